@@ -0,0 +1,51 @@
+// Package dxf reads and writes the geometric entities of ASCII DXF drawings, the exchange
+// format of AutoCAD and most CAD programs.
+//
+// The supported entities are POINT, LINE, LWPOLYLINE, POLYLINE, CIRCLE, ARC and 3DFACE. Each of
+// them is read with the name of its layer, into a type of this package holding g2d or g3d
+// primitives. Only the ENTITIES section is read, and entities of other types, like TEXT or
+// INSERT, are skipped.
+//
+// Circles, arcs and two-dimensional polylines are only supported in planes parallel to XY,
+// whose normal (the extrusion direction) points up or down, and polylines can't have curved
+// (bulged) segments. Reading skips the entities which aren't supported.
+//
+// The drawings are written using the R12 entities, which every DXF reader understands: the
+// polylines are written as POLYLINE entities.
+package dxf
+
+import "errors"
+
+var (
+	// ErrSyntax is returned when the data isn't a valid DXF file.
+	ErrSyntax = errors.New("invalid DXF syntax")
+	// ErrInvalid is returned when an entity's values don't define valid geometry, for example,
+	// a circle with a zero radius.
+	ErrInvalid = errors.New("invalid DXF entity")
+	// ErrUnsupported is returned for valid DXF entities which this package doesn't support.
+	ErrUnsupported = errors.New("unsupported DXF entity")
+)
+
+// DefaultLayer is the layer that every DXF drawing has, used for the entities without a layer.
+const DefaultLayer = "0"
+
+// The DXF entity types.
+const (
+	pointType      = "POINT"
+	lineType       = "LINE"
+	lwPolylineType = "LWPOLYLINE"
+	polylineType   = "POLYLINE"
+	vertexType     = "VERTEX"
+	seqEndType     = "SEQEND"
+	circleType     = "CIRCLE"
+	arcType        = "ARC"
+	faceType       = "3DFACE"
+)
+
+// The polyline flags.
+const (
+	closedFlag       = 1
+	polyline3DFlag   = 8
+	polygonMeshFlag  = 16
+	polyfaceMeshFlag = 64
+)
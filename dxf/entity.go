@@ -0,0 +1,140 @@
+package dxf
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+// An Entity is one of the geometric entities of a drawing: a *Point, *Line, *Polyline,
+// *Circle, *Arc or *Face.
+type Entity interface {
+	// LayerName is the name of the layer where the entity is drawn.
+	LayerName() string
+}
+
+// Layered is embedded in all the entities and holds the name of their layer.
+type Layered struct {
+	Layer string
+}
+
+// LayerName is the name of the layer where the entity is drawn.
+func (l Layered) LayerName() string {
+	return l.Layer
+}
+
+// A Point is a POINT entity.
+type Point struct {
+	Layered
+	Point *g3d.Point
+}
+
+// MakePoint creates a point entity in the given layer.
+func MakePoint(layer string, point *g3d.Point) *Point {
+	return &Point{Layered{layer}, point}
+}
+
+// ToG2D returns the projection of the point onto the XY plane.
+func (p *Point) ToG2D() *g2d.Point {
+	return g2d.MakePoint(p.Point.X(), p.Point.Y())
+}
+
+// A Line is a LINE entity: a straight segment between two points.
+type Line struct {
+	Layered
+	Start, End *g3d.Point
+}
+
+// MakeLine creates a line entity in the given layer.
+func MakeLine(layer string, start, end *g3d.Point) *Line {
+	return &Line{Layered{layer}, start, end}
+}
+
+// ToG2D returns the projection of the line onto the XY plane.
+func (l *Line) ToG2D() *g2d.Segment {
+	return g2d.MakeSegmentFromCoords(l.Start.X(), l.Start.Y(), l.End.X(), l.End.Y())
+}
+
+// A Polyline is an LWPOLYLINE or POLYLINE entity: a sequence of straight segments joining the
+// points. A Closed polyline has a segment joining the last point with the first one.
+type Polyline struct {
+	Layered
+	Points []*g3d.Point
+	Closed bool
+}
+
+// MakePolyline creates a polyline entity in the given layer.
+func MakePolyline(layer string, points []*g3d.Point, closed bool) *Polyline {
+	return &Polyline{Layered{layer}, points, closed}
+}
+
+// ToG2D returns the projection of the polyline onto the XY plane. The polyline of a closed
+// polyline entity ends at its first point.
+func (p *Polyline) ToG2D() (*g2d.Polyline, error) {
+	points := p.points2D()
+	if p.Closed && len(points) > 0 {
+		points = append(points, points[0])
+	}
+
+	return g2d.MakePolyline(points...)
+}
+
+// ToG2DPolygon returns the projection of the closed polyline onto the XY plane as a polygon.
+func (p *Polyline) ToG2DPolygon() (*g2d.Polygon, error) {
+	if !p.Closed {
+		return nil, fmt.Errorf("%w: the polyline isn't closed", ErrInvalid)
+	}
+
+	return g2d.MakePolygon(p.points2D()...)
+}
+
+func (p *Polyline) points2D() []*g2d.Point {
+	points := make([]*g2d.Point, len(p.Points))
+	for i, point := range p.Points {
+		points[i] = g2d.MakePoint(point.X(), point.Y())
+	}
+
+	return points
+}
+
+// A Circle is a CIRCLE entity, in a plane parallel to XY at the given elevation.
+type Circle struct {
+	Layered
+	Circle    *g2d.Circle
+	Elevation float64
+}
+
+// MakeCircle creates a circle entity in the given layer, in the XY plane.
+func MakeCircle(layer string, circle *g2d.Circle) *Circle {
+	return &Circle{Layered: Layered{layer}, Circle: circle}
+}
+
+// An Arc is an ARC entity, in a plane parallel to XY at the given elevation.
+type Arc struct {
+	Layered
+	Arc       *g2d.Arc
+	Elevation float64
+}
+
+// MakeArc creates an arc entity in the given layer, in the XY plane.
+func MakeArc(layer string, arc *g2d.Arc) *Arc {
+	return &Arc{Layered: Layered{layer}, Arc: arc}
+}
+
+// A Face is a 3DFACE entity: a triangle or quadrilateral in space.
+type Face struct {
+	Layered
+	Corners []*g3d.Point
+}
+
+// MakeFace creates a face entity in the given layer.
+//
+// A non-nil error is returned unless there are three or four corners.
+func MakeFace(layer string, corners ...*g3d.Point) (*Face, error) {
+	if len(corners) != 3 && len(corners) != 4 {
+		return nil, fmt.Errorf("%w: a face must have three or four corners, got %d", ErrInvalid, len(corners))
+	}
+
+	return &Face{Layered{layer}, corners}, nil
+}
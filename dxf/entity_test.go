@@ -0,0 +1,58 @@
+package dxf
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestEntityToG2D(t *testing.T) {
+	t.Run("point and line are projected", func(t *testing.T) {
+		if got := MakePoint("", g3d.MakePoint(1, 2, 3)).ToG2D(); !got.Equals(g2d.MakePoint(1, 2)) {
+			t.Errorf("Want (1, 2), got %v", got)
+		}
+
+		line := MakeLine("", g3d.MakePoint(0, 0, 1), g3d.MakePoint(3, 4, 5))
+		if got := line.ToG2D().Length(); got != 5 {
+			t.Errorf("Want length 5, got %f", got)
+		}
+	})
+
+	t.Run("closed polyline", func(t *testing.T) {
+		polyline := MakePolyline("", []*g3d.Point{
+			g3d.MakePoint(0, 0, 0), g3d.MakePoint(2, 0, 0), g3d.MakePoint(2, 2, 0),
+		}, true)
+
+		projected, err := polyline.ToG2D()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(projected.Points()); got != 4 {
+			t.Errorf("Want 4 points, got %d", got)
+		}
+
+		polygon, err := polyline.ToG2DPolygon()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := polygon.Area(); got != 2 {
+			t.Errorf("Want area 2, got %f", got)
+		}
+	})
+
+	t.Run("open polyline isn't a polygon", func(t *testing.T) {
+		polyline := MakePolyline("", []*g3d.Point{g3d.MakePoint(0, 0, 0), g3d.MakePoint(1, 0, 0)}, false)
+
+		if _, err := polyline.ToG2DPolygon(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Want ErrInvalid, got %v", err)
+		}
+	})
+}
+
+func TestMakeFace(t *testing.T) {
+	if _, err := MakeFace("", g3d.MakePoint(0, 0, 0), g3d.MakePoint(1, 0, 0)); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Want ErrInvalid, got %v", err)
+	}
+}
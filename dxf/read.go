@@ -0,0 +1,557 @@
+package dxf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// Read reads the supported entities of the ENTITIES section of an ASCII DXF file, in order.
+// The entities which this package doesn't support, like the polylines with curved segments,
+// are skipped, as are those of other types.
+func Read(r io.Reader) ([]Entity, error) {
+	pairs, err := readPairs(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entities []Entity
+		rd       = &reader{pairs: pairs}
+	)
+
+	for !rd.done() {
+		if !rd.peekIs(0, "SECTION") {
+			return nil, rd.errorf("expected a section")
+		}
+		rd.pos++
+
+		if !rd.peekIs(2, "ENTITIES") {
+			rd.skipSection()
+			continue
+		}
+		rd.pos++
+
+		for !rd.done() && !rd.peekIs(0, "ENDSEC") {
+			entity, err := rd.entity()
+			if errors.Is(err, ErrUnsupported) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if entity != nil {
+				entities = append(entities, entity)
+			}
+		}
+		rd.pos++
+	}
+
+	return entities, nil
+}
+
+// A pair is a group of a DXF file: a code, telling the meaning of the value, and the value.
+type pair struct {
+	code  int
+	value string
+}
+
+// readPairs reads the groups of the file, until the end of the data or the EOF group.
+func readPairs(r io.Reader) ([]pair, error) {
+	var (
+		lines   []string
+		scanner = bufio.NewScanner(r)
+	)
+
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var pairs []pair
+	for i := 0; i < len(lines); i += 2 {
+		code, err := strconv.Atoi(lines[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid group code %q at line %d", ErrSyntax, lines[i], i+1)
+		}
+		if i+1 == len(lines) {
+			return nil, fmt.Errorf("%w: missing the value of the group at line %d", ErrSyntax, i+1)
+		}
+
+		if code == 0 && lines[i+1] == "EOF" {
+			break
+		}
+
+		pairs = append(pairs, pair{code, lines[i+1]})
+	}
+
+	return pairs, nil
+}
+
+// A reader walks the groups of the file, decoding the entities.
+type reader struct {
+	pairs []pair
+	pos   int
+}
+
+func (r *reader) done() bool {
+	return r.pos >= len(r.pairs)
+}
+
+func (r *reader) peekIs(code int, value string) bool {
+	return !r.done() && r.pairs[r.pos].code == code && r.pairs[r.pos].value == value
+}
+
+// skipSection advances past the end of the current section.
+func (r *reader) skipSection() {
+	for !r.done() && !r.peekIs(0, "ENDSEC") {
+		r.pos++
+	}
+	r.pos++
+}
+
+// next returns the type and the groups of the next entity, which go up to the next group with
+// code zero.
+func (r *reader) next() (string, groups, error) {
+	if r.done() || r.pairs[r.pos].code != 0 {
+		return "", nil, r.errorf("expected an entity")
+	}
+
+	var (
+		entityType = r.pairs[r.pos].value
+		start      = r.pos + 1
+	)
+
+	r.pos = start
+	for !r.done() && r.pairs[r.pos].code != 0 {
+		r.pos++
+	}
+
+	return entityType, r.pairs[start:r.pos], nil
+}
+
+// entity decodes the next entity, returning nil if its type isn't supported.
+func (r *reader) entity() (Entity, error) {
+	entityType, g, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch entityType {
+	case pointType:
+		return decodePoint(g)
+	case lineType:
+		return decodeLine(g)
+	case lwPolylineType:
+		return decodeLWPolyline(g)
+	case polylineType:
+		return r.polyline(g)
+	case circleType:
+		return decodeCircle(g)
+	case arcType:
+		return decodeArc(g)
+	case faceType:
+		return decodeFace(g)
+	default:
+		return nil, nil
+	}
+}
+
+// polyline decodes a POLYLINE entity, with the groups of its header, and the VERTEX entities
+// that follow it, up to the SEQEND. The vertices of unsupported polylines are read too, so that
+// the reading can go on after them.
+func (r *reader) polyline(g groups) (Entity, error) {
+	flags, err := g.int(70, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		is3D        = flags&polyline3DFlag != 0
+		mirrored    bool
+		unsupported error
+	)
+	if flags&(polygonMeshFlag|polyfaceMeshFlag) != 0 {
+		unsupported = fmt.Errorf("%w: polygon and polyface meshes", ErrUnsupported)
+	} else if !is3D {
+		mirrored, err = g.mirroredExtrusion()
+		if errors.Is(err, ErrUnsupported) {
+			unsupported = err
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	elevation, err := g.float(30, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var points []*g3d.Point
+	for {
+		entityType, vertex, err := r.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if entityType == seqEndType {
+			break
+		}
+		if entityType != vertexType {
+			return nil, fmt.Errorf("%w: unexpected %s in a polyline", ErrSyntax, entityType)
+		}
+		if unsupported != nil {
+			continue
+		}
+
+		if err := checkNoBulge(vertex); errors.Is(err, ErrUnsupported) {
+			unsupported = err
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		point, err := vertex.point(0)
+		if err != nil {
+			return nil, err
+		}
+		if !is3D {
+			point = ocsToWorld(point.X(), point.Y(), elevation, mirrored)
+		}
+
+		points = append(points, point)
+	}
+
+	if unsupported != nil {
+		return nil, unsupported
+	}
+
+	return MakePolyline(g.layer(), points, flags&closedFlag != 0), nil
+}
+
+func (r *reader) errorf(format string, args ...any) error {
+	message := fmt.Sprintf(format, args...)
+	if r.done() {
+		return fmt.Errorf("%w: %s at the end of the file", ErrSyntax, message)
+	}
+
+	p := r.pairs[r.pos]
+	return fmt.Errorf("%w: %s, got %d %q", ErrSyntax, message, p.code, p.value)
+}
+
+func decodePoint(g groups) (Entity, error) {
+	point, err := g.point(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return MakePoint(g.layer(), point), nil
+}
+
+func decodeLine(g groups) (Entity, error) {
+	start, err := g.point(0)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := g.point(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeLine(g.layer(), start, end), nil
+}
+
+// decodeLWPolyline decodes an LWPOLYLINE entity, whose vertices are given by repeated groups:
+// each X coordinate starts a new vertex.
+func decodeLWPolyline(g groups) (Entity, error) {
+	mirrored, err := g.mirroredExtrusion()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNoBulge(g); err != nil {
+		return nil, err
+	}
+
+	flags, err := g.int(70, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	elevation, err := g.float(38, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var xs, ys []float64
+	for _, p := range g {
+		if p.code != 10 && p.code != 20 {
+			continue
+		}
+
+		value, err := parseFloat(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.code == 10 {
+			xs = append(xs, value)
+			ys = append(ys, math.NaN())
+		} else if len(ys) > 0 {
+			ys[len(ys)-1] = value
+		}
+	}
+
+	points := make([]*g3d.Point, len(xs))
+	for i := range points {
+		if math.IsNaN(ys[i]) {
+			return nil, fmt.Errorf("%w: vertex %d of a polyline has no Y coordinate", ErrSyntax, i)
+		}
+		points[i] = ocsToWorld(xs[i], ys[i], elevation, mirrored)
+	}
+
+	return MakePolyline(g.layer(), points, flags&closedFlag != 0), nil
+}
+
+func decodeCircle(g groups) (Entity, error) {
+	center, radius, _, err := decodeCircleValues(g)
+	if err != nil {
+		return nil, err
+	}
+
+	circle, err := g2d.MakeCircle(g2d.MakePoint(center.X(), center.Y()), radius)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	return &Circle{Layered{g.layer()}, circle, center.Z()}, nil
+}
+
+// decodeArc decodes an ARC entity, which goes counter-clockwise from the start to the end angle,
+// given in degrees. Seen from above, the arc of a mirrored plane goes clockwise, and its angles
+// are measured from the negative X axis.
+func decodeArc(g groups) (Entity, error) {
+	center, radius, mirrored, err := decodeCircleValues(g)
+	if err != nil {
+		return nil, err
+	}
+
+	startDegs, err := g.float(50, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	endDegs, err := g.float(51, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		startRads   = startDegs * math.Pi / 180
+		endRads     = endDegs * math.Pi / 180
+		orientation = g2d.CounterClockwise
+	)
+	if mirrored {
+		startRads, endRads, orientation = math.Pi-startRads, math.Pi-endRads, g2d.Clockwise
+	}
+
+	arc, err := g2d.MakeArc(g2d.MakePoint(center.X(), center.Y()), radius, startRads, endRads, orientation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	return &Arc{Layered{g.layer()}, arc, center.Z()}, nil
+}
+
+// decodeCircleValues decodes the center, in world coordinates, and the radius of a CIRCLE or
+// ARC entity, and whether its plane is mirrored.
+func decodeCircleValues(g groups) (*g3d.Point, float64, bool, error) {
+	mirrored, err := g.mirroredExtrusion()
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	center, err := g.point(0)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	radius, err := g.float(40, 0)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return ocsToWorld(center.X(), center.Y(), center.Z(), mirrored), radius, mirrored, nil
+}
+
+// decodeFace decodes a 3DFACE entity, which always has four corners. Triangles repeat the
+// third corner.
+func decodeFace(g groups) (Entity, error) {
+	corners := make([]*g3d.Point, 4)
+	for i := range corners {
+		var err error
+		if corners[i], err = g.point(i); err != nil {
+			return nil, err
+		}
+	}
+
+	if corners[3].Equals(corners[2]) {
+		corners = corners[:3]
+	}
+
+	return MakeFace(g.layer(), corners...)
+}
+
+func checkNoBulge(g groups) error {
+	for _, p := range g {
+		if p.code != 42 {
+			continue
+		}
+
+		bulge, err := parseFloat(p)
+		if err != nil {
+			return err
+		}
+		if bulge != 0 {
+			return fmt.Errorf("%w: polylines with curved segments", ErrUnsupported)
+		}
+	}
+
+	return nil
+}
+
+// The groups of an entity.
+type groups []pair
+
+// layer returns the name of the entity's layer.
+func (g groups) layer() string {
+	for _, p := range g {
+		if p.code == 8 {
+			return p.value
+		}
+	}
+
+	return DefaultLayer
+}
+
+// float returns the value of the first group with the code, or the default value if there is
+// none.
+func (g groups) float(code int, defaultValue float64) (float64, error) {
+	for _, p := range g {
+		if p.code == code {
+			return parseFloat(p)
+		}
+	}
+
+	return defaultValue, nil
+}
+
+func (g groups) int(code int, defaultValue int) (int, error) {
+	for _, p := range g {
+		if p.code == code {
+			value, err := strconv.Atoi(p.value)
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid integer %q in group %d", ErrSyntax, p.value, p.code)
+			}
+
+			return value, nil
+		}
+	}
+
+	return defaultValue, nil
+}
+
+// point returns the point whose coordinates are in the groups with codes 10, 20 and 30 plus the
+// given index. The X and Y coordinates are required.
+func (g groups) point(index int) (*g3d.Point, error) {
+	var coords [3]float64
+	for i := range coords {
+		code := 10*(i+1) + index
+
+		value, err := g.float(code, math.NaN())
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(value) && i < 2 {
+			return nil, fmt.Errorf("%w: missing group %d", ErrSyntax, code)
+		}
+		if math.IsNaN(value) {
+			value = 0
+		}
+
+		coords[i] = value
+	}
+
+	return g3d.MakePoint(coords[0], coords[1], coords[2]), nil
+}
+
+// extrusionTolerance is the largest deviation from the Z axis of a normalized extrusion
+// direction for which the entity is taken to be in a plane parallel to XY.
+const extrusionTolerance = 1e-9
+
+// mirroredExtrusion checks that the entity's extrusion direction, which is the normal of the
+// plane where planar entities are defined, is the Z axis, and tells whether it points down.
+//
+// The coordinates of planar entities are given in the Object Coordinate System (OCS) of the
+// plane. When the extrusion is the Z axis it is the world coordinate system, and when it is
+// the negative Z axis its X and Z axes are reversed, as given by the arbitrary axis algorithm.
+func (g groups) mirroredExtrusion() (bool, error) {
+	var direction [3]float64
+	for i, code := range []int{210, 220, 230} {
+		defaultValue := 0.0
+		if code == 230 {
+			defaultValue = 1
+		}
+
+		var err error
+		if direction[i], err = g.float(code, defaultValue); err != nil {
+			return false, err
+		}
+	}
+
+	norm := math.Sqrt(direction[0]*direction[0] + direction[1]*direction[1] + direction[2]*direction[2])
+	if norm == 0 {
+		return false, fmt.Errorf("%w: zero extrusion direction", ErrInvalid)
+	}
+	if math.Hypot(direction[0], direction[1]) > extrusionTolerance*norm {
+		return false, fmt.Errorf("%w: entities outside the XY plane", ErrUnsupported)
+	}
+
+	return direction[2] < 0, nil
+}
+
+// ocsToWorld transforms the coordinates of a point in the OCS of a plane parallel to XY to
+// world coordinates.
+func ocsToWorld(x, y, z float64, mirrored bool) *g3d.Point {
+	if mirrored {
+		return g3d.MakePoint(-x, y, -z)
+	}
+
+	return g3d.MakePoint(x, y, z)
+}
+
+func parseFloat(p pair) (float64, error) {
+	value, err := strconv.ParseFloat(p.value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid number %q in group %d", ErrSyntax, p.value, p.code)
+	}
+	if err := floatenc.CheckFinite(value); err != nil {
+		return 0, fmt.Errorf("%w: group %d: %v", ErrSyntax, p.code, err)
+	}
+
+	return value, nil
+}
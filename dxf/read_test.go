@@ -0,0 +1,245 @@
+package dxf
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// makeDXF builds the text of a DXF file with the given groups in the ENTITIES section, given
+// as alternating codes and values.
+func makeDXF(groups ...string) string {
+	lines := []string{"0", "SECTION", "2", "HEADER", "9", "$INSUNITS", "70", "6", "0", "ENDSEC"}
+	lines = append(lines, "0", "SECTION", "2", "ENTITIES")
+	lines = append(lines, groups...)
+	lines = append(lines, "0", "ENDSEC", "0", "EOF")
+
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func mustRead(t *testing.T, data string) []Entity {
+	t.Helper()
+
+	entities, err := Read(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return entities
+}
+
+func TestReadEntities(t *testing.T) {
+	t.Run("point and line", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "POINT", "8", "nodes", "10", "1.5", "20", "2", "30", "3",
+			"0", "LINE", "8", "beams", "10", "0", "20", "0", "30", "0", "11", "4", "21", "0", "31", "3",
+		))
+
+		if len(entities) != 2 {
+			t.Fatalf("Want 2 entities, got %d", len(entities))
+		}
+
+		point := entities[0].(*Point)
+		if point.LayerName() != "nodes" || !point.Point.Equals(g3d.MakePoint(1.5, 2, 3)) {
+			t.Errorf("Unexpected point %v in layer %q", point.Point, point.Layer)
+		}
+
+		line := entities[1].(*Line)
+		if line.LayerName() != "beams" || !line.End.Equals(g3d.MakePoint(4, 0, 3)) {
+			t.Errorf("Unexpected line to %v in layer %q", line.End, line.Layer)
+		}
+	})
+
+	t.Run("lightweight polyline", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "LWPOLYLINE", "8", "section", "90", "3", "70", "1", "38", "2.5",
+			"10", "0", "20", "0", "10", "1", "20", "0", "42", "0", "10", "1", "20", "1",
+		))
+
+		polyline := entities[0].(*Polyline)
+		if !polyline.Closed || len(polyline.Points) != 3 {
+			t.Fatalf("Want a closed polyline with 3 points, got %+v", polyline)
+		}
+		if !polyline.Points[2].Equals(g3d.MakePoint(1, 1, 2.5)) {
+			t.Errorf("Want the last point at (1, 1, 2.5), got %v", polyline.Points[2])
+		}
+	})
+
+	t.Run("2D polyline takes the elevation", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "POLYLINE", "8", "walls", "66", "1", "10", "0", "20", "0", "30", "4", "70", "0",
+			"0", "VERTEX", "8", "walls", "10", "0", "20", "0",
+			"0", "VERTEX", "8", "walls", "10", "5", "20", "0", "30", "99",
+			"0", "SEQEND",
+			"0", "POINT", "10", "1", "20", "1",
+		))
+
+		if len(entities) != 2 {
+			t.Fatalf("Want 2 entities, got %d", len(entities))
+		}
+
+		polyline := entities[0].(*Polyline)
+		if polyline.Closed || !polyline.Points[1].Equals(g3d.MakePoint(5, 0, 4)) {
+			t.Errorf("Unexpected polyline %+v", polyline)
+		}
+		if got := entities[1].LayerName(); got != DefaultLayer {
+			t.Errorf("Want the default layer, got %q", got)
+		}
+	})
+
+	t.Run("3D polyline", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "POLYLINE", "70", "9",
+			"0", "VERTEX", "10", "0", "20", "0", "30", "1", "70", "32",
+			"0", "VERTEX", "10", "5", "20", "0", "30", "2", "70", "32",
+			"0", "SEQEND",
+		))
+
+		polyline := entities[0].(*Polyline)
+		if !polyline.Closed || !polyline.Points[1].Equals(g3d.MakePoint(5, 0, 2)) {
+			t.Errorf("Unexpected polyline %+v", polyline)
+		}
+	})
+
+	t.Run("circle and arc", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "CIRCLE", "8", "holes", "10", "1", "20", "2", "30", "0.5", "40", "3",
+			"0", "ARC", "10", "0", "20", "0", "40", "2", "50", "90", "51", "180",
+		))
+
+		circle := entities[0].(*Circle)
+		want, _ := g2d.MakeCircle(g2d.MakePoint(1, 2), 3)
+		if !circle.Circle.Equals(want) || circle.Elevation != 0.5 {
+			t.Errorf("Unexpected circle %v at elevation %f", circle.Circle, circle.Elevation)
+		}
+
+		arc := entities[1].(*Arc)
+		if !arc.Arc.End().Equals(g2d.MakePoint(-2, 0)) || !nums.FloatsEqual(arc.Arc.SweepAngle(), 0.5*math.Pi) {
+			t.Errorf("Unexpected arc %v", arc.Arc)
+		}
+	})
+
+	t.Run("triangular and quadrilateral faces", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "3DFACE", "10", "0", "20", "0", "30", "0", "11", "1", "21", "0", "31", "0",
+			"12", "0", "22", "1", "32", "0", "13", "0", "23", "1", "33", "0",
+			"0", "3DFACE", "10", "0", "20", "0", "30", "0", "11", "1", "21", "0", "31", "0",
+			"12", "1", "22", "1", "32", "1", "13", "0", "23", "1", "33", "1",
+		))
+
+		if got := len(entities[0].(*Face).Corners); got != 3 {
+			t.Errorf("Want a triangle, got %d corners", got)
+		}
+		if got := len(entities[1].(*Face).Corners); got != 4 {
+			t.Errorf("Want a quadrilateral, got %d corners", got)
+		}
+	})
+
+	t.Run("skips other entities and sections", func(t *testing.T) {
+		data := "0\nSECTION\n2\nBLOCKS\n0\nPOINT\n10\n9\n20\n9\n0\nENDSEC\n" + makeDXF(
+			"0", "TEXT", "8", "labels", "10", "0", "20", "0", "1", "Beam",
+			"0", "POINT", "10", "1", "20", "1",
+		)
+
+		entities := mustRead(t, data)
+		if len(entities) != 1 || !entities[0].(*Point).Point.Equals(g3d.MakePoint(1, 1, 0)) {
+			t.Errorf("Want only the point in the ENTITIES section, got %v", entities)
+		}
+	})
+}
+
+func TestReadExtrusion(t *testing.T) {
+	t.Run("close to the Z axis", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "CIRCLE", "10", "1", "20", "2", "40", "3", "210", "1e-12", "220", "0", "230", "0.99999999999",
+		))
+
+		want, _ := g2d.MakeCircle(g2d.MakePoint(1, 2), 3)
+		if circle := entities[0].(*Circle); !circle.Circle.Equals(want) {
+			t.Errorf("Want %v, got %v", want, circle.Circle)
+		}
+	})
+
+	t.Run("negative Z axis mirrors the X and Z coordinates", func(t *testing.T) {
+		entities := mustRead(t, makeDXF(
+			"0", "CIRCLE", "10", "1", "20", "2", "30", "0.5", "40", "3", "230", "-1",
+			"0", "ARC", "10", "1", "20", "0", "40", "2", "50", "0", "51", "90", "230", "-1",
+			"0", "LWPOLYLINE", "38", "2", "230", "-1", "10", "1", "20", "0", "10", "3", "20", "4",
+			"0", "POLYLINE", "30", "2", "230", "-1",
+			"0", "VERTEX", "10", "1", "20", "0",
+			"0", "VERTEX", "10", "3", "20", "4",
+			"0", "SEQEND",
+		))
+
+		circle := entities[0].(*Circle)
+		want, _ := g2d.MakeCircle(g2d.MakePoint(-1, 2), 3)
+		if !circle.Circle.Equals(want) || circle.Elevation != -0.5 {
+			t.Errorf("Unexpected circle %v at elevation %f", circle.Circle, circle.Elevation)
+		}
+
+		arc := entities[1].(*Arc)
+		if !arc.Arc.Center().Equals(g2d.MakePoint(-1, 0)) ||
+			!arc.Arc.Start().Equals(g2d.MakePoint(-3, 0)) ||
+			!arc.Arc.End().Equals(g2d.MakePoint(-1, 2)) ||
+			arc.Arc.Orientation() != g2d.Clockwise || !nums.FloatsEqual(arc.Arc.SweepAngle(), 0.5*math.Pi) {
+			t.Errorf("Unexpected arc %v", arc.Arc)
+		}
+
+		for _, entity := range entities[2:] {
+			polyline := entity.(*Polyline)
+			if !polyline.Points[0].Equals(g3d.MakePoint(-1, 0, -2)) ||
+				!polyline.Points[1].Equals(g3d.MakePoint(-3, 4, -2)) {
+				t.Errorf("Unexpected polyline %v", polyline.Points)
+			}
+		}
+	})
+}
+
+func TestReadSkipsUnsupportedEntities(t *testing.T) {
+	entities := mustRead(t, makeDXF(
+		"0", "LWPOLYLINE", "10", "0", "20", "0", "42", "1", "10", "1", "20", "0",
+		"0", "CIRCLE", "10", "0", "20", "0", "40", "1", "210", "1", "230", "0",
+		"0", "POLYLINE", "70", "64",
+		"0", "VERTEX", "10", "0", "20", "0",
+		"0", "SEQEND",
+		"0", "POLYLINE",
+		"0", "VERTEX", "10", "0", "20", "0", "42", "0.5",
+		"0", "VERTEX", "10", "1", "20", "0",
+		"0", "SEQEND",
+		"0", "POINT", "10", "1", "20", "1",
+	))
+
+	if len(entities) != 1 || !entities[0].(*Point).Point.Equals(g3d.MakePoint(1, 1, 0)) {
+		t.Errorf("Want only the point, got %v", entities)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"invalid group code", "X\nSECTION\n", ErrSyntax},
+		{"missing value", "0\nSECTION\n2\n", ErrSyntax},
+		{"not a section", "0\nPOINT\n", ErrSyntax},
+		{"invalid number", makeDXF("0", "POINT", "10", "one", "20", "0"), ErrSyntax},
+		{"missing coordinate", makeDXF("0", "POINT", "10", "1"), ErrSyntax},
+		{"unterminated polyline", makeDXF("0", "POLYLINE", "0", "VERTEX", "10", "0", "20", "0"), ErrSyntax},
+		{"zero radius", makeDXF("0", "CIRCLE", "10", "0", "20", "0", "40", "0"), ErrInvalid},
+		{"zero extrusion", makeDXF("0", "CIRCLE", "10", "0", "20", "0", "40", "1", "230", "0"), ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Read(strings.NewReader(tt.data)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
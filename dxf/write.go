@@ -0,0 +1,185 @@
+package dxf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+// Write writes the entities as an ASCII DXF file, in the R12 version of the format.
+//
+// Polylines whose points are all at the same elevation are written as two-dimensional
+// POLYLINE entities, and the rest as three-dimensional ones. Arcs are written
+// counter-clockwise, as DXF requires, swapping the start and end angles of clockwise arcs.
+func Write(w io.Writer, entities []Entity) error {
+	wr := &writer{w: bufio.NewWriter(w)}
+
+	wr.text(0, "SECTION")
+	wr.text(2, "HEADER")
+	wr.text(9, "$ACADVER")
+	wr.text(1, "AC1009")
+	wr.text(0, "ENDSEC")
+
+	wr.text(0, "SECTION")
+	wr.text(2, "ENTITIES")
+	for _, entity := range entities {
+		if err := wr.entity(entity); err != nil {
+			return err
+		}
+	}
+	wr.text(0, "ENDSEC")
+	wr.text(0, "EOF")
+
+	if wr.err != nil {
+		return wr.err
+	}
+
+	return wr.w.Flush()
+}
+
+// A writer writes the groups of a DXF file, keeping the first error.
+type writer struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (w *writer) entity(entity Entity) error {
+	switch e := entity.(type) {
+	case *Point:
+		w.header(pointType, e.Layer)
+		w.point(0, e.Point)
+
+	case *Line:
+		w.header(lineType, e.Layer)
+		w.point(0, e.Start)
+		w.point(1, e.End)
+
+	case *Polyline:
+		w.polyline(e)
+
+	case *Circle:
+		w.header(circleType, e.Layer)
+		w.point2D(0, e.Circle.Center(), e.Elevation)
+		w.float(40, e.Circle.Radius())
+
+	case *Arc:
+		startRads, endRads := e.Arc.StartAngle(), e.Arc.EndAngle()
+		if e.Arc.Orientation() == g2d.Clockwise {
+			startRads, endRads = endRads, startRads
+		}
+
+		w.header(arcType, e.Layer)
+		w.point2D(0, e.Arc.Center(), e.Elevation)
+		w.float(40, e.Arc.Radius())
+		w.float(50, startRads*180/math.Pi)
+		w.float(51, endRads*180/math.Pi)
+
+	case *Face:
+		corners := e.Corners
+		switch len(corners) {
+		case 3:
+			corners = append(corners[:3:3], corners[2])
+		case 4:
+		default:
+			return fmt.Errorf("%w: a face must have three or four corners, got %d", ErrInvalid, len(corners))
+		}
+
+		w.header(faceType, e.Layer)
+		for i, corner := range corners {
+			w.point(i, corner)
+		}
+
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupported, entity)
+	}
+
+	return nil
+}
+
+// polyline writes a POLYLINE entity, followed by its VERTEX entities and the SEQEND.
+func (w *writer) polyline(p *Polyline) {
+	var (
+		flags     = 0
+		elevation = 0.0
+		is3D      = false
+	)
+
+	if len(p.Points) > 0 {
+		elevation = p.Points[0].Z()
+	}
+	for _, point := range p.Points {
+		if point.Z() != elevation {
+			is3D = true
+		}
+	}
+
+	if p.Closed {
+		flags |= closedFlag
+	}
+	if is3D {
+		flags |= polyline3DFlag
+		elevation = 0
+	}
+
+	w.header(polylineType, p.Layer)
+	w.int(66, 1)
+	w.point2D(0, g2d.MakePoint(0, 0), elevation)
+	w.int(70, flags)
+
+	// The vertices of 3D polylines have the 3D polyline vertex flag.
+	vertexFlags := 0
+	if is3D {
+		vertexFlags = 32
+	}
+
+	for _, point := range p.Points {
+		w.header(vertexType, p.Layer)
+		w.point(0, point)
+		w.int(70, vertexFlags)
+	}
+
+	w.header(seqEndType, p.Layer)
+}
+
+// header writes the type and layer of an entity.
+func (w *writer) header(entityType, layer string) {
+	if layer == "" {
+		layer = DefaultLayer
+	}
+
+	w.text(0, entityType)
+	w.text(8, layer)
+}
+
+// point writes the coordinates of the point in the groups with codes 10, 20 and 30 plus the
+// given index.
+func (w *writer) point(index int, p *g3d.Point) {
+	w.float(10+index, p.X())
+	w.float(20+index, p.Y())
+	w.float(30+index, p.Z())
+}
+
+func (w *writer) point2D(index int, p *g2d.Point, elevation float64) {
+	w.point(index, g3d.MakePoint(p.X(), p.Y(), elevation))
+}
+
+func (w *writer) float(code int, value float64) {
+	w.text(code, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+func (w *writer) int(code int, value int) {
+	w.text(code, strconv.Itoa(value))
+}
+
+func (w *writer) text(code int, value string) {
+	if w.err != nil {
+		return
+	}
+
+	_, w.err = fmt.Fprintf(w.w, "%3d\n%s\n", code, value)
+}
@@ -0,0 +1,91 @@
+package dxf
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestWrite(t *testing.T) {
+	t.Run("writes the groups", func(t *testing.T) {
+		var b strings.Builder
+		if err := Write(&b, []Entity{MakePoint("nodes", g3d.MakePoint(1, 2.5, 0))}); err != nil {
+			t.Fatal(err)
+		}
+
+		want := "  0\nSECTION\n  2\nHEADER\n  9\n$ACADVER\n  1\nAC1009\n  0\nENDSEC\n" +
+			"  0\nSECTION\n  2\nENTITIES\n" +
+			"  0\nPOINT\n  8\nnodes\n 10\n1\n 20\n2.5\n 30\n0\n" +
+			"  0\nENDSEC\n  0\nEOF\n"
+		if got := b.String(); got != want {
+			t.Errorf("Want:\n%s\ngot:\n%s", want, got)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		var (
+			circle, _    = g2d.MakeCircle(g2d.MakePoint(1, 1), 0.5)
+			clockwise, _ = g2d.MakeArc(g2d.MakePoint(0, 0), 2, 0.5*math.Pi, 0, g2d.Clockwise)
+			triangle, _  = MakeFace("slab", g3d.MakePoint(0, 0, 3), g3d.MakePoint(1, 0, 3), g3d.MakePoint(0, 1, 3))
+			original     = []Entity{
+				MakeLine("beams", g3d.MakePoint(0, 0, 0), g3d.MakePoint(0, 0, 3)),
+				MakePolyline("section", []*g3d.Point{
+					g3d.MakePoint(0, 0, 1), g3d.MakePoint(2, 0, 1), g3d.MakePoint(2, 1, 1),
+				}, true),
+				MakePolyline("cable", []*g3d.Point{g3d.MakePoint(0, 0, 0), g3d.MakePoint(5, 0, 2)}, false),
+				&Circle{Layered{"holes"}, circle, 0.2},
+				MakeArc("", clockwise),
+				triangle,
+			}
+			b strings.Builder
+		)
+
+		if err := Write(&b, original); err != nil {
+			t.Fatal(err)
+		}
+
+		entities := mustRead(t, b.String())
+		if len(entities) != len(original) {
+			t.Fatalf("Want %d entities, got %d", len(original), len(entities))
+		}
+
+		for i, entity := range entities {
+			if want := original[i].LayerName(); want != "" && entity.LayerName() != want {
+				t.Errorf("Want layer %q, got %q", want, entity.LayerName())
+			}
+		}
+
+		if got := entities[1].(*Polyline); !got.Closed || !got.Points[2].Equals(g3d.MakePoint(2, 1, 1)) {
+			t.Errorf("Unexpected polyline %+v", got)
+		}
+		if got := entities[2].(*Polyline); !got.Points[1].Equals(g3d.MakePoint(5, 0, 2)) {
+			t.Errorf("Unexpected 3D polyline %+v", got)
+		}
+		if got := entities[3].(*Circle); !got.Circle.Equals(circle) || got.Elevation != 0.2 {
+			t.Errorf("Unexpected circle %+v", got)
+		}
+
+		arc := entities[4].(*Arc).Arc
+		if arc.Orientation() != g2d.CounterClockwise || !arc.Start().Equals(clockwise.End()) {
+			t.Errorf("Want the arc reversed, got %v", arc)
+		}
+		if entities[4].LayerName() != DefaultLayer {
+			t.Errorf("Want the default layer, got %q", entities[4].LayerName())
+		}
+
+		if got := entities[5].(*Face).Corners; len(got) != 3 || !got[2].Equals(g3d.MakePoint(0, 1, 3)) {
+			t.Errorf("Unexpected face corners %v", got)
+		}
+	})
+
+	t.Run("invalid face", func(t *testing.T) {
+		face := &Face{Layered{"slab"}, []*g3d.Point{g3d.MakePoint(0, 0, 0)}}
+		if err := Write(&strings.Builder{}, []Entity{face}); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Want ErrInvalid, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+package g2d
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// An Arc is a portion of a circle between a start and an end angle, travelled in a given
+// orientation.
+//
+// Angles are measured in radians from the X axis, counter-clockwise. The arc is parametrized
+// from the start angle (t = 0) to the end angle (t = 1), in the direction of its orientation.
+type Arc struct {
+	circle      *Circle
+	startAngle  float64
+	endAngle    float64
+	sweepAngle  float64
+	orientation Orientation
+}
+
+// MakeArc creates a new arc given the center and radius of its circle, the start and end
+// angles (in radians) and the orientation in which the arc goes from start to end.
+// If the start and end angles are equal, the arc is a complete turn around the circle.
+//
+// A non-nil error is returned if the radius isn't greater than zero.
+func MakeArc(
+	center *Point,
+	radius, startRads, endRads float64,
+	orientation Orientation,
+) (*Arc, error) {
+	circle, err := MakeCircle(center, radius)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep := endRads - startRads
+	if orientation == Clockwise {
+		sweep = -sweep
+	}
+
+	return &Arc{
+		circle:      circle,
+		startAngle:  startRads,
+		endAngle:    endRads,
+		sweepAngle:  normalizeSweep(sweep),
+		orientation: orientation,
+	}, nil
+}
+
+// The Center of the arc's circle.
+func (a *Arc) Center() *Point {
+	return a.circle.center
+}
+
+// The Radius of the arc's circle.
+func (a *Arc) Radius() float64 {
+	return a.circle.radius
+}
+
+// The StartAngle, in radians, is the angle where the arc starts.
+func (a *Arc) StartAngle() float64 {
+	return a.startAngle
+}
+
+// The EndAngle, in radians, is the angle where the arc ends.
+func (a *Arc) EndAngle() float64 {
+	return a.endAngle
+}
+
+// The SweepAngle, in radians, is the angle travelled from the start to the end of the arc.
+// It's always positive and at most 2π.
+func (a *Arc) SweepAngle() float64 {
+	return a.sweepAngle
+}
+
+// The Orientation in which the arc goes from the start angle to the end angle.
+func (a *Arc) Orientation() Orientation {
+	return a.orientation
+}
+
+// Circle returns the circle the arc is a portion of.
+func (a *Arc) Circle() *Circle {
+	return a.circle
+}
+
+// Start is the point where the arc starts.
+func (a *Arc) Start() *Point {
+	return a.PointAt(nums.MinT)
+}
+
+// End is the point where the arc ends.
+func (a *Arc) End() *Point {
+	return a.PointAt(nums.MaxT)
+}
+
+// Length computes the length of the arc.
+func (a *Arc) Length() float64 {
+	return a.circle.radius * a.sweepAngle
+}
+
+// PointAt computes an intermediate point in the arc.
+func (a *Arc) PointAt(t nums.TParam) *Point {
+	return a.circle.pointAtAngle(a.angleAt(t))
+}
+
+// TangentAt computes the versor tangent to the arc at the given t parameter, pointing in the
+// direction in which the arc advances.
+func (a *Arc) TangentAt(t nums.TParam) *Vector {
+	var (
+		angle   = a.angleAt(t)
+		tangent = MakeVector(-math.Sin(angle), math.Cos(angle))
+	)
+
+	if a.orientation == Clockwise {
+		return tangent.Scaled(-1)
+	}
+
+	return tangent
+}
+
+// BoundingRect returns the smallest rectangle containing the arc.
+func (a *Arc) BoundingRect() *Rect {
+	points := []*Point{a.Start(), a.End()}
+
+	for _, angle := range []float64{0, math.Pi / 2, math.Pi, 3 * math.Pi / 2} {
+		if a.ContainsAngle(angle) {
+			points = append(points, a.circle.pointAtAngle(angle))
+		}
+	}
+
+	rect, _ := MakeRectContaining(points)
+	return rect
+}
+
+// ContainsAngle checks whether the point of the circle at the given angle (in radians) is part
+// of the arc. The start and end points are part of the arc.
+func (a *Arc) ContainsAngle(radians float64) bool {
+	travelled := radians - a.startAngle
+	if a.orientation == Clockwise {
+		travelled = -travelled
+	}
+	travelled = normalizeAngle(travelled)
+
+	return travelled <= a.sweepAngle ||
+		nums.FloatsEqual(travelled, a.sweepAngle) ||
+		nums.FloatsEqual(travelled, 2*math.Pi)
+}
+
+// IntersectionsWithSegment computes the points where the segment intersects the arc.
+func (a *Arc) IntersectionsWithSegment(segment *Segment) []*Point {
+	return a.pointsInArc(a.circle.IntersectionsWithSegment(segment))
+}
+
+// IntersectionsWithCircle computes the points where the circle intersects the arc.
+func (a *Arc) IntersectionsWithCircle(circle *Circle) []*Point {
+	return a.pointsInArc(a.circle.IntersectionsWithCircle(circle))
+}
+
+// IntersectionsWithArc computes the points where this and the other arc intersect.
+func (a *Arc) IntersectionsWithArc(other *Arc) []*Point {
+	return other.pointsInArc(a.IntersectionsWithCircle(other.circle))
+}
+
+func (a *Arc) angleAt(t nums.TParam) float64 {
+	if a.orientation == Clockwise {
+		return a.startAngle - t.Value()*a.sweepAngle
+	}
+
+	return a.startAngle + t.Value()*a.sweepAngle
+}
+
+// pointsInArc filters the given points of the arc's circle, keeping those in the arc.
+func (a *Arc) pointsInArc(points []*Point) []*Point {
+	inArc := make([]*Point, 0, len(points))
+	for _, point := range points {
+		if a.ContainsAngle(a.circle.center.VectorTo(point).AngleInRadsFromX()) {
+			inArc = append(inArc, point)
+		}
+	}
+
+	return inArc
+}
+
+func (a *Arc) String() string {
+	return fmt.Sprintf(
+		"Arc{center: %v, radius: %f, start: %f, end: %f, %v}",
+		a.circle.center, a.circle.radius, a.startAngle, a.endAngle, a.orientation,
+	)
+}
+
+// normalizeAngle returns the equivalent angle in the range [0, 2π).
+func normalizeAngle(radians float64) float64 {
+	angle := math.Mod(radians, 2*math.Pi)
+	if angle < 0 {
+		angle += 2 * math.Pi
+	}
+
+	return angle
+}
+
+// normalizeSweep returns the equivalent angle in the range (0, 2π].
+func normalizeSweep(radians float64) float64 {
+	angle := normalizeAngle(radians)
+	if nums.IsCloseToZero(angle) || nums.FloatsEqual(angle, 2*math.Pi) {
+		return 2 * math.Pi
+	}
+
+	return angle
+}
@@ -0,0 +1,92 @@
+package g2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeArc(t *testing.T) {
+	t.Run("counter-clockwise sweep angle", func(t *testing.T) {
+		arc, _ := MakeArc(MakePoint(0, 0), 1, 0, math.Pi/2, CounterClockwise)
+
+		assert.True(t, nums.FloatsEqual(arc.SweepAngle(), math.Pi/2))
+	})
+
+	t.Run("clockwise sweep angle", func(t *testing.T) {
+		arc, _ := MakeArc(MakePoint(0, 0), 1, 0, math.Pi/2, Clockwise)
+
+		assert.True(t, nums.FloatsEqual(arc.SweepAngle(), 3*math.Pi/2))
+	})
+
+	t.Run("equal angles make a complete turn", func(t *testing.T) {
+		arc, _ := MakeArc(MakePoint(0, 0), 1, 1, 1, CounterClockwise)
+
+		assert.True(t, nums.FloatsEqual(arc.SweepAngle(), 2*math.Pi))
+	})
+
+	t.Run("can't be created with a zero radius", func(t *testing.T) {
+		arc, err := MakeArc(MakePoint(0, 0), 0, 0, 1, CounterClockwise)
+
+		assert.Nil(t, arc)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestArcPointAndTangentAt(t *testing.T) {
+	t.Run("counter-clockwise arc", func(t *testing.T) {
+		arc, _ := MakeArc(MakePoint(1, 1), 2, 0, math.Pi, CounterClockwise)
+
+		assert.True(t, arc.Start().Equals(MakePoint(3, 1)))
+		assert.True(t, arc.PointAt(nums.HalfT).Equals(MakePoint(1, 3)))
+		assert.True(t, arc.End().Equals(MakePoint(-1, 1)))
+		assert.True(t, arc.TangentAt(nums.HalfT).Equals(MakeVector(-1, 0)))
+		assert.True(t, nums.FloatsEqual(arc.Length(), 2*math.Pi))
+	})
+
+	t.Run("clockwise arc", func(t *testing.T) {
+		arc, _ := MakeArc(MakePoint(1, 1), 2, 0, math.Pi, Clockwise)
+
+		assert.True(t, arc.PointAt(nums.HalfT).Equals(MakePoint(1, -1)))
+		assert.True(t, arc.TangentAt(nums.HalfT).Equals(MakeVector(-1, 0)))
+	})
+}
+
+func TestArcBoundingRect(t *testing.T) {
+	var (
+		arc, _  = MakeArc(MakePoint(0, 0), 2, -math.Pi/4, math.Pi/2, CounterClockwise)
+		want, _ = MakeRect(MakePoint(0, -math.Sqrt2), 2, 2+math.Sqrt2)
+	)
+
+	assert.True(t, arc.BoundingRect().Equals(want), "Want %v, got %v", want, arc.BoundingRect())
+}
+
+func TestArcIntersections(t *testing.T) {
+	arc, _ := MakeArc(MakePoint(0, 0), 5, 0, math.Pi, CounterClockwise)
+
+	t.Run("with a segment", func(t *testing.T) {
+		points := arc.IntersectionsWithSegment(MakeSegmentFromCoords(4, -10, 4, 10))
+
+		assertPointsEqual(t, []*Point{MakePoint(4, 3)}, points)
+	})
+
+	t.Run("with a circle", func(t *testing.T) {
+		var (
+			circle, _ = MakeCircle(MakePoint(8, 0), 5)
+			points    = arc.IntersectionsWithCircle(circle)
+		)
+
+		assertPointsEqual(t, []*Point{MakePoint(4, 3)}, points)
+	})
+
+	t.Run("with another arc", func(t *testing.T) {
+		var (
+			other, _ = MakeArc(MakePoint(8, 0), 5, math.Pi, 3*math.Pi/2, CounterClockwise)
+			points   = arc.IntersectionsWithArc(other)
+		)
+
+		assert.Empty(t, points)
+	})
+}
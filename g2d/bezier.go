@@ -0,0 +1,218 @@
+package g2d
+
+import (
+	"errors"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// The bezier functions in this file work for curves of any degree, defined by their control
+// points. The QuadBezier and CubicBezier types are built on top of them.
+
+// bezierPointAt evaluates the curve at the given t parameter using de Casteljau's algorithm.
+func bezierPointAt(points []*Point, t nums.TParam) *Point {
+	point, _, _ := bezierDeCasteljau(points, t)
+	return point
+}
+
+// bezierDeCasteljau evaluates the curve at the given t parameter, returning the point and the
+// control points of the two curves resulting of splitting the curve at that point.
+func bezierDeCasteljau(points []*Point, t nums.TParam) (*Point, []*Point, []*Point) {
+	var (
+		n     = len(points)
+		tVal  = t.Value()
+		left  = make([]*Point, n)
+		right = make([]*Point, n)
+		level = make([]*Point, n)
+	)
+
+	copy(level, points)
+
+	for k := 0; k < n; k++ {
+		left[k] = level[0]
+		right[n-1-k] = level[n-1-k]
+
+		for i := 0; i < n-1-k; i++ {
+			level[i] = MakePoint(
+				(1-tVal)*level[i].x+tVal*level[i+1].x,
+				(1-tVal)*level[i].y+tVal*level[i+1].y,
+			)
+		}
+	}
+
+	return left[n-1], left, right
+}
+
+// bezierDerivativePoints returns the control points of the curve's derivative (a curve one
+// degree lower), whose coordinates are the projections of the derivative vector.
+func bezierDerivativePoints(points []*Point) []*Point {
+	var (
+		degree = float64(len(points) - 1)
+		deriv  = make([]*Point, len(points)-1)
+	)
+
+	for i := range deriv {
+		deriv[i] = MakePoint(
+			degree*(points[i+1].x-points[i].x),
+			degree*(points[i+1].y-points[i].y),
+		)
+	}
+
+	return deriv
+}
+
+// bezierDerivativeAt computes the derivative vector of the given order at a t parameter.
+func bezierDerivativeAt(points []*Point, order int, t nums.TParam) *Vector {
+	for i := 0; i < order; i++ {
+		if len(points) < 2 {
+			return MakeVector(0, 0)
+		}
+
+		points = bezierDerivativePoints(points)
+	}
+
+	point := bezierPointAt(points, t)
+	return MakeVector(point.x, point.y)
+}
+
+// bezierBoundingRect computes the tight bounding rectangle of the curve, which contains its
+// end points and the points where the derivative's X or Y projections are zero.
+func bezierBoundingRect(points []*Point) *Rect {
+	var (
+		candidates = []*Point{points[0], points[len(points)-1]}
+		deriv      = bezierDerivativePoints(points)
+	)
+
+	for _, t := range bezierExtremaTParams(deriv) {
+		candidates = append(candidates, bezierPointAt(points, t))
+	}
+
+	rect, _ := MakeRectContaining(candidates)
+	return rect
+}
+
+// bezierExtremaTParams finds the t parameters in (0, 1) where a derivative curve of degree one
+// or two has a zero X or Y projection.
+func bezierExtremaTParams(deriv []*Point) []nums.TParam {
+	var (
+		tParams = []nums.TParam{}
+		xs      = make([]float64, len(deriv))
+		ys      = make([]float64, len(deriv))
+	)
+
+	for i, point := range deriv {
+		xs[i], ys[i] = point.x, point.y
+	}
+
+	for _, coords := range [][]float64{xs, ys} {
+		var roots []float64
+
+		switch len(coords) {
+		case 2:
+			roots = nums.SolveLinear(coords[1]-coords[0], coords[0])
+		case 3:
+			roots = nums.SolveQuadratic(
+				coords[0]-2*coords[1]+coords[2],
+				2*(coords[1]-coords[0]),
+				coords[0],
+			)
+		}
+
+		for _, root := range roots {
+			if root > nums.MinT.Value() && root < nums.MaxT.Value() {
+				tParams = append(tParams, nums.MakeTParam(root))
+			}
+		}
+	}
+
+	return tParams
+}
+
+// bezierLength computes the length of the curve integrating the norm of its derivative.
+func bezierLength(points []*Point) float64 {
+	var (
+		deriv = bezierDerivativePoints(points)
+		speed = func(t float64) float64 {
+			point := bezierPointAt(deriv, nums.MakeTParam(t))
+			return math.Hypot(point.x, point.y)
+		}
+	)
+
+	return adaptiveGaussLegendre(speed, 0, 1, 1e-10, 20)
+}
+
+// bezierIsFlat checks whether all the control points are closer than the tolerance to the
+// chord joining the first and last points. When that's the case, the curve itself is closer
+// than the tolerance to the chord.
+func bezierIsFlat(points []*Point, tolerance float64) bool {
+	chord := MakeSegment(points[0], points[len(points)-1])
+
+	for _, point := range points[1 : len(points)-1] {
+		if chord.DistanceToPoint(point) > tolerance {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bezierFlattened appends to the given slice the points of a polyline that approximates the
+// curve within the tolerance, excluding the curve's start point.
+func bezierFlattened(points []*Point, tolerance float64, maxDepth int, flattened []*Point) []*Point {
+	if maxDepth == 0 || bezierIsFlat(points, tolerance) {
+		return append(flattened, points[len(points)-1])
+	}
+
+	_, left, right := bezierDeCasteljau(points, nums.HalfT)
+	flattened = bezierFlattened(left, tolerance, maxDepth-1, flattened)
+	return bezierFlattened(right, tolerance, maxDepth-1, flattened)
+}
+
+// bezierFlattenedPolyline approximates the curve by a polyline within the given tolerance.
+// A non-nil error is returned if all the control points coincide, as the curve has no length.
+func bezierFlattenedPolyline(points []*Point, tolerance float64) (*Polyline, error) {
+	const maxDepth = 16
+
+	if bezierIsPoint(points) {
+		return nil, errors.New("a Bézier curve whose control points coincide can't be flattened")
+	}
+
+	flattened := bezierFlattened(points, tolerance, maxDepth, []*Point{points[0]})
+	if len(flattened) < 2 {
+		flattened = append(flattened, points[len(points)-1])
+	}
+
+	return MakePolyline(flattened...)
+}
+
+// bezierIsPoint checks whether all the control points of the curve coincide.
+func bezierIsPoint(points []*Point) bool {
+	for _, point := range points[1:] {
+		if !point.Equals(points[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// The five point Gauss–Legendre rule used to compute lengths.
+var lengthRule, _ = nums.MakeGaussLegendreRule(5)
+
+// adaptiveGaussLegendre integrates the function in [a, b], subdividing the interval until
+// the integral of both halves matches that of the whole interval within the tolerance.
+func adaptiveGaussLegendre(f func(float64) float64, a, b, tolerance float64, maxDepth int) float64 {
+	var (
+		middle = 0.5 * (a + b)
+		whole  = lengthRule.Integrate(f, a, b)
+		halves = lengthRule.Integrate(f, a, middle) + lengthRule.Integrate(f, middle, b)
+	)
+
+	if maxDepth == 0 || math.Abs(whole-halves) < tolerance {
+		return halves
+	}
+
+	return adaptiveGaussLegendre(f, a, middle, 0.5*tolerance, maxDepth-1) +
+		adaptiveGaussLegendre(f, middle, b, 0.5*tolerance, maxDepth-1)
+}
@@ -0,0 +1,212 @@
+package g2d
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Circle is the set of points in the plane at a given distance, the radius, from a center
+// point.
+//
+// The circle is parametrized counter-clockwise starting at the point to the right of the
+// center: a t parameter value of 0 corresponds to the angle 0, and a value of 1 to the angle 2π.
+type Circle struct {
+	center *Point
+	radius float64
+}
+
+// MakeCircle creates a new circle given its center point and radius.
+//
+// A non-nil error is returned if the radius isn't greater than zero.
+func MakeCircle(center *Point, radius float64) (*Circle, error) {
+	if radius <= 0.0 || nums.IsCloseToZero(radius) {
+		return nil, errors.New("the radius must be greater than zero")
+	}
+
+	return &Circle{center, radius}, nil
+}
+
+// MakeCircleThroughPoints creates the circle that passes through the three given points.
+//
+// A non-nil error is returned if the points are aligned, as no circle passes through them.
+func MakeCircleThroughPoints(a, b, c *Point) (*Circle, error) {
+	var (
+		ab = a.VectorTo(b)
+		ac = a.VectorTo(c)
+		d  = 2 * ab.CrossTimes(ac)
+	)
+
+	if nums.IsCloseToZero(d) {
+		return nil, errors.New("can't create a circle through aligned points")
+	}
+
+	var (
+		abSq = ab.DotTimes(ab)
+		acSq = ac.DotTimes(ac)
+		ux   = (ac.y*abSq - ab.y*acSq) / d
+		uy   = (ab.x*acSq - ac.x*abSq) / d
+	)
+
+	return MakeCircle(MakePoint(a.x+ux, a.y+uy), math.Sqrt(ux*ux+uy*uy))
+}
+
+// The Center point of the circle.
+func (c *Circle) Center() *Point {
+	return c.center
+}
+
+// The Radius of the circle.
+func (c *Circle) Radius() float64 {
+	return c.radius
+}
+
+// Length computes the perimeter of the circle.
+func (c *Circle) Length() float64 {
+	return 2 * math.Pi * c.radius
+}
+
+// Area computes the area enclosed by the circle.
+func (c *Circle) Area() float64 {
+	return math.Pi * c.radius * c.radius
+}
+
+// PointAt computes the point in the circle at the angle 2πt.
+func (c *Circle) PointAt(t nums.TParam) *Point {
+	return c.pointAtAngle(2 * math.Pi * t.Value())
+}
+
+// TangentAt computes the versor tangent to the circle at the angle 2πt, pointing in the
+// counter-clockwise direction.
+func (c *Circle) TangentAt(t nums.TParam) *Vector {
+	angle := 2 * math.Pi * t.Value()
+	return MakeVector(-math.Sin(angle), math.Cos(angle))
+}
+
+// BoundingRect returns the smallest rectangle containing the circle.
+func (c *Circle) BoundingRect() *Rect {
+	rect, _ := MakeRect(
+		MakePoint(c.center.x-c.radius, c.center.y-c.radius),
+		2*c.radius,
+		2*c.radius,
+	)
+
+	return rect
+}
+
+// ContainsPoint checks whether the given point is inside the circle.
+// Points on the circle aren't considered to be contained.
+func (c *Circle) ContainsPoint(p *Point) bool {
+	return c.ContainsPointTol(p, nums.DefaultTolerance)
+}
+
+// ContainsPointTol checks whether the given point is inside the circle. Points whose distance
+// to the circle is within the given tolerance aren't considered to be contained.
+func (c *Circle) ContainsPointTol(p *Point, tol nums.Tolerance) bool {
+	distance := c.center.DistanceTo(p)
+	return distance < c.radius && !tol.Equal(distance, c.radius)
+}
+
+// IntersectionsWithSegment computes the points where the segment intersects the circle.
+// The result has no points if they don't intersect, one point if the segment is tangent to
+// the circle or only one of its ends is inside, and two points otherwise.
+func (c *Circle) IntersectionsWithSegment(segment *Segment) []*Point {
+	return intersectCircleSegment(c.center, c.radius, segment)
+}
+
+// IntersectionsWithCircle computes the points where the two circles intersect.
+// The result has no points if they don't intersect or are coincident, one point if they are
+// tangent, and two points otherwise.
+func (c *Circle) IntersectionsWithCircle(other *Circle) []*Point {
+	return intersectCircles(c.center, c.radius, other.center, other.radius)
+}
+
+// Equals checks whether this and other circle have equal centers and radii.
+func (c *Circle) Equals(other *Circle) bool {
+	return c.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol checks whether this and other circle have equal centers and radii within the given
+// tolerance.
+func (c *Circle) EqualsTol(other *Circle, tol nums.Tolerance) bool {
+	return c.center.EqualsTol(other.center, tol) && tol.Equal(c.radius, other.radius)
+}
+
+func (c *Circle) pointAtAngle(radians float64) *Point {
+	return MakePoint(
+		c.center.x+c.radius*math.Cos(radians),
+		c.center.y+c.radius*math.Sin(radians),
+	)
+}
+
+func (c *Circle) String() string {
+	return fmt.Sprintf("Circle{center: %v, radius: %f}", c.center, c.radius)
+}
+
+// intersectCircleSegment computes the intersection points between a circle and a segment,
+// ordered from the segment's start to its end.
+func intersectCircleSegment(center *Point, radius float64, segment *Segment) []*Point {
+	var (
+		direction = segment.start.VectorTo(segment.end)
+		toStart   = center.VectorTo(segment.start)
+		a         = direction.DotTimes(direction)
+		b         = 2 * direction.DotTimes(toStart)
+		c         = toStart.DotTimes(toStart) - radius*radius
+		disc      = b*b - 4*a*c
+	)
+
+	if nums.IsCloseToZero(a) || (disc < 0 && !nums.IsCloseToZero(disc)) {
+		return []*Point{}
+	}
+
+	var (
+		points = make([]*Point, 0, 2)
+		sqrt   = math.Sqrt(math.Max(disc, 0))
+		roots  = []float64{(-b - sqrt) / (2 * a), (-b + sqrt) / (2 * a)}
+	)
+
+	if nums.IsCloseToZero(sqrt) {
+		roots = roots[:1]
+	}
+
+	for _, root := range roots {
+		if isBetween(root, nums.MinT.Value(), nums.MaxT.Value(), nums.DefaultTolerance) {
+			points = append(points, segment.PointAt(nums.MakeTParam(root)))
+		}
+	}
+
+	return points
+}
+
+// intersectCircles computes the intersection points between two circles.
+func intersectCircles(centerA *Point, radiusA float64, centerB *Point, radiusB float64) []*Point {
+	var (
+		distance = centerA.DistanceTo(centerB)
+		points   = make([]*Point, 0, 2)
+	)
+
+	if nums.IsCloseToZero(distance) ||
+		distance > radiusA+radiusB && !nums.FloatsEqual(distance, radiusA+radiusB) ||
+		distance < math.Abs(radiusA-radiusB) && !nums.FloatsEqual(distance, math.Abs(radiusA-radiusB)) {
+		return points
+	}
+
+	var (
+		dir    = centerA.VectorTo(centerB).Scaled(1 / distance)
+		a      = (radiusA*radiusA - radiusB*radiusB + distance*distance) / (2 * distance)
+		h      = math.Sqrt(math.Max(radiusA*radiusA-a*a, 0))
+		middle = MakePoint(centerA.x+a*dir.x, centerA.y+a*dir.y)
+	)
+
+	if nums.IsCloseToZero(h) {
+		return append(points, middle)
+	}
+
+	return append(
+		points,
+		MakePoint(middle.x-h*dir.y, middle.y+h*dir.x),
+		MakePoint(middle.x+h*dir.y, middle.y-h*dir.x),
+	)
+}
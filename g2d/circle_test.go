@@ -0,0 +1,153 @@
+package g2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeCircle(t *testing.T) {
+	t.Run("has center and radius", func(t *testing.T) {
+		circle, err := MakeCircle(MakePoint(1, 2), 3)
+
+		assert.Nil(t, err)
+		assert.True(t, circle.Center().Equals(MakePoint(1, 2)))
+		assert.Equal(t, 3.0, circle.Radius())
+	})
+
+	t.Run("can't be created with a zero radius", func(t *testing.T) {
+		circle, err := MakeCircle(MakePoint(1, 2), 0)
+
+		assert.Nil(t, circle)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMakeCircleThroughPoints(t *testing.T) {
+	t.Run("passes through the three points", func(t *testing.T) {
+		var (
+			circle, err = MakeCircleThroughPoints(MakePoint(6, 2), MakePoint(1, 7), MakePoint(-4, 2))
+			want, _     = MakeCircle(MakePoint(1, 2), 5)
+		)
+
+		assert.Nil(t, err)
+		assert.True(t, circle.Equals(want), "Want %v, got %v", want, circle)
+	})
+
+	t.Run("can't be created from aligned points", func(t *testing.T) {
+		circle, err := MakeCircleThroughPoints(MakePoint(0, 0), MakePoint(1, 1), MakePoint(2, 2))
+
+		assert.Nil(t, circle)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestCircleMeasures(t *testing.T) {
+	circle, _ := MakeCircle(MakePoint(1, 2), 2)
+
+	assert.True(t, nums.FloatsEqual(circle.Length(), 4*math.Pi))
+	assert.True(t, nums.FloatsEqual(circle.Area(), 4*math.Pi))
+}
+
+func TestCirclePointAndTangentAt(t *testing.T) {
+	circle, _ := MakeCircle(MakePoint(1, 2), 2)
+
+	t.Run("at the start", func(t *testing.T) {
+		assert.True(t, circle.PointAt(nums.MinT).Equals(MakePoint(3, 2)))
+		assert.True(t, circle.TangentAt(nums.MinT).Equals(JVersor))
+	})
+
+	t.Run("at a quarter turn", func(t *testing.T) {
+		quarter := nums.MakeTParam(0.25)
+
+		assert.True(t, circle.PointAt(quarter).Equals(MakePoint(1, 4)))
+		assert.True(t, circle.TangentAt(quarter).Equals(MakeVector(-1, 0)))
+	})
+}
+
+func TestCircleBoundingRect(t *testing.T) {
+	var (
+		circle, _ = MakeCircle(MakePoint(1, 2), 2)
+		want, _   = MakeRect(MakePoint(-1, 0), 4, 4)
+	)
+
+	assert.True(t, circle.BoundingRect().Equals(want))
+}
+
+func TestCircleContainsPoint(t *testing.T) {
+	circle, _ := MakeCircle(MakePoint(1, 2), 2)
+
+	assert.True(t, circle.ContainsPoint(MakePoint(2, 3)))
+	assert.False(t, circle.ContainsPoint(MakePoint(3, 2)))
+	assert.False(t, circle.ContainsPoint(MakePoint(4, 4)))
+}
+
+func TestCircleIntersectionsWithSegment(t *testing.T) {
+	circle, _ := MakeCircle(MakePoint(0, 0), 5)
+
+	t.Run("secant segment", func(t *testing.T) {
+		points := circle.IntersectionsWithSegment(MakeSegmentFromCoords(-10, 3, 10, 3))
+
+		assertPointsEqual(t, []*Point{MakePoint(-4, 3), MakePoint(4, 3)}, points)
+	})
+
+	t.Run("segment with an end inside", func(t *testing.T) {
+		points := circle.IntersectionsWithSegment(MakeSegmentFromCoords(0, 3, 10, 3))
+
+		assertPointsEqual(t, []*Point{MakePoint(4, 3)}, points)
+	})
+
+	t.Run("tangent segment", func(t *testing.T) {
+		points := circle.IntersectionsWithSegment(MakeSegmentFromCoords(-10, 5, 10, 5))
+
+		assertPointsEqual(t, []*Point{MakePoint(0, 5)}, points)
+	})
+
+	t.Run("external segment", func(t *testing.T) {
+		points := circle.IntersectionsWithSegment(MakeSegmentFromCoords(-10, 6, 10, 6))
+
+		assert.Empty(t, points)
+	})
+
+	t.Run("segment inside", func(t *testing.T) {
+		points := circle.IntersectionsWithSegment(MakeSegmentFromCoords(-1, 0, 1, 0))
+
+		assert.Empty(t, points)
+	})
+}
+
+func TestCircleIntersectionsWithCircle(t *testing.T) {
+	circle, _ := MakeCircle(MakePoint(0, 0), 5)
+
+	t.Run("secant circles", func(t *testing.T) {
+		var (
+			other, _ = MakeCircle(MakePoint(8, 0), 5)
+			points   = circle.IntersectionsWithCircle(other)
+		)
+
+		assertPointsEqual(t, []*Point{MakePoint(4, 3), MakePoint(4, -3)}, points)
+	})
+
+	t.Run("tangent circles", func(t *testing.T) {
+		var (
+			other, _ = MakeCircle(MakePoint(8, 0), 3)
+			points   = circle.IntersectionsWithCircle(other)
+		)
+
+		assertPointsEqual(t, []*Point{MakePoint(5, 0)}, points)
+	})
+
+	t.Run("disjoint circles", func(t *testing.T) {
+		other, _ := MakeCircle(MakePoint(20, 0), 3)
+
+		assert.Empty(t, circle.IntersectionsWithCircle(other))
+	})
+
+	t.Run("concentric circles", func(t *testing.T) {
+		other, _ := MakeCircle(MakePoint(0, 0), 3)
+
+		assert.Empty(t, circle.IntersectionsWithCircle(other))
+	})
+}
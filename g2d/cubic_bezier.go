@@ -0,0 +1,89 @@
+package g2d
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A CubicBezier is a cubic Bézier curve, defined by a start point, two control points and an
+// end point. The curve starts at t = 0 and ends at t = 1.
+type CubicBezier struct {
+	start, controlA, controlB, end *Point
+}
+
+// MakeCubicBezier creates a new cubic Bézier curve given its start, control and end points.
+func MakeCubicBezier(start, controlA, controlB, end *Point) *CubicBezier {
+	return &CubicBezier{start, controlA, controlB, end}
+}
+
+// The Start point of the curve.
+func (b *CubicBezier) Start() *Point {
+	return b.start
+}
+
+// The ControlA point defines the direction of the curve at the start point.
+func (b *CubicBezier) ControlA() *Point {
+	return b.controlA
+}
+
+// The ControlB point defines the direction of the curve at the end point.
+func (b *CubicBezier) ControlB() *Point {
+	return b.controlB
+}
+
+// The End point of the curve.
+func (b *CubicBezier) End() *Point {
+	return b.end
+}
+
+// ControlPoints returns the start, both control and end points, in that order.
+func (b *CubicBezier) ControlPoints() []*Point {
+	return []*Point{b.start, b.controlA, b.controlB, b.end}
+}
+
+// PointAt computes an intermediate point in the curve.
+func (b *CubicBezier) PointAt(t nums.TParam) *Point {
+	return bezierPointAt(b.ControlPoints(), t)
+}
+
+// FirstDerivativeAt computes the derivative of the curve with respect to t, a vector tangent to
+// the curve.
+func (b *CubicBezier) FirstDerivativeAt(t nums.TParam) *Vector {
+	return bezierDerivativeAt(b.ControlPoints(), 1, t)
+}
+
+// SecondDerivativeAt computes the second derivative of the curve with respect to t.
+func (b *CubicBezier) SecondDerivativeAt(t nums.TParam) *Vector {
+	return bezierDerivativeAt(b.ControlPoints(), 2, t)
+}
+
+// SplitAt divides the curve in two at the given t parameter, returning the curves before and
+// after the split point.
+func (b *CubicBezier) SplitAt(t nums.TParam) (*CubicBezier, *CubicBezier) {
+	_, left, right := bezierDeCasteljau(b.ControlPoints(), t)
+	return MakeCubicBezier(left[0], left[1], left[2], left[3]),
+		MakeCubicBezier(right[0], right[1], right[2], right[3])
+}
+
+// BoundingRect returns the smallest rectangle containing the curve.
+func (b *CubicBezier) BoundingRect() *Rect {
+	return bezierBoundingRect(b.ControlPoints())
+}
+
+// Length computes the arc length of the curve.
+func (b *CubicBezier) Length() float64 {
+	return bezierLength(b.ControlPoints())
+}
+
+// Flattened approximates the curve by a polyline whose distance to the curve is smaller than the
+// given tolerance.
+//
+// A non-nil error is returned if all the control points coincide, as the curve is a single point.
+func (b *CubicBezier) Flattened(tolerance float64) (*Polyline, error) {
+	return bezierFlattenedPolyline(b.ControlPoints(), tolerance)
+}
+
+func (b *CubicBezier) String() string {
+	return fmt.Sprintf("CubicBezier{%v, %v, %v, %v}", b.start, b.controlA, b.controlB, b.end)
+}
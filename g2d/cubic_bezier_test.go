@@ -0,0 +1,101 @@
+package g2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestCubicBezier() *CubicBezier {
+	return MakeCubicBezier(MakePoint(0, 0), MakePoint(0, 1), MakePoint(1, 1), MakePoint(1, 0))
+}
+
+func TestCubicBezierPointAt(t *testing.T) {
+	curve := makeTestCubicBezier()
+
+	assert.True(t, curve.PointAt(nums.MinT).Equals(MakePoint(0, 0)))
+	assert.True(t, curve.PointAt(nums.HalfT).Equals(MakePoint(0.5, 0.75)))
+	assert.True(t, curve.PointAt(nums.MaxT).Equals(MakePoint(1, 0)))
+}
+
+func TestCubicBezierDerivatives(t *testing.T) {
+	curve := makeTestCubicBezier()
+
+	assert.True(t, curve.FirstDerivativeAt(nums.MinT).Equals(MakeVector(0, 3)))
+	assert.True(t, curve.FirstDerivativeAt(nums.HalfT).Equals(MakeVector(1.5, 0)))
+	assert.True(t, curve.SecondDerivativeAt(nums.MinT).Equals(MakeVector(6, -6)))
+}
+
+func TestCubicBezierSplitAt(t *testing.T) {
+	var (
+		curve       = makeTestCubicBezier()
+		left, right = curve.SplitAt(nums.HalfT)
+		tParam      = nums.MakeTParam(0.3)
+	)
+
+	assert.True(t, left.End().Equals(MakePoint(0.5, 0.75)))
+	assert.True(t, right.Start().Equals(MakePoint(0.5, 0.75)))
+	assert.True(t, left.PointAt(tParam).Equals(curve.PointAt(nums.MakeTParam(0.15))))
+	assert.True(t, right.PointAt(tParam).Equals(curve.PointAt(nums.MakeTParam(0.65))))
+}
+
+func TestCubicBezierBoundingRect(t *testing.T) {
+	var (
+		curve   = makeTestCubicBezier()
+		want, _ = MakeRect(MakePoint(0, 0), 1, 0.75)
+	)
+
+	assert.True(t, curve.BoundingRect().Equals(want), "Want %v, got %v", want, curve.BoundingRect())
+}
+
+func TestCubicBezierLength(t *testing.T) {
+	curve := makeTestCubicBezier()
+
+	assert.True(t, nums.FloatsEqualEps(curve.Length(), 2.0, 1e-9))
+}
+
+func TestCubicBezierFlattened(t *testing.T) {
+	var (
+		curve        = makeTestCubicBezier()
+		tolerance    = 0.01
+		flattened, _ = curve.Flattened(tolerance)
+	)
+
+	assert.True(t, flattened.Start().Equals(curve.Start()))
+	assert.True(t, flattened.End().Equals(curve.End()))
+
+	for _, tParam := range nums.SubTParamCompleteRangeTimes(20) {
+		var (
+			point    = curve.PointAt(tParam)
+			distance = flattened.Segments()[0].DistanceToPoint(point)
+		)
+
+		for _, segment := range flattened.Segments()[1:] {
+			distance = math.Min(distance, segment.DistanceToPoint(point))
+		}
+
+		assert.LessOrEqual(t, distance, tolerance)
+	}
+
+	t.Run("coincident control points", func(t *testing.T) {
+		var (
+			p              = MakePoint(1, 1)
+			flattened, err = MakeCubicBezier(p, p, p, p).Flattened(0.1)
+		)
+
+		assert.Nil(t, flattened)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("coincident ends", func(t *testing.T) {
+		var (
+			p              = MakePoint(1, 1)
+			flattened, err = MakeCubicBezier(p, MakePoint(2, 3), MakePoint(3, 1), p).Flattened(0.1)
+		)
+
+		assert.Nil(t, err)
+		assert.True(t, flattened.Start().Equals(p))
+	})
+}
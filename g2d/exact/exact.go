@@ -0,0 +1,36 @@
+// Package exact has the basic two-dimensional primitives backed by arbitrary precision rational
+// numbers, so that the geometric predicates and constructions don't have rounding errors.
+//
+// Exact arithmetic is much slower than floating-point arithmetic, and the size of the numbers
+// grows with every operation. It's meant to be used as a fallback for the hard cases where the
+// rounding errors break the topology of a model, converting from and to the g2d primitives.
+package exact
+
+import (
+	"math/big"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// ErrNotFinite is returned when converting a NaN or infinite float to a rational number.
+var ErrNotFinite = nums.ErrNotFinite
+
+// ratFromFloat returns the rational number with the exact value of the float.
+func ratFromFloat(value float64) (*big.Rat, error) {
+	rat := new(big.Rat).SetFloat64(value)
+	if rat == nil {
+		return nil, ErrNotFinite
+	}
+
+	return rat, nil
+}
+
+// ratToFloat returns the float closest to the rational number.
+func ratToFloat(value *big.Rat) float64 {
+	float, _ := value.Float64()
+	return float
+}
+
+func copyRat(value *big.Rat) *big.Rat {
+	return new(big.Rat).Set(value)
+}
@@ -0,0 +1,27 @@
+package exact
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestRatConversions(t *testing.T) {
+	t.Run("non-finite floats", func(t *testing.T) {
+		for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			if _, err := ratFromFloat(value); err != ErrNotFinite {
+				t.Errorf("Want ErrNotFinite for %f, got %v", value, err)
+			}
+		}
+	})
+
+	t.Run("exact values", func(t *testing.T) {
+		rat, _ := ratFromFloat(0.1)
+		if rat.Cmp(big.NewRat(1, 10)) == 0 {
+			t.Error("Expected 0.1 not to be exactly 1/10")
+		}
+		if got := ratToFloat(rat); got != 0.1 {
+			t.Errorf("Want 0.1, got %v", got)
+		}
+	})
+}
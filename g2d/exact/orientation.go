@@ -0,0 +1,22 @@
+package exact
+
+import "github.com/angelsolaorbaiceta/inkgeom/g2d"
+
+// OrientationSign returns 1 if the points a, b and c are in counter-clockwise order, -1 if they
+// are in clockwise order and 0 if they are aligned.
+func OrientationSign(a, b, c *Point) int {
+	return a.VectorTo(b).CrossTimes(a.VectorTo(c)).Sign()
+}
+
+// OrientationOf returns the orientation of the triangle defined by the points a, b and c. The
+// second returned value is false if the points are aligned, and thus there is no orientation.
+func OrientationOf(a, b, c *Point) (g2d.Orientation, bool) {
+	switch OrientationSign(a, b, c) {
+	case 1:
+		return g2d.CounterClockwise, true
+	case -1:
+		return g2d.Clockwise, true
+	default:
+		return g2d.CounterClockwise, false
+	}
+}
@@ -0,0 +1,56 @@
+package exact
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func mustPoint(t *testing.T, x, y float64) *Point {
+	t.Helper()
+
+	p, err := MakePointFromFloats(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func TestOrientation(t *testing.T) {
+	var (
+		a = mustPoint(t, 0, 0)
+		b = mustPoint(t, 1, 0)
+		c = mustPoint(t, 0, 1)
+	)
+
+	t.Run("counter-clockwise", func(t *testing.T) {
+		if got, ok := OrientationOf(a, b, c); !ok || got != g2d.CounterClockwise {
+			t.Errorf("Want CounterClockwise, got %v", got)
+		}
+	})
+
+	t.Run("clockwise", func(t *testing.T) {
+		if got, ok := OrientationOf(a, c, b); !ok || got != g2d.Clockwise {
+			t.Errorf("Want Clockwise, got %v", got)
+		}
+	})
+
+	t.Run("aligned", func(t *testing.T) {
+		if _, ok := OrientationOf(a, b, mustPoint(t, 3, 0)); ok {
+			t.Error("Expected no orientation")
+		}
+	})
+
+	t.Run("nearly aligned points", func(t *testing.T) {
+		var (
+			p = mustPoint(t, 0.5, 0.5)
+			q = mustPoint(t, 12, 12)
+			r = mustPoint(t, 24, 24.000000000000004)
+		)
+
+		if got := OrientationSign(p, q, r); got != 1 {
+			t.Errorf("Want 1, got %d", got)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package exact
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+// A Point is a position in the two-dimensional space with rational coordinates.
+type Point struct {
+	x, y *big.Rat
+}
+
+// MakePoint creates a new point with copies of the given X and Y coordinates.
+func MakePoint(x, y *big.Rat) *Point {
+	return &Point{copyRat(x), copyRat(y)}
+}
+
+// MakePointFromFloats creates a new point with the exact values of the given coordinates.
+// An ErrNotFinite error is returned if any of the coordinates is NaN or infinite.
+func MakePointFromFloats(x, y float64) (*Point, error) {
+	ratX, err := ratFromFloat(x)
+	if err != nil {
+		return nil, err
+	}
+
+	ratY, err := ratFromFloat(y)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Point{ratX, ratY}, nil
+}
+
+// FromG2DPoint creates a new point with the exact values of the coordinates of the given point.
+// An ErrNotFinite error is returned if any of the coordinates is NaN or infinite.
+func FromG2DPoint(p *g2d.Point) (*Point, error) {
+	return MakePointFromFloats(p.X(), p.Y())
+}
+
+// X returns a copy of the X coordinate of the point.
+func (p *Point) X() *big.Rat {
+	return copyRat(p.x)
+}
+
+// Y returns a copy of the Y coordinate of the point.
+func (p *Point) Y() *big.Rat {
+	return copyRat(p.y)
+}
+
+// ToG2D returns the point with the floating-point coordinates closest to this point's.
+func (p *Point) ToG2D() *g2d.Point {
+	return g2d.MakePoint(ratToFloat(p.x), ratToFloat(p.y))
+}
+
+// VectorTo computes the vector from this point to the other.
+func (from *Point) VectorTo(to *Point) *Vector {
+	return &Vector{
+		new(big.Rat).Sub(to.x, from.x),
+		new(big.Rat).Sub(to.y, from.y),
+	}
+}
+
+// Displaced returns the point resulting from displacing this one by the given vector.
+func (p *Point) Displaced(v *Vector) *Point {
+	return &Point{
+		new(big.Rat).Add(p.x, v.x),
+		new(big.Rat).Add(p.y, v.y),
+	}
+}
+
+// Equals returns true if both points have exactly the same coordinates.
+func (p *Point) Equals(other *Point) bool {
+	return p.x.Cmp(other.x) == 0 && p.y.Cmp(other.y) == 0
+}
+
+// Compare returns -1 if this point goes before the other, 0 if they are equal and 1 if this
+// point goes after the other, comparing first the X and then the Y coordinates.
+func (p *Point) Compare(other *Point) int {
+	if cmp := p.x.Cmp(other.x); cmp != 0 {
+		return cmp
+	}
+
+	return p.y.Cmp(other.y)
+}
+
+func (p *Point) String() string {
+	return fmt.Sprintf("(%s, %s)", p.x.RatString(), p.y.RatString())
+}
@@ -0,0 +1,69 @@
+package exact
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestPointConversion(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		original := g2d.MakePoint(0.1, -3.75)
+
+		p, err := FromG2DPoint(original)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := p.ToG2D(); got.X() != original.X() || got.Y() != original.Y() {
+			t.Errorf("Want %v, got %v", original, got)
+		}
+	})
+
+	t.Run("non-finite coordinates", func(t *testing.T) {
+		if _, err := MakePointFromFloats(math.NaN(), 0); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestPointOperations(t *testing.T) {
+	var (
+		p = MakePoint(big.NewRat(1, 3), big.NewRat(1, 2))
+		q = MakePoint(big.NewRat(2, 3), big.NewRat(-1, 2))
+	)
+
+	t.Run("vector to", func(t *testing.T) {
+		want := MakeVector(big.NewRat(1, 3), big.NewRat(-1, 1))
+		if got := p.VectorTo(q); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("displaced", func(t *testing.T) {
+		if got := p.Displaced(p.VectorTo(q)); !got.Equals(q) {
+			t.Errorf("Want %v, got %v", q, got)
+		}
+	})
+
+	t.Run("compare", func(t *testing.T) {
+		if p.Compare(q) != -1 || q.Compare(p) != 1 || p.Compare(p) != 0 {
+			t.Error("Wrong point ordering")
+		}
+	})
+
+	t.Run("coordinates are copies", func(t *testing.T) {
+		p.X().SetInt64(10)
+		if p.X().Cmp(big.NewRat(1, 3)) != 0 {
+			t.Error("Expected the point not to be modified")
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if got := p.String(); got != "(1/3, 1/2)" {
+			t.Errorf("Want (1/3, 1/2), got %s", got)
+		}
+	})
+}
@@ -0,0 +1,188 @@
+package exact
+
+import (
+	"math/big"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+// A Segment is a straight line defined between two points with rational coordinates.
+type Segment struct {
+	start, end *Point
+}
+
+// An IntersectionKind describes how two segments intersect.
+type IntersectionKind int
+
+const (
+	// NoIntersection means the segments have no point in common.
+	NoIntersection IntersectionKind = iota
+	// PointIntersection means the segments have a single point in common.
+	PointIntersection
+	// OverlapIntersection means the segments are collinear and share a part of positive length.
+	OverlapIntersection
+)
+
+// A SegmentIntersection is the result of intersecting two segments. Point is only defined for
+// the PointIntersection kind, and Overlap only for the OverlapIntersection kind.
+type SegmentIntersection struct {
+	Kind    IntersectionKind
+	Point   *Point
+	Overlap *Segment
+}
+
+// MakeSegment creates a new segment defined between the given start and end points.
+func MakeSegment(start, end *Point) *Segment {
+	return &Segment{start, end}
+}
+
+// FromG2DSegment creates a new segment with the exact values of the coordinates of the given
+// segment's points. An ErrNotFinite error is returned if any of them is NaN or infinite.
+func FromG2DSegment(s *g2d.Segment) (*Segment, error) {
+	start, err := FromG2DPoint(s.Start())
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := FromG2DPoint(s.End())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Segment{start, end}, nil
+}
+
+// Start returns the start point of the segment.
+func (s *Segment) Start() *Point {
+	return s.start
+}
+
+// End returns the end point of the segment.
+func (s *Segment) End() *Point {
+	return s.end
+}
+
+// ToG2D returns the segment between the floating-point points closest to this segment's.
+func (s *Segment) ToG2D() *g2d.Segment {
+	return g2d.MakeSegment(s.start.ToG2D(), s.end.ToG2D())
+}
+
+// PointAt returns the point at the given parameter: the start point for t = 0 and the end
+// point for t = 1. Values of t outside of [0, 1] give points in the segment's line.
+func (s *Segment) PointAt(t *big.Rat) *Point {
+	return s.start.Displaced(s.start.VectorTo(s.end).Scaled(t))
+}
+
+// IsDegenerate returns true if the start and end points of the segment are the same.
+func (s *Segment) IsDegenerate() bool {
+	return s.start.Equals(s.end)
+}
+
+// ContainsPoint checks whether the point lies exactly on the segment, ends included.
+func (s *Segment) ContainsPoint(p *Point) bool {
+	return OrientationSign(s.start, s.end, p) == 0 && s.containsCollinearPoint(p)
+}
+
+// Intersects checks whether this and the other segment have at least a point in common.
+// Segments that touch at one of their ends, or that overlap, are considered to intersect.
+func (s *Segment) Intersects(other *Segment) bool {
+	return s.Intersection(other).Kind != NoIntersection
+}
+
+// Intersection computes the exact intersection between this and the other segment.
+func (s *Segment) Intersection(other *Segment) *SegmentIntersection {
+	switch {
+	case s.IsDegenerate():
+		return degenerateIntersection(s.start, other)
+	case other.IsDegenerate():
+		return degenerateIntersection(other.start, s)
+	}
+
+	var (
+		r       = s.start.VectorTo(s.end)
+		q       = other.start.VectorTo(other.end)
+		toOther = s.start.VectorTo(other.start)
+		denom   = r.CrossTimes(q)
+	)
+
+	if denom.Sign() == 0 {
+		if toOther.CrossTimes(r).Sign() != 0 {
+			return &SegmentIntersection{Kind: NoIntersection}
+		}
+
+		return s.collinearIntersection(other, r, q, toOther)
+	}
+
+	var (
+		t = new(big.Rat).Quo(toOther.CrossTimes(q), denom)
+		u = new(big.Rat).Quo(toOther.CrossTimes(r), denom)
+	)
+
+	if !isUnitParam(t) || !isUnitParam(u) {
+		return &SegmentIntersection{Kind: NoIntersection}
+	}
+
+	return &SegmentIntersection{Kind: PointIntersection, Point: s.PointAt(t)}
+}
+
+// collinearIntersection intersects two collinear segments, projecting the other segment onto
+// this one's parameter space.
+func (s *Segment) collinearIntersection(other *Segment, r, q, toOther *Vector) *SegmentIntersection {
+	var (
+		rr   = r.DotTimes(r)
+		t0   = new(big.Rat).Quo(toOther.DotTimes(r), rr)
+		t1   = new(big.Rat).Add(t0, new(big.Rat).Quo(q.DotTimes(r), rr))
+		low  = maxRat(minRat(t0, t1), new(big.Rat))
+		high = minRat(maxRat(t0, t1), big.NewRat(1, 1))
+	)
+
+	switch low.Cmp(high) {
+	case 1:
+		return &SegmentIntersection{Kind: NoIntersection}
+	case 0:
+		return &SegmentIntersection{Kind: PointIntersection, Point: s.PointAt(low)}
+	default:
+		return &SegmentIntersection{
+			Kind:    OverlapIntersection,
+			Overlap: MakeSegment(s.PointAt(low), s.PointAt(high)),
+		}
+	}
+}
+
+// containsCollinearPoint checks whether a point, known to be aligned with the segment, lies
+// between its start and end points.
+func (s *Segment) containsCollinearPoint(p *Point) bool {
+	return isBetween(p.x, s.start.x, s.end.x) && isBetween(p.y, s.start.y, s.end.y)
+}
+
+func degenerateIntersection(p *Point, s *Segment) *SegmentIntersection {
+	if s.ContainsPoint(p) {
+		return &SegmentIntersection{Kind: PointIntersection, Point: p}
+	}
+
+	return &SegmentIntersection{Kind: NoIntersection}
+}
+
+// isBetween checks whether the value is in the range defined by a and b, ends included.
+func isBetween(value, a, b *big.Rat) bool {
+	return value.Cmp(minRat(a, b)) >= 0 && value.Cmp(maxRat(a, b)) <= 0
+}
+
+// isUnitParam checks whether the value is in [0, 1].
+func isUnitParam(value *big.Rat) bool {
+	return value.Sign() >= 0 && value.Cmp(big.NewRat(1, 1)) <= 0
+}
+
+func minRat(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+func maxRat(a, b *big.Rat) *big.Rat {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
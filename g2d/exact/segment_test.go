@@ -0,0 +1,124 @@
+package exact
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func mustSegment(t *testing.T, startX, startY, endX, endY float64) *Segment {
+	t.Helper()
+	return MakeSegment(mustPoint(t, startX, startY), mustPoint(t, endX, endY))
+}
+
+func TestSegmentIntersection(t *testing.T) {
+	t.Run("crossing segments", func(t *testing.T) {
+		var (
+			s = mustSegment(t, 0, 0, 1, 1)
+			o = mustSegment(t, 0, 1, 3, -2)
+			i = s.Intersection(o)
+		)
+
+		if i.Kind != PointIntersection {
+			t.Fatalf("Want a point intersection, got %v", i.Kind)
+		}
+		if want := MakePoint(big.NewRat(1, 2), big.NewRat(1, 2)); !i.Point.Equals(want) {
+			t.Errorf("Want %v, got %v", want, i.Point)
+		}
+	})
+
+	t.Run("exact intersection point", func(t *testing.T) {
+		var (
+			s = mustSegment(t, 0, 0, 3, 1)
+			o = mustSegment(t, 0, 1, 1, 0)
+			i = s.Intersection(o)
+		)
+
+		if want := MakePoint(big.NewRat(3, 4), big.NewRat(1, 4)); i.Kind != PointIntersection || !i.Point.Equals(want) {
+			t.Errorf("Want %v, got %v", want, i.Point)
+		}
+	})
+
+	t.Run("touching at an end", func(t *testing.T) {
+		var (
+			s = mustSegment(t, 0, 0, 2, 0)
+			o = mustSegment(t, 1, 0, 1, 5)
+		)
+
+		if i := s.Intersection(o); i.Kind != PointIntersection || !i.Point.Equals(mustPoint(t, 1, 0)) {
+			t.Errorf("Want a point intersection at (1, 0), got %v", i)
+		}
+	})
+
+	t.Run("disjoint segments", func(t *testing.T) {
+		if s, o := mustSegment(t, 0, 0, 1, 1), mustSegment(t, 2, 0, 3, -1); s.Intersects(o) {
+			t.Error("Expected no intersection")
+		}
+	})
+
+	t.Run("parallel segments", func(t *testing.T) {
+		if s, o := mustSegment(t, 0, 0, 1, 1), mustSegment(t, 0, 1, 1, 2); s.Intersects(o) {
+			t.Error("Expected no intersection")
+		}
+	})
+
+	t.Run("overlapping segments", func(t *testing.T) {
+		var (
+			s = mustSegment(t, 0, 0, 4, 4)
+			o = mustSegment(t, 5, 5, 2, 2)
+			i = s.Intersection(o)
+		)
+
+		if i.Kind != OverlapIntersection {
+			t.Fatalf("Want an overlap, got %v", i.Kind)
+		}
+		if !i.Overlap.Start().Equals(mustPoint(t, 2, 2)) || !i.Overlap.End().Equals(mustPoint(t, 4, 4)) {
+			t.Errorf("Want overlap from (2, 2) to (4, 4), got %v to %v", i.Overlap.Start(), i.Overlap.End())
+		}
+	})
+
+	t.Run("collinear segments touching at an end", func(t *testing.T) {
+		s, o := mustSegment(t, 0, 0, 1, 0), mustSegment(t, 1, 0, 2, 0)
+		if i := s.Intersection(o); i.Kind != PointIntersection || !i.Point.Equals(mustPoint(t, 1, 0)) {
+			t.Errorf("Want a point intersection at (1, 0), got %v", i)
+		}
+	})
+
+	t.Run("collinear disjoint segments", func(t *testing.T) {
+		if s, o := mustSegment(t, 0, 0, 1, 0), mustSegment(t, 2, 0, 3, 0); s.Intersects(o) {
+			t.Error("Expected no intersection")
+		}
+	})
+
+	t.Run("degenerate segment", func(t *testing.T) {
+		var (
+			s = mustSegment(t, 0, 0, 2, 2)
+			d = mustSegment(t, 1, 1, 1, 1)
+		)
+
+		if !s.Intersects(d) || !d.Intersects(s) {
+			t.Error("Expected an intersection")
+		}
+		if d.Intersects(mustSegment(t, 0, 1, 1, 2)) {
+			t.Error("Expected no intersection")
+		}
+	})
+}
+
+func TestSegmentConversion(t *testing.T) {
+	original := g2d.MakeSegmentFromCoords(0.1, 0.2, 0.3, 0.4)
+
+	s, err := FromG2DSegment(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := s.ToG2D()
+	if got.Start().X() != 0.1 || got.End().Y() != 0.4 {
+		t.Errorf("Want %v, got %v", original, got)
+	}
+	if !s.ContainsPoint(s.PointAt(big.NewRat(1, 3))) {
+		t.Error("Expected the segment to contain its own points")
+	}
+}
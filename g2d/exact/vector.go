@@ -0,0 +1,112 @@
+package exact
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+// A Vector is a direction in the plane with rational projections in the X and Y axes.
+type Vector struct {
+	x, y *big.Rat
+}
+
+// MakeVector creates a new vector with copies of the given projections.
+func MakeVector(x, y *big.Rat) *Vector {
+	return &Vector{copyRat(x), copyRat(y)}
+}
+
+// FromG2DVector creates a new vector with the exact values of the projections of the given
+// vector. An ErrNotFinite error is returned if any of the projections is NaN or infinite.
+func FromG2DVector(v *g2d.Vector) (*Vector, error) {
+	x, err := ratFromFloat(v.X())
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := ratFromFloat(v.Y())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vector{x, y}, nil
+}
+
+// X returns a copy of the projection of the vector in the X axis.
+func (v *Vector) X() *big.Rat {
+	return copyRat(v.x)
+}
+
+// Y returns a copy of the projection of the vector in the Y axis.
+func (v *Vector) Y() *big.Rat {
+	return copyRat(v.y)
+}
+
+// ToG2D returns the vector with the floating-point projections closest to this vector's.
+func (v *Vector) ToG2D() *g2d.Vector {
+	return g2d.MakeVector(ratToFloat(v.x), ratToFloat(v.y))
+}
+
+// IsZero returns true if both projections of the vector are zero.
+func (v *Vector) IsZero() bool {
+	return v.x.Sign() == 0 && v.y.Sign() == 0
+}
+
+// Scaled creates a new vector with the projections scaled by the given factor.
+func (v *Vector) Scaled(factor *big.Rat) *Vector {
+	return &Vector{
+		new(big.Rat).Mul(v.x, factor),
+		new(big.Rat).Mul(v.y, factor),
+	}
+}
+
+// Plus creates a new vector, result of adding this and the other vector.
+func (v *Vector) Plus(other *Vector) *Vector {
+	return &Vector{
+		new(big.Rat).Add(v.x, other.x),
+		new(big.Rat).Add(v.y, other.y),
+	}
+}
+
+// Minus creates a new vector, result of subtracting the other vector from this one.
+func (v *Vector) Minus(other *Vector) *Vector {
+	return &Vector{
+		new(big.Rat).Sub(v.x, other.x),
+		new(big.Rat).Sub(v.y, other.y),
+	}
+}
+
+// DotTimes computes the dot product of this vector with the other.
+func (v *Vector) DotTimes(other *Vector) *big.Rat {
+	var (
+		xx = new(big.Rat).Mul(v.x, other.x)
+		yy = new(big.Rat).Mul(v.y, other.y)
+	)
+
+	return xx.Add(xx, yy)
+}
+
+// CrossTimes computes the Z projection of the cross product of this vector with the other.
+func (v *Vector) CrossTimes(other *Vector) *big.Rat {
+	var (
+		xy = new(big.Rat).Mul(v.x, other.y)
+		yx = new(big.Rat).Mul(v.y, other.x)
+	)
+
+	return xy.Sub(xy, yx)
+}
+
+// IsParallelTo checks whether this and the other vector are exactly parallel.
+func (v *Vector) IsParallelTo(other *Vector) bool {
+	return v.CrossTimes(other).Sign() == 0
+}
+
+// Equals returns true if both vectors have exactly the same projections.
+func (v *Vector) Equals(other *Vector) bool {
+	return v.x.Cmp(other.x) == 0 && v.y.Cmp(other.y) == 0
+}
+
+func (v *Vector) String() string {
+	return fmt.Sprintf("{%s, %s}", v.x.RatString(), v.y.RatString())
+}
@@ -0,0 +1,68 @@
+package exact
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestVectorOperations(t *testing.T) {
+	var (
+		u = MakeVector(big.NewRat(1, 3), big.NewRat(2, 1))
+		v = MakeVector(big.NewRat(1, 1), big.NewRat(6, 1))
+		w = MakeVector(big.NewRat(-6, 1), big.NewRat(1, 1))
+	)
+
+	t.Run("dot product", func(t *testing.T) {
+		if got := v.DotTimes(w); got.Sign() != 0 {
+			t.Errorf("Want 0, got %s", got.RatString())
+		}
+	})
+
+	t.Run("cross product", func(t *testing.T) {
+		if got, want := v.CrossTimes(w), big.NewRat(37, 1); got.Cmp(want) != 0 {
+			t.Errorf("Want %s, got %s", want.RatString(), got.RatString())
+		}
+	})
+
+	t.Run("exact parallelism", func(t *testing.T) {
+		if !u.IsParallelTo(v) {
+			t.Error("Expected the vectors to be parallel")
+		}
+		if u.IsParallelTo(w) {
+			t.Error("Expected the vectors not to be parallel")
+		}
+	})
+
+	t.Run("plus and minus", func(t *testing.T) {
+		if got := u.Plus(v).Minus(v); !got.Equals(u) {
+			t.Errorf("Want %v, got %v", u, got)
+		}
+	})
+
+	t.Run("scaled", func(t *testing.T) {
+		if got := u.Scaled(big.NewRat(3, 1)); !got.Equals(v) {
+			t.Errorf("Want %v, got %v", v, got)
+		}
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		if !u.Minus(u).IsZero() || u.IsZero() {
+			t.Error("Wrong zero check")
+		}
+	})
+}
+
+func TestVectorConversion(t *testing.T) {
+	original := g2d.MakeVector(1e-300, 7.5)
+
+	v, err := FromG2DVector(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := v.ToG2D(); got.X() != original.X() || got.Y() != original.Y() {
+		t.Errorf("Want %v, got %v", original, got)
+	}
+}
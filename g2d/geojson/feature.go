@@ -0,0 +1,104 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	featureType           = "Feature"
+	featureCollectionType = "FeatureCollection"
+)
+
+// A Feature is a geometry with its properties. Both the geometry and the properties may be nil,
+// and the ID, if not nil, is a string or a number.
+type Feature struct {
+	ID         any
+	Geometry   *Geometry
+	Properties map[string]any
+}
+
+// A FeatureCollection is a list of features.
+type FeatureCollection struct {
+	Features []*Feature
+}
+
+// MakeFeature creates a new feature with the given geometry and properties.
+func MakeFeature(geometry *Geometry, properties map[string]any) *Feature {
+	return &Feature{Geometry: geometry, Properties: properties}
+}
+
+// MakeFeatureCollection creates a new collection with the given features.
+func MakeFeatureCollection(features ...*Feature) *FeatureCollection {
+	return &FeatureCollection{features}
+}
+
+type featureJSON struct {
+	Type       string         `json:"type"`
+	ID         any            `json:"id,omitempty"`
+	Geometry   *Geometry      `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// MarshalJSON encodes the feature as a GeoJSON Feature object.
+func (f *Feature) MarshalJSON() ([]byte, error) {
+	return json.Marshal(featureJSON{featureType, f.ID, f.Geometry, f.Properties})
+}
+
+// UnmarshalJSON decodes the feature from a GeoJSON Feature object.
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	var raw featureJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Type != featureType {
+		return fmt.Errorf("%w: want type %q, got %q", ErrInvalid, featureType, raw.Type)
+	}
+
+	switch raw.ID.(type) {
+	case nil, string, float64:
+	default:
+		return fmt.Errorf("%w: a feature ID must be a string or a number", ErrInvalid)
+	}
+
+	f.ID, f.Geometry, f.Properties = raw.ID, raw.Geometry, raw.Properties
+	return nil
+}
+
+type featureCollectionJSON struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// MarshalJSON encodes the collection as a GeoJSON FeatureCollection object.
+func (c *FeatureCollection) MarshalJSON() ([]byte, error) {
+	features := c.Features
+	if features == nil {
+		features = []*Feature{}
+	}
+
+	return json.Marshal(featureCollectionJSON{featureCollectionType, features})
+}
+
+// UnmarshalJSON decodes the collection from a GeoJSON FeatureCollection object.
+func (c *FeatureCollection) UnmarshalJSON(data []byte) error {
+	var raw featureCollectionJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Type != featureCollectionType {
+		return fmt.Errorf("%w: want type %q, got %q", ErrInvalid, featureCollectionType, raw.Type)
+	}
+	if raw.Features == nil {
+		return fmt.Errorf("%w: a feature collection must have a features list", ErrInvalid)
+	}
+
+	for _, feature := range raw.Features {
+		if feature == nil {
+			return fmt.Errorf("%w: features can't be null", ErrInvalid)
+		}
+	}
+
+	c.Features = raw.Features
+	return nil
+}
@@ -0,0 +1,89 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestFeatureCollection(t *testing.T) {
+	t.Run("encode", func(t *testing.T) {
+		var (
+			column = MakeFeature(MakePointGeometry(g2d.MakePoint(1, 2)), map[string]any{"section": "HEB200"})
+			empty  = &Feature{ID: "note"}
+			got    = mustMarshal(t, MakeFeatureCollection(column, empty))
+			want   = `{"type":"FeatureCollection","features":[` +
+				`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2]},"properties":{"section":"HEB200"}},` +
+				`{"type":"Feature","id":"note","geometry":null,"properties":null}]}`
+		)
+
+		if got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("encode empty collection", func(t *testing.T) {
+		if got, want := mustMarshal(t, MakeFeatureCollection()), `{"type":"FeatureCollection","features":[]}`; got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		var collection FeatureCollection
+		err := json.Unmarshal([]byte(`{
+			"type": "FeatureCollection",
+			"features": [
+				{
+					"type": "Feature",
+					"id": 7,
+					"geometry": {"type": "LineString", "coordinates": [[0, 0], [6, 8]]},
+					"properties": {"load": 12.5, "tags": ["beam"]}
+				},
+				{"type": "Feature", "geometry": null, "properties": null}
+			]
+		}`), &collection)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(collection.Features) != 2 {
+			t.Fatalf("Want 2 features, got %d", len(collection.Features))
+		}
+
+		beam := collection.Features[0]
+		if beam.ID != 7.0 {
+			t.Errorf("Want ID 7, got %v", beam.ID)
+		}
+		if got := beam.Geometry.LineString.Length(); got != 10 {
+			t.Errorf("Want length 10, got %f", got)
+		}
+		if got := beam.Properties["load"]; got != 12.5 {
+			t.Errorf("Want load 12.5, got %v", got)
+		}
+		if collection.Features[1].Geometry != nil {
+			t.Error("Expected a nil geometry")
+		}
+	})
+
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"wrong collection type", `{"type":"Feature","features":[]}`},
+		{"missing features", `{"type":"FeatureCollection"}`},
+		{"null feature", `{"type":"FeatureCollection","features":[null]}`},
+		{"wrong feature type", `{"type":"FeatureCollection","features":[{"type":"Point"}]}`},
+		{"invalid feature ID", `{"type":"FeatureCollection","features":[{"type":"Feature","id":true}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var collection FeatureCollection
+			if err := json.Unmarshal([]byte(tt.json), &collection); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Want ErrInvalid, got %v", err)
+			}
+		})
+	}
+}
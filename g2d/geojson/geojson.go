@@ -0,0 +1,21 @@
+// Package geojson encodes and decodes two-dimensional geometry in the GeoJSON format, defined
+// by RFC 7946.
+//
+// The Point, LineString, Polygon and MultiPolygon geometries are mapped onto the g2d point,
+// polyline and polygon types, and features keep their properties as a map. The other
+// geometry types aren't supported.
+//
+// Polygons are encoded following the right-hand rule: the shell is counter-clockwise and the
+// holes are clockwise. Their rings are closed in the JSON, repeating the first position at the
+// end, but the g2d polygons don't repeat it. When decoding, a third element in a position, the
+// altitude, is ignored.
+package geojson
+
+import "errors"
+
+var (
+	// ErrInvalid is returned when the JSON is valid, but isn't a valid GeoJSON object.
+	ErrInvalid = errors.New("invalid GeoJSON")
+	// ErrUnsupportedType is returned when decoding a GeoJSON object whose type isn't supported.
+	ErrUnsupportedType = errors.New("unsupported GeoJSON type")
+)
@@ -0,0 +1,299 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// A GeometryType is the type of a GeoJSON geometry.
+type GeometryType string
+
+// The supported geometry types.
+const (
+	PointType        GeometryType = "Point"
+	LineStringType   GeometryType = "LineString"
+	PolygonType      GeometryType = "Polygon"
+	MultiPolygonType GeometryType = "MultiPolygon"
+)
+
+// A Polygon is a shell with zero or more holes.
+type Polygon struct {
+	Shell *g2d.Polygon
+	Holes []*g2d.Polygon
+}
+
+// A Geometry is a GeoJSON geometry object. Only the field for its type is defined.
+type Geometry struct {
+	Type         GeometryType
+	Point        *g2d.Point
+	LineString   *g2d.Polyline
+	Polygon      *Polygon
+	MultiPolygon []*Polygon
+}
+
+// MakePointGeometry creates a new Point geometry.
+func MakePointGeometry(p *g2d.Point) *Geometry {
+	return &Geometry{Type: PointType, Point: p}
+}
+
+// MakeLineStringGeometry creates a new LineString geometry.
+func MakeLineStringGeometry(polyline *g2d.Polyline) *Geometry {
+	return &Geometry{Type: LineStringType, LineString: polyline}
+}
+
+// MakePolygonGeometry creates a new Polygon geometry with the given shell and holes.
+func MakePolygonGeometry(shell *g2d.Polygon, holes ...*g2d.Polygon) *Geometry {
+	return &Geometry{Type: PolygonType, Polygon: &Polygon{shell, holes}}
+}
+
+// MakeMultiPolygonGeometry creates a new MultiPolygon geometry.
+func MakeMultiPolygonGeometry(polygons ...*Polygon) *Geometry {
+	return &Geometry{Type: MultiPolygonType, MultiPolygon: polygons}
+}
+
+type geometryJSON struct {
+	Type        GeometryType    `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+type (
+	position        = []float64
+	positionList    = []position
+	ringList        = []positionList
+	polygonPosition = []ringList
+)
+
+// MarshalJSON encodes the geometry as a GeoJSON geometry object.
+func (g *Geometry) MarshalJSON() ([]byte, error) {
+	var coordinates any
+
+	switch g.Type {
+	case PointType:
+		coordinates = pointPosition(g.Point)
+	case LineStringType:
+		coordinates = pointPositions(g.LineString.Points())
+	case PolygonType:
+		coordinates = polygonPositions(g.Polygon)
+	case MultiPolygonType:
+		polygons := make(polygonPosition, len(g.MultiPolygon))
+		for i, polygon := range g.MultiPolygon {
+			polygons[i] = polygonPositions(polygon)
+		}
+		coordinates = polygons
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedType, g.Type)
+	}
+
+	rawCoordinates, err := json.Marshal(coordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(geometryJSON{g.Type, rawCoordinates})
+}
+
+// UnmarshalJSON decodes the geometry from a GeoJSON geometry object.
+func (g *Geometry) UnmarshalJSON(data []byte) error {
+	var raw geometryJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch raw.Type {
+	case PointType, LineStringType, PolygonType, MultiPolygonType:
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedType, raw.Type)
+	}
+
+	if raw.Coordinates == nil {
+		return fmt.Errorf("%w: a geometry must have coordinates", ErrInvalid)
+	}
+
+	decoded := Geometry{Type: raw.Type}
+
+	switch raw.Type {
+	case PointType:
+		var coordinates position
+		if err := json.Unmarshal(raw.Coordinates, &coordinates); err != nil {
+			return err
+		}
+
+		point, err := makePoint(coordinates)
+		if err != nil {
+			return err
+		}
+		decoded.Point = point
+
+	case LineStringType:
+		var coordinates positionList
+		if err := json.Unmarshal(raw.Coordinates, &coordinates); err != nil {
+			return err
+		}
+
+		polyline, err := makePolyline(coordinates)
+		if err != nil {
+			return err
+		}
+		decoded.LineString = polyline
+
+	case PolygonType:
+		var coordinates ringList
+		if err := json.Unmarshal(raw.Coordinates, &coordinates); err != nil {
+			return err
+		}
+
+		polygon, err := makePolygon(coordinates)
+		if err != nil {
+			return err
+		}
+		decoded.Polygon = polygon
+
+	case MultiPolygonType:
+		var coordinates polygonPosition
+		if err := json.Unmarshal(raw.Coordinates, &coordinates); err != nil {
+			return err
+		}
+
+		decoded.MultiPolygon = make([]*Polygon, len(coordinates))
+		for i, rings := range coordinates {
+			polygon, err := makePolygon(rings)
+			if err != nil {
+				return err
+			}
+			decoded.MultiPolygon[i] = polygon
+		}
+	}
+
+	*g = decoded
+	return nil
+}
+
+/* <-- Encoding --> */
+
+func pointPosition(p *g2d.Point) position {
+	return position{p.X(), p.Y()}
+}
+
+func pointPositions(points []*g2d.Point) positionList {
+	positions := make(positionList, len(points))
+	for i, p := range points {
+		positions[i] = pointPosition(p)
+	}
+
+	return positions
+}
+
+// polygonPositions returns the closed rings of the polygon, following the right-hand rule.
+func polygonPositions(polygon *Polygon) ringList {
+	rings := make(ringList, 0, 1+len(polygon.Holes))
+	rings = append(rings, ringPositions(polygon.Shell, true))
+	for _, hole := range polygon.Holes {
+		rings = append(rings, ringPositions(hole, false))
+	}
+
+	return rings
+}
+
+func ringPositions(ring *g2d.Polygon, counterClockwise bool) positionList {
+	var (
+		vertices  = ring.Vertices()
+		n         = len(vertices)
+		positions = make(positionList, n+1)
+		reversed  = (ring.SignedArea() > 0) != counterClockwise
+	)
+
+	for i, vertex := range vertices {
+		if reversed {
+			positions[n-1-i] = pointPosition(vertex)
+		} else {
+			positions[i] = pointPosition(vertex)
+		}
+	}
+	positions[n] = positions[0]
+
+	return positions
+}
+
+/* <-- Decoding --> */
+
+func makePoint(coordinates position) (*g2d.Point, error) {
+	if len(coordinates) < 2 || len(coordinates) > 3 {
+		return nil, fmt.Errorf("%w: a position must have two or three elements", ErrInvalid)
+	}
+	if err := floatenc.CheckFinite(coordinates...); err != nil {
+		return nil, err
+	}
+
+	return g2d.MakePoint(coordinates[0], coordinates[1]), nil
+}
+
+func makePoints(coordinates positionList) ([]*g2d.Point, error) {
+	points := make([]*g2d.Point, len(coordinates))
+	for i, position := range coordinates {
+		point, err := makePoint(position)
+		if err != nil {
+			return nil, err
+		}
+		points[i] = point
+	}
+
+	return points, nil
+}
+
+func makePolyline(coordinates positionList) (*g2d.Polyline, error) {
+	points, err := makePoints(coordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	polyline, err := g2d.MakePolyline(points...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	return polyline, nil
+}
+
+func makePolygon(coordinates ringList) (*Polygon, error) {
+	if len(coordinates) == 0 {
+		return nil, fmt.Errorf("%w: a polygon must have at least one ring", ErrInvalid)
+	}
+
+	rings := make([]*g2d.Polygon, len(coordinates))
+	for i, ringCoordinates := range coordinates {
+		ring, err := makeRing(ringCoordinates)
+		if err != nil {
+			return nil, err
+		}
+		rings[i] = ring
+	}
+
+	return &Polygon{rings[0], rings[1:]}, nil
+}
+
+// makeRing creates the polygon for a closed ring of positions.
+func makeRing(coordinates positionList) (*g2d.Polygon, error) {
+	if len(coordinates) < 4 {
+		return nil, fmt.Errorf("%w: a ring must have at least four positions", ErrInvalid)
+	}
+
+	points, err := makePoints(coordinates)
+	if err != nil {
+		return nil, err
+	}
+
+	first, last := points[0], points[len(points)-1]
+	if first.X() != last.X() || first.Y() != last.Y() {
+		return nil, fmt.Errorf("%w: the rings must be closed", ErrInvalid)
+	}
+
+	ring, err := g2d.MakePolygon(points[:len(points)-1]...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	return ring, nil
+}
@@ -0,0 +1,159 @@
+package geojson
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func mustPolygon(t *testing.T, coords ...float64) *g2d.Polygon {
+	t.Helper()
+
+	points := make([]*g2d.Point, 0, len(coords)/2)
+	for i := 0; i < len(coords); i += 2 {
+		points = append(points, g2d.MakePoint(coords[i], coords[i+1]))
+	}
+
+	polygon, err := g2d.MakePolygon(points...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return polygon
+}
+
+func mustMarshal(t *testing.T, value any) string {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func TestEncodeGeometry(t *testing.T) {
+	t.Run("point", func(t *testing.T) {
+		got := mustMarshal(t, MakePointGeometry(g2d.MakePoint(1.5, 2)))
+		if want := `{"type":"Point","coordinates":[1.5,2]}`; got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("line string", func(t *testing.T) {
+		polyline, _ := g2d.MakePolyline(g2d.MakePoint(0, 0), g2d.MakePoint(1, 1))
+		got := mustMarshal(t, MakeLineStringGeometry(polyline))
+		if want := `{"type":"LineString","coordinates":[[0,0],[1,1]]}`; got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("polygon follows the right-hand rule", func(t *testing.T) {
+		var (
+			clockwiseShell = mustPolygon(t, 0, 0, 0, 4, 4, 4, 4, 0)
+			ccwHole        = mustPolygon(t, 1, 1, 2, 1, 2, 2)
+			got            = mustMarshal(t, MakePolygonGeometry(clockwiseShell, ccwHole))
+			want           = `{"type":"Polygon","coordinates":[` +
+				`[[4,0],[4,4],[0,4],[0,0],[4,0]],` +
+				`[[2,2],[2,1],[1,1],[2,2]]]}`
+		)
+
+		if got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("multi polygon", func(t *testing.T) {
+		var (
+			a   = &Polygon{Shell: mustPolygon(t, 0, 0, 1, 0, 0, 1)}
+			b   = &Polygon{Shell: mustPolygon(t, 5, 5, 6, 5, 5, 6)}
+			got = mustMarshal(t, MakeMultiPolygonGeometry(a, b))
+		)
+
+		want := `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[0,1],[0,0]]],[[[5,5],[6,5],[5,6],[5,5]]]]}`
+		if got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		if _, err := json.Marshal(&Geometry{Type: "Circle"}); !errors.Is(err, ErrUnsupportedType) {
+			t.Errorf("Want ErrUnsupportedType, got %v", err)
+		}
+	})
+}
+
+func TestDecodeGeometry(t *testing.T) {
+	t.Run("point with altitude", func(t *testing.T) {
+		var g Geometry
+		if err := json.Unmarshal([]byte(`{"type":"Point","coordinates":[1,2,30]}`), &g); err != nil {
+			t.Fatal(err)
+		}
+		if g.Type != PointType || !g.Point.Equals(g2d.MakePoint(1, 2)) {
+			t.Errorf("Want point (1, 2), got %+v", g)
+		}
+	})
+
+	t.Run("polygon with hole", func(t *testing.T) {
+		var g Geometry
+		err := json.Unmarshal([]byte(`{
+			"type": "Polygon",
+			"coordinates": [
+				[[0, 0], [10, 0], [10, 10], [0, 10], [0, 0]],
+				[[2, 2], [2, 4], [4, 4], [2, 2]]
+			]
+		}`), &g)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !nums.FloatsEqual(g.Polygon.Shell.Area(), 100) || len(g.Polygon.Holes) != 1 {
+			t.Errorf("Unexpected polygon %+v", g.Polygon)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		var (
+			original = MakeMultiPolygonGeometry(
+				&Polygon{Shell: mustPolygon(t, 0, 0, 3, 0, 3, 3), Holes: []*g2d.Polygon{mustPolygon(t, 2, 1, 1, 0.5, 2, 2)}},
+			)
+			encoded = mustMarshal(t, original)
+			decoded Geometry
+		)
+
+		if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if got := mustMarshal(t, &decoded); got != encoded {
+			t.Errorf("Want %s, got %s", encoded, got)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		json    string
+		wantErr error
+	}{
+		{"unsupported type", `{"type":"MultiPoint","coordinates":[[0,0]]}`, ErrUnsupportedType},
+		{"geometry collection", `{"type":"GeometryCollection","geometries":[]}`, ErrUnsupportedType},
+		{"missing coordinates", `{"type":"Point"}`, ErrInvalid},
+		{"short position", `{"type":"Point","coordinates":[1]}`, ErrInvalid},
+		{"short line string", `{"type":"LineString","coordinates":[[1,1]]}`, ErrInvalid},
+		{"open ring", `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`, ErrInvalid},
+		{"short ring", `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`, ErrInvalid},
+		{"no rings", `{"type":"Polygon","coordinates":[]}`, ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var g Geometry
+			if err := json.Unmarshal([]byte(tt.json), &g); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
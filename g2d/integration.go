@@ -0,0 +1,61 @@
+package g2d
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// IntegrateTriangle approximates the integral of the function over the triangle with vertices
+// a, b and c, using the given triangle rule (see nums.MakeTriangleRule).
+func IntegrateTriangle(a, b, c *Point, f func(p *Point) float64, rule *nums.ElementRule) float64 {
+	var (
+		ab       = a.VectorTo(b)
+		ac       = a.VectorTo(c)
+		jacobian = math.Abs(ab.CrossTimes(ac))
+	)
+
+	return jacobian * rule.Integrate(func(coords []float64) float64 {
+		return f(MakePoint(
+			a.x+coords[0]*ab.x+coords[1]*ac.x,
+			a.y+coords[0]*ab.y+coords[1]*ac.y,
+		))
+	})
+}
+
+// IntegrateQuad approximates the integral of the function over the quadrilateral with vertices
+// a, b, c and d, in order, using the given quadrilateral rule (see nums.MakeQuadRule).
+// The reference square is mapped to the quadrilateral using bilinear shape functions, so the
+// quadrilateral must be convex.
+func IntegrateQuad(a, b, c, d *Point, f func(p *Point) float64, rule *nums.ElementRule) float64 {
+	vertices := [4]*Point{a, b, c, d}
+
+	return rule.Integrate(func(coords []float64) float64 {
+		var (
+			xi, eta       = coords[0], coords[1]
+			x, y          float64
+			dxDxi, dxDeta float64
+			dyDxi, dyDeta float64
+			signsXi       = [4]float64{-1, 1, 1, -1}
+			signsEta      = [4]float64{-1, -1, 1, 1}
+		)
+
+		for i, vertex := range vertices {
+			var (
+				shape     = 0.25 * (1 + signsXi[i]*xi) * (1 + signsEta[i]*eta)
+				shapeDxi  = 0.25 * signsXi[i] * (1 + signsEta[i]*eta)
+				shapeDeta = 0.25 * signsEta[i] * (1 + signsXi[i]*xi)
+			)
+
+			x += shape * vertex.x
+			y += shape * vertex.y
+			dxDxi += shapeDxi * vertex.x
+			dxDeta += shapeDeta * vertex.x
+			dyDxi += shapeDxi * vertex.y
+			dyDeta += shapeDeta * vertex.y
+		}
+
+		jacobian := math.Abs(dxDxi*dyDeta - dxDeta*dyDxi)
+		return jacobian * f(MakePoint(x, y))
+	})
+}
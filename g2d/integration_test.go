@@ -0,0 +1,59 @@
+package g2d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestIntegrateTriangle(t *testing.T) {
+	var (
+		a, b, c = MakePoint(1, 1), MakePoint(4, 1), MakePoint(1, 3)
+		rule, _ = nums.MakeTriangleRule(2)
+	)
+
+	t.Run("the integral of one is the area", func(t *testing.T) {
+		got := IntegrateTriangle(a, b, c, func(*Point) float64 { return 1 }, rule)
+		if !nums.FloatsEqual(got, 3) {
+			t.Errorf("Want 3, got %f", got)
+		}
+	})
+
+	t.Run("the vertex order doesn't change the result", func(t *testing.T) {
+		var (
+			f    = func(p *Point) float64 { return p.x * p.y }
+			ccw  = IntegrateTriangle(a, b, c, f, rule)
+			cw   = IntegrateTriangle(a, c, b, f, rule)
+			want = 9.5
+		)
+
+		if !nums.FloatsEqual(ccw, cw) {
+			t.Errorf("Want equal results, got %f and %f", ccw, cw)
+		}
+		if !nums.FloatsEqual(ccw, want) {
+			t.Errorf("Want %f, got %f", want, ccw)
+		}
+	})
+}
+
+func TestIntegrateQuad(t *testing.T) {
+	var (
+		rule, _ = nums.MakeQuadRule(2)
+		a, b    = MakePoint(0, 0), MakePoint(4, 0)
+		c, d    = MakePoint(3, 2), MakePoint(1, 2)
+	)
+
+	t.Run("the integral of one is the area", func(t *testing.T) {
+		got := IntegrateQuad(a, b, c, d, func(*Point) float64 { return 1 }, rule)
+		if !nums.FloatsEqual(got, 6) {
+			t.Errorf("Want 6, got %f", got)
+		}
+	})
+
+	t.Run("integrates a linear function", func(t *testing.T) {
+		got := IntegrateQuad(a, b, c, d, func(p *Point) float64 { return p.y }, rule)
+		if want := 16.0 / 3; !nums.FloatsEqual(got, want) {
+			t.Errorf("Want %f, got %f", want, got)
+		}
+	})
+}
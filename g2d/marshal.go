@@ -0,0 +1,312 @@
+package g2d
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// The primitives are encoded as:
+//
+//   - JSON: objects with the fields "x" and "y" for points and vectors, "origin", "width" and
+//     "height" for rectangles, and "start" and "end" for segments.
+//   - Text: their numbers separated by spaces. "x y" for points and vectors, "x y width height"
+//     for rectangles and "startX startY endX endY" for segments.
+//   - Binary: the same numbers as in the text encoding, as little-endian IEEE 754 values.
+//
+// Decoding validates the values: coordinates must be finite, and the rectangles' sizes can't
+// be negative.
+
+// ErrMissingFields is returned when decoding a JSON object without some of the required fields.
+var ErrMissingFields = errors.New("the JSON object is missing required fields")
+
+var (
+	_ json.Marshaler             = (*Point)(nil)
+	_ json.Unmarshaler           = (*Point)(nil)
+	_ encoding.TextMarshaler     = (*Point)(nil)
+	_ encoding.TextUnmarshaler   = (*Point)(nil)
+	_ encoding.BinaryMarshaler   = (*Point)(nil)
+	_ encoding.BinaryUnmarshaler = (*Point)(nil)
+
+	_ json.Marshaler             = (*Vector)(nil)
+	_ json.Unmarshaler           = (*Vector)(nil)
+	_ encoding.TextMarshaler     = (*Vector)(nil)
+	_ encoding.TextUnmarshaler   = (*Vector)(nil)
+	_ encoding.BinaryMarshaler   = (*Vector)(nil)
+	_ encoding.BinaryUnmarshaler = (*Vector)(nil)
+
+	_ json.Marshaler             = (*Rect)(nil)
+	_ json.Unmarshaler           = (*Rect)(nil)
+	_ encoding.TextMarshaler     = (*Rect)(nil)
+	_ encoding.TextUnmarshaler   = (*Rect)(nil)
+	_ encoding.BinaryMarshaler   = (*Rect)(nil)
+	_ encoding.BinaryUnmarshaler = (*Rect)(nil)
+
+	_ json.Marshaler             = (*Segment)(nil)
+	_ json.Unmarshaler           = (*Segment)(nil)
+	_ encoding.TextMarshaler     = (*Segment)(nil)
+	_ encoding.TextUnmarshaler   = (*Segment)(nil)
+	_ encoding.BinaryMarshaler   = (*Segment)(nil)
+	_ encoding.BinaryUnmarshaler = (*Segment)(nil)
+)
+
+type xyJSON struct {
+	X *float64 `json:"x"`
+	Y *float64 `json:"y"`
+}
+
+func (xy xyJSON) values() (float64, float64, error) {
+	if xy.X == nil || xy.Y == nil {
+		return 0, 0, fmt.Errorf("%w: x and y", ErrMissingFields)
+	}
+
+	return *xy.X, *xy.Y, nil
+}
+
+/* <-- Point --> */
+
+// MarshalJSON encodes the point as a JSON object with its x and y coordinates.
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(xyJSON{&p.x, &p.y})
+}
+
+// UnmarshalJSON decodes the point from a JSON object with its x and y coordinates.
+// Returns an ErrMissingFields error if any of the coordinates is missing.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	var xy xyJSON
+	if err := json.Unmarshal(data, &xy); err != nil {
+		return err
+	}
+
+	x, y, err := xy.values()
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y = x, y
+	return nil
+}
+
+// MarshalText encodes the point as its coordinates separated by a space.
+func (p Point) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(p.x, p.y), nil
+}
+
+// UnmarshalText decodes the point from its coordinates separated by a space.
+func (p *Point) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 2)
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y = values[0], values[1]
+	return nil
+}
+
+// MarshalBinary encodes the point as its two coordinates.
+func (p Point) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(p.x, p.y), nil
+}
+
+// UnmarshalBinary decodes the point from its two coordinates.
+func (p *Point) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 2)
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y = values[0], values[1]
+	return nil
+}
+
+/* <-- Vector --> */
+
+// MarshalJSON encodes the vector as a JSON object with its x and y projections.
+func (v Vector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(xyJSON{&v.x, &v.y})
+}
+
+// UnmarshalJSON decodes the vector from a JSON object with its x and y projections.
+// Returns an ErrMissingFields error if any of the projections is missing.
+func (v *Vector) UnmarshalJSON(data []byte) error {
+	var xy xyJSON
+	if err := json.Unmarshal(data, &xy); err != nil {
+		return err
+	}
+
+	x, y, err := xy.values()
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y = x, y
+	return nil
+}
+
+// MarshalText encodes the vector as its projections separated by a space.
+func (v Vector) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(v.x, v.y), nil
+}
+
+// UnmarshalText decodes the vector from its projections separated by a space.
+func (v *Vector) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 2)
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y = values[0], values[1]
+	return nil
+}
+
+// MarshalBinary encodes the vector as its two projections.
+func (v Vector) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(v.x, v.y), nil
+}
+
+// UnmarshalBinary decodes the vector from its two projections.
+func (v *Vector) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 2)
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y = values[0], values[1]
+	return nil
+}
+
+/* <-- Rect --> */
+
+type rectJSON struct {
+	Origin *Point   `json:"origin"`
+	Width  *float64 `json:"width"`
+	Height *float64 `json:"height"`
+}
+
+// MarshalJSON encodes the rectangle as a JSON object with its origin, width and height.
+func (r Rect) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rectJSON{r.origin, &r.width, &r.height})
+}
+
+// UnmarshalJSON decodes the rectangle from a JSON object with its origin, width and height.
+// Returns an ErrMissingFields error if any of them is missing, and a non-nil error if the width
+// or height are negative.
+func (r *Rect) UnmarshalJSON(data []byte) error {
+	var rect rectJSON
+	if err := json.Unmarshal(data, &rect); err != nil {
+		return err
+	}
+	if rect.Origin == nil || rect.Width == nil || rect.Height == nil {
+		return fmt.Errorf("%w: origin, width and height", ErrMissingFields)
+	}
+
+	return r.set(rect.Origin, *rect.Width, *rect.Height)
+}
+
+// MarshalText encodes the rectangle as the coordinates of its origin, its width and its height,
+// separated by spaces.
+func (r Rect) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(r.origin.x, r.origin.y, r.width, r.height), nil
+}
+
+// UnmarshalText decodes the rectangle from the coordinates of its origin, its width and its
+// height, separated by spaces. A non-nil error is returned if the width or height are negative.
+func (r *Rect) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 4)
+	if err != nil {
+		return err
+	}
+
+	return r.set(MakePoint(values[0], values[1]), values[2], values[3])
+}
+
+// MarshalBinary encodes the rectangle as the coordinates of its origin, its width and its
+// height.
+func (r Rect) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(r.origin.x, r.origin.y, r.width, r.height), nil
+}
+
+// UnmarshalBinary decodes the rectangle from the coordinates of its origin, its width and its
+// height. A non-nil error is returned if the width or height are negative.
+func (r *Rect) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 4)
+	if err != nil {
+		return err
+	}
+
+	return r.set(MakePoint(values[0], values[1]), values[2], values[3])
+}
+
+func (r *Rect) set(origin *Point, width, height float64) error {
+	rect, err := MakeRect(origin, width, height)
+	if err != nil {
+		return err
+	}
+
+	*r = *rect
+	return nil
+}
+
+/* <-- Segment --> */
+
+type segmentJSON struct {
+	Start *Point `json:"start"`
+	End   *Point `json:"end"`
+}
+
+// MarshalJSON encodes the segment as a JSON object with its start and end points.
+func (s Segment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(segmentJSON{s.start, s.end})
+}
+
+// UnmarshalJSON decodes the segment from a JSON object with its start and end points.
+// Returns an ErrMissingFields error if any of them is missing.
+func (s *Segment) UnmarshalJSON(data []byte) error {
+	var segment segmentJSON
+	if err := json.Unmarshal(data, &segment); err != nil {
+		return err
+	}
+	if segment.Start == nil || segment.End == nil {
+		return fmt.Errorf("%w: start and end", ErrMissingFields)
+	}
+
+	s.start, s.end = segment.Start, segment.End
+	return nil
+}
+
+// MarshalText encodes the segment as the coordinates of its start and end points, separated by
+// spaces.
+func (s Segment) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(s.start.x, s.start.y, s.end.x, s.end.y), nil
+}
+
+// UnmarshalText decodes the segment from the coordinates of its start and end points, separated
+// by spaces.
+func (s *Segment) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 4)
+	if err != nil {
+		return err
+	}
+
+	s.start, s.end = MakePoint(values[0], values[1]), MakePoint(values[2], values[3])
+	return nil
+}
+
+// MarshalBinary encodes the segment as the coordinates of its start and end points.
+func (s Segment) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(s.start.x, s.start.y, s.end.x, s.end.y), nil
+}
+
+// UnmarshalBinary decodes the segment from the coordinates of its start and end points.
+func (s *Segment) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 4)
+	if err != nil {
+		return err
+	}
+
+	s.start, s.end = MakePoint(values[0], values[1]), MakePoint(values[2], values[3])
+	return nil
+}
@@ -0,0 +1,213 @@
+package g2d
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestPointMarshaling(t *testing.T) {
+	p := MakePoint(1.5, -0.1)
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"x":1.5,"y":-0.1}` {
+			t.Errorf("Unexpected JSON %s", data)
+		}
+
+		var got Point
+		if err := json.Unmarshal(data, &got); err != nil || !got.Equals(p) {
+			t.Errorf("Want %v, got %v (%v)", p, &got, err)
+		}
+	})
+
+	t.Run("JSON of a value", func(t *testing.T) {
+		value := struct {
+			Position Point  `json:"position"`
+			Velocity Vector `json:"velocity"`
+		}{MakePointVal(1.5, -0.1), MakeVectorVal(2, 0)}
+
+		data, err := json.Marshal(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := `{"position":{"x":1.5,"y":-0.1},"velocity":{"x":2,"y":0}}`; string(data) != want {
+			t.Errorf("Want %s, got %s", want, data)
+		}
+	})
+
+	t.Run("JSON missing a coordinate", func(t *testing.T) {
+		var got Point
+		if err := json.Unmarshal([]byte(`{"x":1}`), &got); !errors.Is(err, ErrMissingFields) {
+			t.Errorf("Want ErrMissingFields, got %v", err)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		text, _ := p.MarshalText()
+		if string(text) != "1.5 -0.1" {
+			t.Errorf("Unexpected text %q", text)
+		}
+
+		var got Point
+		if err := got.UnmarshalText(text); err != nil || !got.Equals(p) {
+			t.Errorf("Want %v, got %v (%v)", p, &got, err)
+		}
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		data, _ := p.MarshalBinary()
+
+		var got Point
+		if err := got.UnmarshalBinary(data); err != nil || got.x != p.x || got.y != p.y {
+			t.Errorf("Want %v, got %v (%v)", p, &got, err)
+		}
+	})
+
+	t.Run("non-finite coordinates", func(t *testing.T) {
+		var got Point
+		if err := got.UnmarshalText([]byte("1 +Inf")); !errors.Is(err, nums.ErrNotFinite) {
+			t.Errorf("Want ErrNotFinite, got %v", err)
+		}
+	})
+}
+
+func TestVectorMarshaling(t *testing.T) {
+	v := MakeVector(3, 4)
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fromJSON Vector
+	if err := json.Unmarshal(data, &fromJSON); err != nil || !fromJSON.Equals(v) {
+		t.Errorf("Want %v, got %v (%v)", v, fromJSON, err)
+	}
+
+	var (
+		binary, _  = v.MarshalBinary()
+		fromBinary Vector
+	)
+	if err := fromBinary.UnmarshalBinary(binary); err != nil || !fromBinary.Equals(v) {
+		t.Errorf("Want %v, got %v (%v)", v, fromBinary, err)
+	}
+
+	var fromText Vector
+	if err := fromText.UnmarshalText([]byte("3 4 5")); err == nil {
+		t.Error("Expected an error for too many projections")
+	}
+}
+
+func TestRectMarshaling(t *testing.T) {
+	rect, _ := MakeRect(MakePoint(1, 2), 10, 20)
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(rect)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"origin":{"x":1,"y":2},"width":10,"height":20}` {
+			t.Errorf("Unexpected JSON %s", data)
+		}
+
+		var got Rect
+		if err := json.Unmarshal(data, &got); err != nil || !got.Equals(rect) {
+			t.Errorf("Want %v, got %v (%v)", rect, &got, err)
+		}
+	})
+
+	t.Run("text and binary", func(t *testing.T) {
+		var (
+			text, _    = rect.MarshalText()
+			binary, _  = rect.MarshalBinary()
+			fromText   Rect
+			fromBinary Rect
+		)
+
+		if err := fromText.UnmarshalText(text); err != nil || !fromText.Equals(rect) {
+			t.Errorf("Want %v, got %v (%v)", rect, &fromText, err)
+		}
+		if err := fromBinary.UnmarshalBinary(binary); err != nil || !fromBinary.Equals(rect) {
+			t.Errorf("Want %v, got %v (%v)", rect, &fromBinary, err)
+		}
+	})
+
+	t.Run("negative size", func(t *testing.T) {
+		var got Rect
+		if err := json.Unmarshal([]byte(`{"origin":{"x":0,"y":0},"width":-1,"height":2}`), &got); err == nil {
+			t.Error("Expected an error")
+		}
+		if err := got.UnmarshalText([]byte("0 0 1 -2")); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("JSON missing the height", func(t *testing.T) {
+		var got Rect
+		if err := json.Unmarshal([]byte(`{"origin":{"x":0,"y":0},"width":1}`), &got); !errors.Is(err, ErrMissingFields) {
+			t.Errorf("Want ErrMissingFields, got %v", err)
+		}
+	})
+}
+
+func TestSegmentMarshaling(t *testing.T) {
+	segment := MakeSegmentFromCoords(1, 2, 3, 4)
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(segment)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got Segment
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Start().Equals(segment.Start()) || !got.End().Equals(segment.End()) {
+			t.Errorf("Want %s, got %s", data, mustMarshalJSON(t, &got))
+		}
+	})
+
+	t.Run("JSON missing an end", func(t *testing.T) {
+		var got Segment
+		if err := json.Unmarshal([]byte(`{"start":{"x":0,"y":0}}`), &got); !errors.Is(err, ErrMissingFields) {
+			t.Errorf("Want ErrMissingFields, got %v", err)
+		}
+	})
+
+	t.Run("text and binary", func(t *testing.T) {
+		var (
+			text, _    = segment.MarshalText()
+			binary, _  = segment.MarshalBinary()
+			fromText   Segment
+			fromBinary Segment
+		)
+
+		if string(text) != "1 2 3 4" {
+			t.Errorf("Unexpected text %q", text)
+		}
+		if err := fromText.UnmarshalText(text); err != nil || !fromText.End().Equals(segment.End()) {
+			t.Errorf("Wrong segment decoded from text (%v)", err)
+		}
+		if err := fromBinary.UnmarshalBinary(binary); err != nil || !fromBinary.Start().Equals(segment.Start()) {
+			t.Errorf("Wrong segment decoded from binary (%v)", err)
+		}
+	})
+}
+
+func mustMarshalJSON(t *testing.T, value any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
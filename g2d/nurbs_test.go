@@ -0,0 +1,164 @@
+package g2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+// makeQuarterCircle returns the exact NURBS representation of the unit circle's first quadrant.
+func makeQuarterCircle() *NURBS {
+	var (
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 1, 1, 1)
+		curve, _ = MakeNURBS(
+			2,
+			[]*Point{MakePoint(1, 0), MakePoint(1, 1), MakePoint(0, 1)},
+			[]float64{1, math.Sqrt2 / 2, 1},
+			knots,
+		)
+	)
+
+	return curve
+}
+
+func TestMakeNURBS(t *testing.T) {
+	var (
+		points   = []*Point{MakePoint(0, 0), MakePoint(1, 1), MakePoint(2, 0)}
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 1, 1, 1)
+	)
+
+	t.Run("requires a weight per control point", func(t *testing.T) {
+		_, err := MakeNURBS(2, points, []float64{1, 1}, knots)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("requires positive weights", func(t *testing.T) {
+		_, err := MakeNURBS(2, points, []float64{1, 0, 1}, knots)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("requires a matching number of knots", func(t *testing.T) {
+		_, err := MakeBSpline(1, points, knots)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("B-splines aren't rational", func(t *testing.T) {
+		curve, _ := MakeBSpline(2, points, knots)
+		assert.False(t, curve.IsRational())
+		assert.True(t, makeQuarterCircle().IsRational())
+	})
+}
+
+func TestNURBSPointAt(t *testing.T) {
+	t.Run("the quarter circle is exact", func(t *testing.T) {
+		curve := makeQuarterCircle()
+
+		for _, tParam := range nums.SubTParamCompleteRangeTimes(10) {
+			point := curve.PointAt(tParam)
+			assert.True(t, nums.IsCloseToOne(point.DistanceTo(MakePoint(0, 0))), "Point %v off the circle", point)
+		}
+	})
+
+	t.Run("a clamped B-spline starts and ends at its control points", func(t *testing.T) {
+		var (
+			points   = []*Point{MakePoint(0, 0), MakePoint(1, 3), MakePoint(3, 3), MakePoint(4, 0), MakePoint(6, 1)}
+			curve, _ = MakeClampedBSpline(3, points)
+		)
+
+		assert.True(t, curve.PointAt(nums.MinT).Equals(points[0]))
+		assert.True(t, curve.PointAt(nums.MaxT).Equals(points[4]))
+	})
+}
+
+func TestNURBSDerivativeAt(t *testing.T) {
+	var (
+		curve   = makeQuarterCircle()
+		tParam  = nums.MakeTParam(0.3)
+		h       = 1e-6
+		before  = curve.PointAt(nums.MakeTParam(0.3 - h))
+		after   = curve.PointAt(nums.MakeTParam(0.3 + h))
+		numeric = before.VectorTo(after).Scaled(0.5 / h)
+		got     = curve.DerivativeAt(tParam, 1)
+	)
+
+	assert.InDelta(t, numeric.X(), got.X(), 1e-6)
+	assert.InDelta(t, numeric.Y(), got.Y(), 1e-6)
+
+	radius := MakePoint(0, 0).VectorTo(curve.PointAt(tParam))
+	assert.True(t, nums.IsCloseToZero(curve.TangentAt(tParam).DotTimes(radius)))
+}
+
+func TestNURBSKnotInsertionAndDegreeElevation(t *testing.T) {
+	var (
+		points   = []*Point{MakePoint(0, 0), MakePoint(1, 3), MakePoint(3, 3), MakePoint(4, 0), MakePoint(6, 1)}
+		weights  = []float64{1, 2, 0.5, 1, 1}
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 0.3, 0.6, 1, 1, 1)
+		curve, _ = MakeNURBS(2, points, weights, knots)
+	)
+
+	assertSameShape := func(t *testing.T, other *NURBS) {
+		for _, tParam := range nums.SubTParamCompleteRangeTimes(20) {
+			var (
+				want = curve.PointAt(tParam)
+				got  = other.PointAt(tParam)
+			)
+			assert.True(t, want.Equals(got), "Want %v, got %v", want, got)
+		}
+	}
+
+	t.Run("inserting a knot", func(t *testing.T) {
+		inserted, err := curve.WithInsertedKnot(0.45, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, len(points)+2, len(inserted.ControlPoints()))
+		assert.Equal(t, 2, inserted.Knots().Multiplicity(0.45))
+		assertSameShape(t, inserted)
+	})
+
+	t.Run("can't insert a knot above the degree", func(t *testing.T) {
+		_, err := curve.WithInsertedKnot(0.3, 2)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("elevating the degree", func(t *testing.T) {
+		elevated, err := curve.WithElevatedDegree(2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, elevated.Degree())
+		assertSameShape(t, elevated)
+	})
+}
+
+func TestInterpolateBSpline(t *testing.T) {
+	points := []*Point{MakePoint(0, 0), MakePoint(3, 4), MakePoint(-1, 4), MakePoint(-4, 0), MakePoint(-4, -3)}
+
+	t.Run("passes through the points", func(t *testing.T) {
+		var (
+			curve, err = InterpolateBSpline(3, points)
+			found      = 0
+		)
+
+		assert.Nil(t, err)
+		for _, tParam := range nums.SubTParamCompleteRangeTimes(10000) {
+			point := curve.PointAt(tParam)
+			for _, p := range points {
+				if p.DistanceTo(point) < 1e-3 {
+					found++
+					break
+				}
+			}
+		}
+		assert.True(t, curve.PointAt(nums.MinT).Equals(points[0]))
+		assert.True(t, curve.PointAt(nums.MaxT).Equals(points[4]))
+		assert.GreaterOrEqual(t, found, len(points))
+	})
+
+	t.Run("requires degree + 1 points", func(t *testing.T) {
+		_, err := InterpolateBSpline(3, points[:3])
+
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+package g2d
+
+// An Orientation is the direction in which a rotation takes place.
+type Orientation int
+
+const (
+	// CounterClockwise is the positive direction of rotation: from the X axis towards the Y axis.
+	CounterClockwise Orientation = iota
+	// Clockwise is the negative direction of rotation: from the Y axis towards the X axis.
+	Clockwise
+)
+
+func (o Orientation) String() string {
+	switch o {
+	case CounterClockwise:
+		return "CounterClockwise"
+	case Clockwise:
+		return "Clockwise"
+	default:
+		return "Unknown"
+	}
+}
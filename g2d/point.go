@@ -44,18 +44,29 @@ func (from *Point) VectorTo(to *Point) *Vector {
 
 // Equals returns true if the projections of this and other projectable are equal.
 func (p *Point) Equals(other *Point) bool {
-	return nums.FloatsEqual(p.x, other.x) &&
-		nums.FloatsEqual(p.y, other.y)
+	return p.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol returns true if the projections of this and other point are equal within the
+// given tolerance.
+func (p *Point) EqualsTol(other *Point, tol nums.Tolerance) bool {
+	return tol.Equal(p.x, other.x) && tol.Equal(p.y, other.y)
 }
 
 // Compare returns -1 if this node goes before the other, 0 if they are equal and 1 if
 // this node goes after the other.
 func (p *Point) Compare(other *Point) int {
-	if p.Equals(other) {
+	return p.CompareTol(other, nums.DefaultTolerance)
+}
+
+// CompareTol returns -1 if this node goes before the other, 0 if they are equal and 1 if
+// this node goes after the other, comparing the projections with the given tolerance.
+func (p *Point) CompareTol(other *Point, tol nums.Tolerance) int {
+	if p.EqualsTol(other, tol) {
 		return 0
 	}
 
-	if nums.FloatsEqual(p.x, other.x) {
+	if tol.Equal(p.x, other.x) {
 		if p.y < other.y {
 			return -1
 		}
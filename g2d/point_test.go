@@ -74,4 +74,40 @@ func TestPointOrdering(t *testing.T) {
 		}
 	})
 
+	t.Run("points are compared with the given tolerance", func(t *testing.T) {
+		var (
+			p   = MakePoint(2, 5)
+			q   = MakePoint(2.001, 4)
+			tol = nums.MakeAbsoluteTolerance(0.01)
+		)
+
+		if p.CompareTol(MakePoint(2.001, 5.001), tol) != 0 {
+			t.Error("Expected the points to be equal")
+		}
+		if p.CompareTol(q, tol) != 1 || q.CompareTol(p, tol) != -1 {
+			t.Error("Expected the points with the same X to be sorted by Y")
+		}
+		if p.Compare(q) != -1 {
+			t.Error("Expected point p to go before q")
+		}
+	})
+}
+
+func TestPointEqualsTol(t *testing.T) {
+	var (
+		p = MakePoint(1.5e6, 2.5e6)
+		q = MakePoint(1.5e6+1e-4, 2.5e6-1e-4)
+	)
+
+	t.Run("aren't equal with the default tolerance", func(t *testing.T) {
+		if p.Equals(q) {
+			t.Error("Points expected to not be equal")
+		}
+	})
+
+	t.Run("are equal with a relative tolerance", func(t *testing.T) {
+		if !p.EqualsTol(q, nums.MakeRelativeTolerance(1e-9)) {
+			t.Error("Points expected to be equal")
+		}
+	})
 }
@@ -0,0 +1,94 @@
+package g2d
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A Polygon is a closed two dimensional shape bounded by a ring of straight edges.
+// The last vertex of the polygon is connected to the first one by an edge.
+type Polygon struct {
+	vertices []*Point
+}
+
+// MakePolygon creates a new polygon with the given vertices, in order.
+// If the last vertex is equal to the first one, it's discarded, as the polygon is closed
+// implicitly.
+//
+// A non-nil error is returned if the polygon has less than three vertices.
+func MakePolygon(vertices ...*Point) (*Polygon, error) {
+	if len(vertices) > 1 && vertices[0].Equals(vertices[len(vertices)-1]) {
+		vertices = vertices[:len(vertices)-1]
+	}
+
+	if len(vertices) < 3 {
+		return nil, errors.New("at least three vertices are required to construct a polygon")
+	}
+
+	return &Polygon{vertices}, nil
+}
+
+// Vertices returns the vertices of the polygon, in order. The first vertex isn't repeated at
+// the end.
+func (p *Polygon) Vertices() []*Point {
+	return p.vertices
+}
+
+// Edges returns the segments bounding the polygon. The last edge joins the last vertex with
+// the first one.
+func (p *Polygon) Edges() []*Segment {
+	var (
+		n     = len(p.vertices)
+		edges = make([]*Segment, n)
+	)
+
+	for i := 0; i < n; i++ {
+		edges[i] = MakeSegment(p.vertices[i], p.vertices[(i+1)%n])
+	}
+
+	return edges
+}
+
+// Perimeter computes the sum of the lengths of the polygon's edges.
+func (p *Polygon) Perimeter() float64 {
+	perimeter := 0.0
+	for _, edge := range p.Edges() {
+		perimeter += edge.Length()
+	}
+
+	return perimeter
+}
+
+// SignedArea computes the area enclosed by the polygon using the shoelace formula.
+// The area is positive if the vertices are in counter-clockwise order and negative if they
+// are in clockwise order.
+func (p *Polygon) SignedArea() float64 {
+	var (
+		n    = len(p.vertices)
+		area = 0.0
+		a, b *Point
+	)
+
+	for i := 0; i < n; i++ {
+		a, b = p.vertices[i], p.vertices[(i+1)%n]
+		area += a.x*b.y - b.x*a.y
+	}
+
+	return 0.5 * area
+}
+
+// Area computes the area enclosed by the polygon.
+func (p *Polygon) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+func (p *Polygon) String() string {
+	vertices := make([]string, len(p.vertices))
+	for i, vertex := range p.vertices {
+		vertices[i] = vertex.String()
+	}
+
+	return fmt.Sprintf("Polygon{%s}", strings.Join(vertices, ", "))
+}
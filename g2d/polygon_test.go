@@ -0,0 +1,52 @@
+package g2d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakePolygon(t *testing.T) {
+	t.Run("discards a repeated closing vertex", func(t *testing.T) {
+		polygon, err := MakePolygon(MakePoint(0, 0), MakePoint(1, 0), MakePoint(0, 1), MakePoint(0, 0))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(polygon.Vertices()))
+	})
+
+	t.Run("can't be created with less than three vertices", func(t *testing.T) {
+		polygon, err := MakePolygon(MakePoint(0, 0), MakePoint(1, 0), MakePoint(0, 0))
+
+		assert.Nil(t, polygon)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPolygonEdges(t *testing.T) {
+	var (
+		polygon, _ = MakePolygon(MakePoint(0, 0), MakePoint(4, 0), MakePoint(4, 3))
+		edges      = polygon.Edges()
+	)
+
+	assert.Equal(t, 3, len(edges))
+	assert.True(t, edges[2].Start().Equals(MakePoint(4, 3)))
+	assert.True(t, edges[2].End().Equals(MakePoint(0, 0)))
+	assert.True(t, nums.FloatsEqual(polygon.Perimeter(), 12.0))
+}
+
+func TestPolygonArea(t *testing.T) {
+	t.Run("counter-clockwise vertices have positive signed area", func(t *testing.T) {
+		polygon, _ := MakePolygon(MakePoint(0, 0), MakePoint(4, 0), MakePoint(4, 3), MakePoint(0, 3))
+
+		assert.True(t, nums.FloatsEqual(polygon.SignedArea(), 12.0))
+		assert.True(t, nums.FloatsEqual(polygon.Area(), 12.0))
+	})
+
+	t.Run("clockwise vertices have negative signed area", func(t *testing.T) {
+		polygon, _ := MakePolygon(MakePoint(0, 0), MakePoint(0, 3), MakePoint(4, 3), MakePoint(4, 0))
+
+		assert.True(t, nums.FloatsEqual(polygon.SignedArea(), -12.0))
+		assert.True(t, nums.FloatsEqual(polygon.Area(), 12.0))
+	})
+}
@@ -0,0 +1,196 @@
+package g2d
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Polyline is a sequence of connected segments, where each segment starts at the end
+// point of the previous one.
+//
+// The polyline is parametrized by arc length: a t parameter value of 0 corresponds to the
+// first point, a value of 1 to the last point, and any value in between to the point found
+// after travelling that fraction of the total length along the polyline.
+type Polyline struct {
+	points   []*Point
+	segments []*Segment
+	// cumLengths[i] is the length of the polyline from its first point up to points[i].
+	cumLengths []float64
+}
+
+// MakePolyline creates a new polyline passing through the given points, in order.
+//
+// A non-nil error is returned if less than two points are given, or if all the points are
+// coincident, as the resulting polyline would have a zero length.
+func MakePolyline(points ...*Point) (*Polyline, error) {
+	if len(points) < 2 {
+		return nil, errors.New("at least two points are required to construct a polyline")
+	}
+
+	var (
+		segments   = make([]*Segment, len(points)-1)
+		cumLengths = make([]float64, len(points))
+	)
+
+	for i := 1; i < len(points); i++ {
+		segments[i-1] = MakeSegment(points[i-1], points[i])
+		cumLengths[i] = cumLengths[i-1] + segments[i-1].Length()
+	}
+
+	if nums.IsCloseToZero(cumLengths[len(points)-1]) {
+		return nil, errors.New("a polyline can't have a zero length")
+	}
+
+	return &Polyline{
+		points:     points,
+		segments:   segments,
+		cumLengths: cumLengths,
+	}, nil
+}
+
+// Points returns the vertices of the polyline, in order.
+func (p *Polyline) Points() []*Point {
+	return p.points
+}
+
+// Segments returns the segments between consecutive vertices of the polyline, in order.
+func (p *Polyline) Segments() []*Segment {
+	return p.segments
+}
+
+// Start is the first point of the polyline.
+func (p *Polyline) Start() *Point {
+	return p.points[0]
+}
+
+// End is the last point of the polyline.
+func (p *Polyline) End() *Point {
+	return p.points[len(p.points)-1]
+}
+
+// Length computes the total length of the polyline: the sum of the lengths of its segments.
+func (p *Polyline) Length() float64 {
+	return p.cumLengths[len(p.cumLengths)-1]
+}
+
+// PointAt computes the point found after travelling the fraction of the total length given
+// by the t parameter along the polyline.
+func (p *Polyline) PointAt(t nums.TParam) *Point {
+	index, localT := p.locate(t)
+	return p.segments[index].PointAt(localT)
+}
+
+// TangentAt computes the versor tangent to the polyline at the given t parameter.
+// At a vertex, the tangent is that of the segment that ends at the vertex, except for the
+// first point, where the tangent is that of the first segment.
+func (p *Polyline) TangentAt(t nums.TParam) *Vector {
+	index, _ := p.locate(t)
+	return p.segments[index].DirectionVersor()
+}
+
+// NormalAt computes the versor perpendicular to the tangent versor at the given t parameter.
+func (p *Polyline) NormalAt(t nums.TParam) *Vector {
+	return p.TangentAt(t).Perpendicular()
+}
+
+// SplitAt divides the polyline in two at the given t parameter, returning the polyline before
+// and the polyline after the split point.
+//
+// A non-nil error is returned if the t parameter is an extreme value, as one of the resulting
+// polylines would have a zero length.
+func (p *Polyline) SplitAt(t nums.TParam) (*Polyline, *Polyline, error) {
+	if t.IsExtreme() {
+		return nil, nil, errors.New("can't split a polyline at one of its ends")
+	}
+
+	var (
+		index, localT = p.locate(t)
+		splitPoint    = p.segments[index].PointAt(localT)
+		before        = make([]*Point, 0, index+2)
+		after         = make([]*Point, 0, len(p.points)-index)
+	)
+
+	before = append(before, p.points[:index+1]...)
+	if !splitPoint.Equals(p.points[index]) {
+		before = append(before, splitPoint)
+	}
+
+	if !splitPoint.Equals(p.points[index+1]) {
+		after = append(after, splitPoint)
+	}
+	after = append(after, p.points[index+1:]...)
+
+	first, err := MakePolyline(before...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	second, err := MakePolyline(after...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return first, second, nil
+}
+
+// Resampled creates a new polyline by dividing this one into the given number of pieces of
+// equal length. The resulting polyline has pieces + 1 points.
+//
+// A non-nil error is returned if the number of pieces is smaller than one.
+func (p *Polyline) Resampled(pieces int) (*Polyline, error) {
+	if pieces < 1 {
+		return nil, errors.New("a polyline needs to be resampled into at least one piece")
+	}
+
+	var (
+		tParams = nums.SubTParamCompleteRangeTimes(pieces)
+		points  = make([]*Point, len(tParams))
+	)
+
+	for i, t := range tParams {
+		points[i] = p.PointAt(t)
+	}
+
+	return MakePolyline(points...)
+}
+
+// locate finds the index of the segment containing the point at the given t parameter and the
+// t parameter local to that segment.
+func (p *Polyline) locate(t nums.TParam) (int, nums.TParam) {
+	var (
+		length    = t.Value() * p.Length()
+		lastIndex = len(p.segments) - 1
+		index     = 0
+	)
+
+	for index < lastIndex && p.cumLengths[index+1] < length {
+		index++
+	}
+
+	// Skip the segments of zero length, as their direction isn't defined. The polyline has a
+	// non-zero length, so at least one segment has a non-zero length.
+	for index < lastIndex && p.segmentLength(index) == 0 {
+		index++
+	}
+	for index > 0 && p.segmentLength(index) == 0 {
+		index--
+	}
+
+	return index, nums.MakeTParam((length - p.cumLengths[index]) / p.segmentLength(index))
+}
+
+func (p *Polyline) segmentLength(index int) float64 {
+	return p.cumLengths[index+1] - p.cumLengths[index]
+}
+
+func (p *Polyline) String() string {
+	points := make([]string, len(p.points))
+	for i, point := range p.points {
+		points[i] = point.String()
+	}
+
+	return fmt.Sprintf("Polyline{%s}", strings.Join(points, ", "))
+}
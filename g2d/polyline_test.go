@@ -0,0 +1,161 @@
+package g2d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeLShapedPolyline() *Polyline {
+	polyline, _ := MakePolyline(
+		MakePoint(0, 0),
+		MakePoint(30, 0),
+		MakePoint(30, 10),
+	)
+
+	return polyline
+}
+
+func TestMakePolyline(t *testing.T) {
+	t.Run("has segments joining the points", func(t *testing.T) {
+		var (
+			polyline = makeLShapedPolyline()
+			segments = polyline.Segments()
+		)
+
+		assert.Equal(t, 2, len(segments))
+		assert.True(t, segments[0].Start().Equals(MakePoint(0, 0)))
+		assert.True(t, segments[0].End().Equals(MakePoint(30, 0)))
+		assert.True(t, segments[1].Start().Equals(MakePoint(30, 0)))
+		assert.True(t, segments[1].End().Equals(MakePoint(30, 10)))
+	})
+
+	t.Run("can't be created with less than two points", func(t *testing.T) {
+		polyline, err := MakePolyline(MakePoint(1, 2))
+
+		assert.Nil(t, polyline)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("can't be created with zero length", func(t *testing.T) {
+		polyline, err := MakePolyline(MakePoint(1, 2), MakePoint(1, 2))
+
+		assert.Nil(t, polyline)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPolylineLength(t *testing.T) {
+	polyline := makeLShapedPolyline()
+
+	assert.True(t, nums.FloatsEqual(polyline.Length(), 40.0))
+}
+
+func TestPolylinePointAt(t *testing.T) {
+	polyline := makeLShapedPolyline()
+
+	t.Run("at the start", func(t *testing.T) {
+		assert.True(t, polyline.PointAt(nums.MinT).Equals(MakePoint(0, 0)))
+	})
+
+	t.Run("in the first segment", func(t *testing.T) {
+		assert.True(t, polyline.PointAt(nums.MakeTParam(0.5)).Equals(MakePoint(20, 0)))
+	})
+
+	t.Run("at a vertex", func(t *testing.T) {
+		assert.True(t, polyline.PointAt(nums.MakeTParam(0.75)).Equals(MakePoint(30, 0)))
+	})
+
+	t.Run("in the second segment", func(t *testing.T) {
+		assert.True(t, polyline.PointAt(nums.MakeTParam(0.875)).Equals(MakePoint(30, 5)))
+	})
+
+	t.Run("at the end", func(t *testing.T) {
+		assert.True(t, polyline.PointAt(nums.MaxT).Equals(MakePoint(30, 10)))
+	})
+}
+
+func TestPolylineTangentAndNormal(t *testing.T) {
+	polyline := makeLShapedPolyline()
+
+	t.Run("in the first segment", func(t *testing.T) {
+		tParam := nums.MakeTParam(0.5)
+
+		assert.True(t, polyline.TangentAt(tParam).Equals(IVersor))
+		assert.True(t, polyline.NormalAt(tParam).Equals(JVersor))
+	})
+
+	t.Run("in the second segment", func(t *testing.T) {
+		tParam := nums.MakeTParam(0.9)
+
+		assert.True(t, polyline.TangentAt(tParam).Equals(JVersor))
+		assert.True(t, polyline.NormalAt(tParam).Equals(MakeVector(-1, 0)))
+	})
+
+	t.Run("skips zero length segments", func(t *testing.T) {
+		polyline, _ := MakePolyline(MakePoint(0, 0), MakePoint(0, 0), MakePoint(0, 10))
+
+		assert.True(t, polyline.TangentAt(nums.MinT).Equals(JVersor))
+	})
+}
+
+func TestPolylineSplitAt(t *testing.T) {
+	polyline := makeLShapedPolyline()
+
+	t.Run("in the middle of a segment", func(t *testing.T) {
+		first, second, err := polyline.SplitAt(nums.MakeTParam(0.5))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(first.Points()))
+		assert.True(t, first.End().Equals(MakePoint(20, 0)))
+		assert.True(t, nums.FloatsEqual(first.Length(), 20.0))
+		assert.Equal(t, 3, len(second.Points()))
+		assert.True(t, second.Start().Equals(MakePoint(20, 0)))
+		assert.True(t, nums.FloatsEqual(second.Length(), 20.0))
+	})
+
+	t.Run("at a vertex", func(t *testing.T) {
+		first, second, err := polyline.SplitAt(nums.MakeTParam(0.75))
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(first.Points()))
+		assert.Equal(t, 2, len(second.Points()))
+		assert.True(t, second.Start().Equals(MakePoint(30, 0)))
+	})
+
+	t.Run("can't split at the ends", func(t *testing.T) {
+		_, _, err := polyline.SplitAt(nums.MaxT)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPolylineResampled(t *testing.T) {
+	polyline := makeLShapedPolyline()
+
+	t.Run("into equal length pieces", func(t *testing.T) {
+		var (
+			resampled, err = polyline.Resampled(4)
+			wantPoints     = []*Point{
+				MakePoint(0, 0),
+				MakePoint(10, 0),
+				MakePoint(20, 0),
+				MakePoint(30, 0),
+				MakePoint(30, 10),
+			}
+		)
+
+		assert.Nil(t, err)
+		assert.Equal(t, len(wantPoints), len(resampled.Points()))
+		for i, want := range wantPoints {
+			assert.True(t, resampled.Points()[i].Equals(want), "Want %v, got %v", want, resampled.Points()[i])
+		}
+	})
+
+	t.Run("can't resample into less than one piece", func(t *testing.T) {
+		_, err := polyline.Resampled(0)
+
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package g2d
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A QuadBezier is a quadratic Bézier curve, defined by a start point, a control point and an
+// end point. The curve starts at t = 0 and ends at t = 1.
+type QuadBezier struct {
+	start, control, end *Point
+}
+
+// MakeQuadBezier creates a new quadratic Bézier curve given its start, control and end points.
+func MakeQuadBezier(start, control, end *Point) *QuadBezier {
+	return &QuadBezier{start, control, end}
+}
+
+// The Start point of the curve.
+func (b *QuadBezier) Start() *Point {
+	return b.start
+}
+
+// The Control point of the curve, which the curve is pulled towards.
+func (b *QuadBezier) Control() *Point {
+	return b.control
+}
+
+// The End point of the curve.
+func (b *QuadBezier) End() *Point {
+	return b.end
+}
+
+// ControlPoints returns the start, control and end points, in that order.
+func (b *QuadBezier) ControlPoints() []*Point {
+	return []*Point{b.start, b.control, b.end}
+}
+
+// PointAt computes an intermediate point in the curve.
+func (b *QuadBezier) PointAt(t nums.TParam) *Point {
+	return bezierPointAt(b.ControlPoints(), t)
+}
+
+// FirstDerivativeAt computes the derivative of the curve with respect to t, a vector tangent to
+// the curve.
+func (b *QuadBezier) FirstDerivativeAt(t nums.TParam) *Vector {
+	return bezierDerivativeAt(b.ControlPoints(), 1, t)
+}
+
+// SecondDerivativeAt computes the second derivative of the curve with respect to t, which is
+// constant for quadratic curves.
+func (b *QuadBezier) SecondDerivativeAt(t nums.TParam) *Vector {
+	return bezierDerivativeAt(b.ControlPoints(), 2, t)
+}
+
+// SplitAt divides the curve in two at the given t parameter, returning the curves before and
+// after the split point.
+func (b *QuadBezier) SplitAt(t nums.TParam) (*QuadBezier, *QuadBezier) {
+	_, left, right := bezierDeCasteljau(b.ControlPoints(), t)
+	return MakeQuadBezier(left[0], left[1], left[2]), MakeQuadBezier(right[0], right[1], right[2])
+}
+
+// BoundingRect returns the smallest rectangle containing the curve.
+func (b *QuadBezier) BoundingRect() *Rect {
+	return bezierBoundingRect(b.ControlPoints())
+}
+
+// Length computes the arc length of the curve.
+func (b *QuadBezier) Length() float64 {
+	return bezierLength(b.ControlPoints())
+}
+
+// Flattened approximates the curve by a polyline whose distance to the curve is smaller than the
+// given tolerance.
+//
+// A non-nil error is returned if all the control points coincide, as the curve is a single point.
+func (b *QuadBezier) Flattened(tolerance float64) (*Polyline, error) {
+	return bezierFlattenedPolyline(b.ControlPoints(), tolerance)
+}
+
+func (b *QuadBezier) String() string {
+	return fmt.Sprintf("QuadBezier{%v, %v, %v}", b.start, b.control, b.end)
+}
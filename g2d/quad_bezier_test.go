@@ -0,0 +1,76 @@
+package g2d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestQuadBezier() *QuadBezier {
+	return MakeQuadBezier(MakePoint(0, 0), MakePoint(1, 2), MakePoint(2, 0))
+}
+
+func TestQuadBezierPointAt(t *testing.T) {
+	curve := makeTestQuadBezier()
+
+	assert.True(t, curve.PointAt(nums.MinT).Equals(MakePoint(0, 0)))
+	assert.True(t, curve.PointAt(nums.HalfT).Equals(MakePoint(1, 1)))
+	assert.True(t, curve.PointAt(nums.MaxT).Equals(MakePoint(2, 0)))
+}
+
+func TestQuadBezierDerivatives(t *testing.T) {
+	curve := makeTestQuadBezier()
+
+	assert.True(t, curve.FirstDerivativeAt(nums.MinT).Equals(MakeVector(2, 4)))
+	assert.True(t, curve.FirstDerivativeAt(nums.HalfT).Equals(MakeVector(2, 0)))
+	assert.True(t, curve.SecondDerivativeAt(nums.HalfT).Equals(MakeVector(0, -8)))
+}
+
+func TestQuadBezierSplitAt(t *testing.T) {
+	var (
+		curve       = makeTestQuadBezier()
+		left, right = curve.SplitAt(nums.HalfT)
+	)
+
+	assertPointsEqual(t, []*Point{MakePoint(0, 0), MakePoint(0.5, 1), MakePoint(1, 1)}, left.ControlPoints())
+	assertPointsEqual(t, []*Point{MakePoint(1, 1), MakePoint(1.5, 1), MakePoint(2, 0)}, right.ControlPoints())
+}
+
+func TestQuadBezierBoundingRect(t *testing.T) {
+	var (
+		curve   = makeTestQuadBezier()
+		want, _ = MakeRect(MakePoint(0, 0), 2, 1)
+	)
+
+	assert.True(t, curve.BoundingRect().Equals(want), "Want %v, got %v", want, curve.BoundingRect())
+}
+
+func TestQuadBezierLength(t *testing.T) {
+	curve := makeTestQuadBezier()
+
+	assert.True(t, nums.FloatsEqualEps(curve.Length(), 2.957885715089195, 1e-9))
+}
+
+func TestQuadBezierFlattened(t *testing.T) {
+	var (
+		curve     = makeTestQuadBezier()
+		coarse, _ = curve.Flattened(0.1)
+		fine, _   = curve.Flattened(0.001)
+	)
+
+	assert.True(t, coarse.Start().Equals(curve.Start()))
+	assert.True(t, coarse.End().Equals(curve.End()))
+	assert.Less(t, len(coarse.Points()), len(fine.Points()))
+	assert.InDelta(t, curve.Length(), fine.Length(), 0.001)
+
+	t.Run("coincident control points", func(t *testing.T) {
+		var (
+			p              = MakePoint(1, 1)
+			flattened, err = MakeQuadBezier(p, p, p).Flattened(0.1)
+		)
+
+		assert.Nil(t, flattened)
+		assert.NotNil(t, err)
+	})
+}
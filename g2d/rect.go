@@ -131,9 +131,14 @@ func (r *Rect) WithMargins(lateral, vertical float64) (*Rect, error) {
 
 // Equals checks if this and other rectangle are equal.
 func (r *Rect) Equals(other *Rect) bool {
-	return r.origin.Equals(other.origin) &&
-		nums.FloatsEqual(r.width, other.width) &&
-		nums.FloatsEqual(r.height, other.height)
+	return r.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol checks if this and other rectangle are equal within the given tolerance.
+func (r *Rect) EqualsTol(other *Rect, tol nums.Tolerance) bool {
+	return r.origin.EqualsTol(other.origin, tol) &&
+		tol.Equal(r.width, other.width) &&
+		tol.Equal(r.height, other.height)
 }
 
 func (r *Rect) String() string {
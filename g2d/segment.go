@@ -1,6 +1,8 @@
 package g2d
 
 import (
+	"math"
+
 	"github.com/angelsolaorbaiceta/inkgeom/nums"
 )
 
@@ -76,3 +78,95 @@ The Reference Frame i's versor points in the direction of the direction versor.
 func (s *Segment) RefFrame() *RefFrame {
 	return MakeRefFrameWithIVersor(s.DirectionVersor())
 }
+
+// DistanceToPoint computes the distance from the given point to the closest point in the
+// segment.
+func (s *Segment) DistanceToPoint(p *Point) float64 {
+	return s.DistanceToPointTol(p, nums.DefaultTolerance)
+}
+
+// DistanceToPointTol computes the distance from the given point to the closest point in the
+// segment. Segments whose length is zero within the given tolerance are taken as a point.
+func (s *Segment) DistanceToPointTol(p *Point, tol nums.Tolerance) float64 {
+	direction := s.start.VectorTo(s.end)
+
+	if tol.IsZero(direction.Length()) {
+		return s.start.DistanceTo(p)
+	}
+
+	t := nums.MakeTParam(s.start.VectorTo(p).DotTimes(direction) / direction.DotTimes(direction))
+	return s.PointAt(t).DistanceTo(p)
+}
+
+// Intersects checks whether this and the other segment have at least a point in common.
+// Segments that touch at one of their ends, or that overlap, are considered to intersect.
+func (s *Segment) Intersects(other *Segment) bool {
+	return s.IntersectsTol(other, nums.DefaultTolerance)
+}
+
+// IntersectsTol checks whether this and the other segment have at least a point in common, using
+// the given tolerance to decide whether an aligned end point lies within the other segment.
+func (s *Segment) IntersectsTol(other *Segment, tol nums.Tolerance) bool {
+	var (
+		o1, finite1 = orientationSign(s.start, s.end, other.start)
+		o2, finite2 = orientationSign(s.start, s.end, other.end)
+		o3, finite3 = orientationSign(other.start, other.end, s.start)
+		o4, finite4 = orientationSign(other.start, other.end, s.end)
+	)
+
+	// Segments with infinite or NaN coordinates don't intersect anything.
+	if !finite1 || !finite2 || !finite3 || !finite4 {
+		return false
+	}
+
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+
+	return (o1 == 0 && s.containsCollinearPoint(other.start, tol)) ||
+		(o2 == 0 && s.containsCollinearPoint(other.end, tol)) ||
+		(o3 == 0 && other.containsCollinearPoint(s.start, tol)) ||
+		(o4 == 0 && other.containsCollinearPoint(s.end, tol))
+}
+
+// Integrate approximates the integral of the function along the segment, with respect to its
+// arc length, using the given Gauss–Legendre rule.
+func (s *Segment) Integrate(f func(t nums.TParam) float64, rule *nums.GaussLegendreRule) float64 {
+	return s.Length() * rule.IntegrateTParams(f, nums.MinT, nums.MaxT)
+}
+
+// containsCollinearPoint checks whether a point, known to be aligned with the segment, lies
+// between its start and end points within the given tolerance.
+func (s *Segment) containsCollinearPoint(p *Point, tol nums.Tolerance) bool {
+	return isBetween(p.x, s.start.x, s.end.x, tol) && isBetween(p.y, s.start.y, s.end.y, tol)
+}
+
+// isBetween checks whether the value is in the range defined by a and b, ends included within
+// the given tolerance.
+func isBetween(value, a, b float64, tol nums.Tolerance) bool {
+	var (
+		low  = math.Min(a, b)
+		high = math.Max(a, b)
+	)
+
+	return (value > low || tol.Equal(value, low)) &&
+		(value < high || tol.Equal(value, high))
+}
+
+// orientationSign returns 1 if the points a, b and c are in counter-clockwise order, -1 if
+// they are in clockwise order and 0 if they are exactly aligned. The second value is false if
+// the orientation isn't finite, as happens with infinite or NaN coordinates.
+func orientationSign(a, b, c *Point) (int, bool) {
+	orient := nums.Orient2D([2]float64{a.x, a.y}, [2]float64{b.x, b.y}, [2]float64{c.x, c.y})
+
+	switch {
+	case math.IsNaN(orient) || math.IsInf(orient, 0):
+		return 0, false
+	case orient == 0:
+		return 0, true
+	case orient > 0:
+		return 1, true
+	default:
+		return -1, true
+	}
+}
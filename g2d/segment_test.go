@@ -126,3 +126,92 @@ func TestAngleInRadsFromX(t *testing.T) {
 		assert.True(t, nums.FloatsEqual(angle, -math.Pi/4), "Expected -PI/4, got %f", angle)
 	})
 }
+
+func TestSegmentDistanceToPoint(t *testing.T) {
+	seg := MakeSegmentFromCoords(0, 0, 10, 0)
+
+	t.Run("point projecting inside the segment", func(t *testing.T) {
+		assert.True(t, nums.FloatsEqual(seg.DistanceToPoint(MakePoint(4, 3)), 3.0))
+	})
+
+	t.Run("point projecting before the start", func(t *testing.T) {
+		assert.True(t, nums.FloatsEqual(seg.DistanceToPoint(MakePoint(-3, 4)), 5.0))
+	})
+
+	t.Run("point projecting after the end", func(t *testing.T) {
+		assert.True(t, nums.FloatsEqual(seg.DistanceToPoint(MakePoint(13, -4)), 5.0))
+	})
+
+	t.Run("short segment", func(t *testing.T) {
+		short := MakeSegmentFromCoords(0, 0, 1e-6, 0)
+		assert.True(t, nums.FloatsEqual(short.DistanceToPoint(MakePoint(1, 1)), math.Hypot(1-1e-6, 1)))
+	})
+
+	t.Run("segment shorter than the tolerance", func(t *testing.T) {
+		short := MakeSegmentFromCoords(0, 0, 1e-6, 0)
+		distance := short.DistanceToPointTol(MakePoint(1, 1), nums.MakeAbsoluteTolerance(1e-3))
+		assert.True(t, nums.FloatsEqual(distance, math.Sqrt2))
+	})
+}
+
+func TestSegmentsIntersect(t *testing.T) {
+	seg := MakeSegmentFromCoords(0, 0, 10, 10)
+
+	t.Run("crossing segments", func(t *testing.T) {
+		assert.True(t, seg.Intersects(MakeSegmentFromCoords(0, 10, 10, 0)))
+	})
+
+	t.Run("segments touching at an end", func(t *testing.T) {
+		assert.True(t, seg.Intersects(MakeSegmentFromCoords(5, 5, 10, 0)))
+	})
+
+	t.Run("overlapping collinear segments", func(t *testing.T) {
+		assert.True(t, seg.Intersects(MakeSegmentFromCoords(5, 5, 15, 15)))
+	})
+
+	t.Run("disjoint collinear segments", func(t *testing.T) {
+		assert.False(t, seg.Intersects(MakeSegmentFromCoords(11, 11, 15, 15)))
+	})
+
+	t.Run("non crossing segments", func(t *testing.T) {
+		assert.False(t, seg.Intersects(MakeSegmentFromCoords(0, 1, 5, 10)))
+	})
+
+	t.Run("non crossing tiny segments", func(t *testing.T) {
+		var (
+			tinySeg = MakeSegmentFromCoords(0, 0, 1e-6, 1e-6)
+			other   = MakeSegmentFromCoords(0, 1e-7, 5e-7, 1e-6)
+		)
+
+		assert.False(t, tinySeg.Intersects(other))
+	})
+
+	t.Run("segments with non-finite coordinates", func(t *testing.T) {
+		for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			assert.False(t, seg.Intersects(MakeSegmentFromCoords(value, 0, 5, 5)))
+			assert.False(t, MakeSegmentFromCoords(0, 10, value, value).Intersects(seg))
+		}
+	})
+
+	t.Run("collinear segments within a tolerance", func(t *testing.T) {
+		var (
+			other = MakeSegmentFromCoords(10.001, 10.001, 15, 15)
+			tol   = nums.MakeAbsoluteTolerance(0.01)
+		)
+
+		assert.False(t, seg.Intersects(other))
+		assert.True(t, seg.IntersectsTol(other, tol))
+	})
+}
+
+func TestSegmentIntegrate(t *testing.T) {
+	var (
+		seg     = MakeSegmentFromCoords(0, 0, 3, 4)
+		rule, _ = nums.MakeGaussLegendreRule(2)
+		f       = func(t nums.TParam) float64 { return seg.PointAt(t).X() }
+	)
+
+	if got := seg.Integrate(f, rule); !nums.FloatsEqual(got, 7.5) {
+		t.Errorf("Want 7.5, got %f", got)
+	}
+}
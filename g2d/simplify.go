@@ -0,0 +1,377 @@
+package g2d
+
+import (
+	"container/heap"
+	"math"
+)
+
+// SimplifiedDouglasPeucker creates a new polyline with a subset of this polyline's points using
+// the Douglas–Peucker algorithm. Every removed point is closer than the tolerance to the
+// resulting polyline. The start and end points are always kept, and so is the point farthest
+// from the start of a closed polyline, which otherwise could collapse into a single point.
+//
+// When preserveTopology is true, points are kept as needed so that the simplification doesn't
+// introduce self intersections.
+func (p *Polyline) SimplifiedDouglasPeucker(tolerance float64, preserveTopology bool) *Polyline {
+	var (
+		last = len(p.points) - 1
+		kept = make([]bool, len(p.points))
+	)
+
+	kept[0], kept[last] = true, true
+
+	closed := p.Start().Equals(p.End())
+	if closed {
+		anchor, _ := farthestFromPoint(p.points, 0, last)
+		kept[anchor] = true
+		douglasPeucker(p.points, 0, anchor, tolerance, kept)
+		douglasPeucker(p.points, anchor, last, tolerance, kept)
+	} else {
+		douglasPeucker(p.points, 0, last, tolerance, kept)
+	}
+
+	if preserveTopology {
+		preserveChainTopology(p.points, kept, closed)
+	}
+
+	simplified, _ := MakePolyline(keptPoints(p.points, kept)...)
+	return simplified
+}
+
+// SimplifiedVisvalingamWhyatt creates a new polyline with a subset of this polyline's points
+// using the Visvalingam–Whyatt algorithm. Points are removed in order of increasing effective
+// area (the area of the triangle formed with its two neighbors) while that area is smaller than
+// the given tolerance. The start and end points are always kept, and a closed polyline keeps at
+// least three points.
+//
+// When preserveTopology is true, points whose removal would introduce a self intersection are
+// kept.
+func (p *Polyline) SimplifiedVisvalingamWhyatt(areaTolerance float64, preserveTopology bool) *Polyline {
+	var kept []bool
+
+	if last := len(p.points) - 1; p.Start().Equals(p.End()) {
+		// The closed polyline is simplified as a ring whose start can't be removed.
+		kept = append(
+			visvalingamWhyatt(p.points[:last], true, true, areaTolerance, 2, preserveTopology),
+			true,
+		)
+	} else {
+		kept = visvalingamWhyatt(p.points, false, false, areaTolerance, 2, preserveTopology)
+	}
+
+	simplified, _ := MakePolyline(keptPoints(p.points, kept)...)
+	return simplified
+}
+
+// SimplifiedDouglasPeucker creates a new polygon with a subset of this polygon's vertices using
+// the Douglas–Peucker algorithm. Every removed vertex is closer than the tolerance to the
+// resulting polygon, which has at least three vertices.
+//
+// When preserveTopology is true, vertices are kept as needed so that the simplification doesn't
+// introduce self intersections.
+func (p *Polygon) SimplifiedDouglasPeucker(tolerance float64, preserveTopology bool) *Polygon {
+	var (
+		n = len(p.vertices)
+		// The ring is handled as a chain whose last point is the first vertex.
+		chain     = append(append(make([]*Point, 0, n+1), p.vertices...), p.vertices[0])
+		kept      = make([]bool, n+1)
+		anchor, _ = farthestFromPoint(chain, 0, n)
+	)
+
+	kept[0], kept[anchor], kept[n] = true, true, true
+	douglasPeucker(chain, 0, anchor, tolerance, kept)
+	douglasPeucker(chain, anchor, n, tolerance, kept)
+
+	if countKept(kept) < 4 {
+		// The simplified ring collapsed into a line: keep the vertex farthest from it.
+		index, _ := farthestFromSegment(MakeSegment(chain[0], chain[anchor]), chain, 0, n)
+		kept[index] = true
+	}
+
+	if preserveTopology {
+		preserveChainTopology(chain, kept, true)
+	}
+
+	simplified, _ := MakePolygon(keptPoints(chain, kept)...)
+	return simplified
+}
+
+// SimplifiedVisvalingamWhyatt creates a new polygon with a subset of this polygon's vertices
+// using the Visvalingam–Whyatt algorithm. Vertices are removed in order of increasing effective
+// area while that area is smaller than the given tolerance, and the resulting polygon has at
+// least three vertices.
+//
+// When preserveTopology is true, vertices whose removal would introduce a self intersection
+// are kept.
+func (p *Polygon) SimplifiedVisvalingamWhyatt(areaTolerance float64, preserveTopology bool) *Polygon {
+	kept := visvalingamWhyatt(p.vertices, true, false, areaTolerance, 3, preserveTopology)
+
+	simplified, _ := MakePolygon(keptPoints(p.vertices, kept)...)
+	return simplified
+}
+
+/* <-- Douglas–Peucker --> */
+
+// douglasPeucker marks as kept the points between the first and last indices that are farther
+// than the tolerance from the simplified chain.
+func douglasPeucker(points []*Point, first, last int, tolerance float64, kept []bool) {
+	type span struct{ first, last int }
+	stack := []span{{first, last}}
+
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		var (
+			base            = MakeSegment(points[s.first], points[s.last])
+			index, distance = farthestFromSegment(base, points, s.first, s.last)
+		)
+		if index >= 0 && distance > tolerance {
+			kept[index] = true
+			stack = append(stack, span{s.first, index}, span{index, s.last})
+		}
+	}
+}
+
+// farthestFromSegment returns the index of the point strictly between the first and last
+// indices that is farthest from the given segment, and its distance. The index is -1 if there
+// are no points between first and last.
+func farthestFromSegment(segment *Segment, points []*Point, first, last int) (int, float64) {
+	var (
+		index    = -1
+		maxDist  = 0.0
+		distance float64
+	)
+
+	for i := first + 1; i < last; i++ {
+		if distance = segment.DistanceToPoint(points[i]); index < 0 || distance > maxDist {
+			index, maxDist = i, distance
+		}
+	}
+
+	return index, maxDist
+}
+
+// farthestFromPoint returns the index of the point strictly between the first and last indices
+// that is farthest from the point at the first index.
+func farthestFromPoint(points []*Point, first, last int) (int, float64) {
+	var (
+		index    = first + 1
+		maxDist  = 0.0
+		distance float64
+	)
+
+	for i := first + 1; i < last; i++ {
+		if distance = points[first].DistanceTo(points[i]); distance > maxDist {
+			index, maxDist = i, distance
+		}
+	}
+
+	return index, maxDist
+}
+
+// preserveChainTopology keeps points from the original chain until none of the simplified
+// segments intersects another simplified segment it isn't adjacent to.
+func preserveChainTopology(points []*Point, kept []bool, closed bool) {
+	for changed := true; changed; {
+		changed = false
+		indices := keptIndices(kept)
+
+		for i := 0; i+1 < len(indices); i++ {
+			if indices[i+1] == indices[i]+1 {
+				continue
+			}
+
+			if chainSegmentIntersects(points, indices, i, closed) {
+				var (
+					first, last = indices[i], indices[i+1]
+					base        = MakeSegment(points[first], points[last])
+					index, _    = farthestFromSegment(base, points, first, last)
+				)
+				kept[index] = true
+				changed = true
+			}
+		}
+	}
+}
+
+// chainSegmentIntersects checks whether the i-th segment of the chain defined by the points at
+// the given indices intersects any other segment in the chain that isn't adjacent to it.
+func chainSegmentIntersects(points []*Point, indices []int, i int, closed bool) bool {
+	var (
+		last    = len(indices) - 2
+		segment = MakeSegment(points[indices[i]], points[indices[i+1]])
+	)
+
+	for j := 0; j <= last; j++ {
+		if j == i || j == i-1 || j == i+1 {
+			continue
+		}
+		if closed && ((i == 0 && j == last) || (i == last && j == 0)) {
+			continue
+		}
+
+		if segment.Intersects(MakeSegment(points[indices[j]], points[indices[j+1]])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/* <-- Visvalingam–Whyatt --> */
+
+// visvalingamWhyatt returns which of the given points are kept after removing, in order of
+// increasing effective area, the points whose effective area is smaller than the tolerance.
+// The ends of an open chain are never removed, and neither is the start of a closed one when
+// keepStart is true.
+func visvalingamWhyatt(
+	points []*Point,
+	closed bool,
+	keepStart bool,
+	areaTolerance float64,
+	minPoints int,
+	preserveTopology bool,
+) []bool {
+	var (
+		n     = len(points)
+		kept  = make([]bool, n)
+		prev  = make([]int, n)
+		next  = make([]int, n)
+		areas = make([]float64, n)
+		queue = make(vertexAreaQueue, 0, n)
+		count = n
+	)
+
+	for i := 0; i < n; i++ {
+		kept[i] = true
+		prev[i], next[i] = i-1, i+1
+	}
+
+	if closed {
+		prev[0], next[n-1] = n-1, 0
+	} else {
+		next[n-1] = -1
+	}
+
+	isRemovable := func(i int) bool {
+		return prev[i] >= 0 && next[i] >= 0 && !(keepStart && i == 0)
+	}
+
+	for i := 0; i < n; i++ {
+		if isRemovable(i) {
+			areas[i] = triangleArea(points[prev[i]], points[i], points[next[i]])
+			queue = append(queue, vertexArea{i, areas[i]})
+		}
+	}
+	heap.Init(&queue)
+
+	for queue.Len() > 0 && count > minPoints {
+		item := heap.Pop(&queue).(vertexArea)
+		i := item.index
+
+		if !kept[i] || item.area != areas[i] {
+			// Stale entry: the area of the vertex changed after it was queued.
+			continue
+		}
+		if item.area >= areaTolerance {
+			break
+		}
+		if preserveTopology && removalIntersects(points, kept, prev, next, i) {
+			continue
+		}
+
+		kept[i] = false
+		count--
+		next[prev[i]], prev[next[i]] = next[i], prev[i]
+
+		for _, neighbor := range []int{prev[i], next[i]} {
+			if isRemovable(neighbor) {
+				// The effective area of a vertex never decreases below that of the removed ones.
+				areas[neighbor] = math.Max(
+					item.area,
+					triangleArea(points[prev[neighbor]], points[neighbor], points[next[neighbor]]),
+				)
+				heap.Push(&queue, vertexArea{neighbor, areas[neighbor]})
+			}
+		}
+	}
+
+	return kept
+}
+
+// removalIntersects checks whether the segment that results from removing the i-th vertex
+// intersects any other segment of the chain it isn't adjacent to.
+func removalIntersects(points []*Point, kept []bool, prev, next []int, i int) bool {
+	var (
+		before  = prev[i]
+		after   = next[i]
+		segment = MakeSegment(points[before], points[after])
+	)
+
+	for j := 0; j < len(points); j++ {
+		if !kept[j] || next[j] < 0 || j == i {
+			// Removed vertices and the chain's last point don't start a segment.
+			continue
+		}
+		if j == before || next[j] == before || j == after || next[j] == after {
+			continue
+		}
+
+		if segment.Intersects(MakeSegment(points[j], points[next[j]])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func triangleArea(a, b, c *Point) float64 {
+	return 0.5 * math.Abs(a.VectorTo(b).CrossTimes(a.VectorTo(c)))
+}
+
+type vertexArea struct {
+	index int
+	area  float64
+}
+
+// vertexAreaQueue implements heap.Interface for a min-heap of vertices by effective area.
+type vertexAreaQueue []vertexArea
+
+func (q vertexAreaQueue) Len() int            { return len(q) }
+func (q vertexAreaQueue) Less(i, j int) bool  { return q[i].area < q[j].area }
+func (q vertexAreaQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *vertexAreaQueue) Push(x interface{}) { *q = append(*q, x.(vertexArea)) }
+func (q *vertexAreaQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+/* <-- Utils --> */
+
+func keptIndices(kept []bool) []int {
+	indices := make([]int, 0, len(kept))
+	for i, isKept := range kept {
+		if isKept {
+			indices = append(indices, i)
+		}
+	}
+
+	return indices
+}
+
+func keptPoints(points []*Point, kept []bool) []*Point {
+	indices := keptIndices(kept)
+	result := make([]*Point, len(indices))
+	for i, index := range indices {
+		result[i] = points[index]
+	}
+
+	return result
+}
+
+func countKept(kept []bool) int {
+	return len(keptIndices(kept))
+}
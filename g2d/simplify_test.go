@@ -0,0 +1,226 @@
+package g2d
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func assertPointsEqual(t *testing.T, want, got []*Point) {
+	t.Helper()
+
+	if !assert.Equal(t, len(want), len(got), "Want points %v, got %v", want, got) {
+		return
+	}
+
+	for i := range want {
+		assert.True(t, want[i].Equals(got[i]), "Want point %v, got %v", want[i], got[i])
+	}
+}
+
+func TestPolylineSimplifiedDouglasPeucker(t *testing.T) {
+	polyline, _ := MakePolyline(
+		MakePoint(0, 0),
+		MakePoint(1, 0.1),
+		MakePoint(2, -0.1),
+		MakePoint(3, 5),
+		MakePoint(4, 6),
+		MakePoint(5, 7),
+		MakePoint(6, 8.1),
+		MakePoint(7, 9),
+	)
+
+	t.Run("removes the points closer than the tolerance", func(t *testing.T) {
+		var (
+			simplified = polyline.SimplifiedDouglasPeucker(0.5, false)
+			want       = []*Point{MakePoint(0, 0), MakePoint(2, -0.1), MakePoint(3, 5), MakePoint(7, 9)}
+		)
+
+		assertPointsEqual(t, want, simplified.Points())
+	})
+
+	t.Run("removes only the aligned points with a tiny tolerance", func(t *testing.T) {
+		var (
+			simplified = polyline.SimplifiedDouglasPeucker(1e-9, false)
+			want       = append(polyline.Points()[:4:4], polyline.Points()[5:]...)
+		)
+
+		assertPointsEqual(t, want, simplified.Points())
+	})
+
+	t.Run("keeps the ends with a large tolerance", func(t *testing.T) {
+		var (
+			simplified = polyline.SimplifiedDouglasPeucker(100, false)
+			want       = []*Point{MakePoint(0, 0), MakePoint(7, 9)}
+		)
+
+		assertPointsEqual(t, want, simplified.Points())
+	})
+}
+
+func TestPolylineSimplifiedVisvalingamWhyatt(t *testing.T) {
+	polyline, _ := MakePolyline(
+		MakePoint(0, 0),
+		MakePoint(1, 0.1),
+		MakePoint(2, 0),
+		MakePoint(3, 4),
+		MakePoint(4, 0),
+	)
+
+	t.Run("removes the points with a smaller effective area", func(t *testing.T) {
+		var (
+			simplified = polyline.SimplifiedVisvalingamWhyatt(1, false)
+			want       = []*Point{MakePoint(0, 0), MakePoint(2, 0), MakePoint(3, 4), MakePoint(4, 0)}
+		)
+
+		assertPointsEqual(t, want, simplified.Points())
+	})
+
+	t.Run("keeps the ends with a large tolerance", func(t *testing.T) {
+		var (
+			simplified = polyline.SimplifiedVisvalingamWhyatt(100, false)
+			want       = []*Point{MakePoint(0, 0), MakePoint(4, 0)}
+		)
+
+		assertPointsEqual(t, want, simplified.Points())
+	})
+}
+
+func TestClosedPolylineSimplification(t *testing.T) {
+	ring, _ := MakePolyline(
+		MakePoint(0, 0),
+		MakePoint(4, 0),
+		MakePoint(4, 4),
+		MakePoint(0, 4),
+		MakePoint(0, 0),
+	)
+
+	t.Run("Douglas–Peucker keeps the farthest point with a large tolerance", func(t *testing.T) {
+		var (
+			simplified = ring.SimplifiedDouglasPeucker(100, false)
+			want       = []*Point{MakePoint(0, 0), MakePoint(4, 4), MakePoint(0, 0)}
+		)
+
+		if assert.NotNil(t, simplified) {
+			assertPointsEqual(t, want, simplified.Points())
+		}
+	})
+
+	t.Run("Douglas–Peucker keeps the corners with a small tolerance", func(t *testing.T) {
+		simplified := ring.SimplifiedDouglasPeucker(0.1, false)
+
+		if assert.NotNil(t, simplified) {
+			assertPointsEqual(t, ring.Points(), simplified.Points())
+		}
+	})
+
+	t.Run("the closing point joins adjacent segments", func(t *testing.T) {
+		var (
+			bumpy, _ = MakePolyline(
+				MakePoint(0, 0),
+				MakePoint(2, 0.01),
+				MakePoint(4, 0),
+				MakePoint(4, 4),
+				MakePoint(0, 4),
+				MakePoint(0.01, 2),
+				MakePoint(0, 0),
+			)
+			want = []*Point{
+				MakePoint(0, 0), MakePoint(4, 0), MakePoint(4, 4), MakePoint(0, 4), MakePoint(0, 0),
+			}
+		)
+
+		for _, preserveTopology := range []bool{false, true} {
+			assertPointsEqual(t, want, bumpy.SimplifiedDouglasPeucker(0.5, preserveTopology).Points())
+			assertPointsEqual(t, want, bumpy.SimplifiedVisvalingamWhyatt(0.5, preserveTopology).Points())
+		}
+	})
+
+	t.Run("Visvalingam–Whyatt keeps three points with a large tolerance", func(t *testing.T) {
+		simplified := ring.SimplifiedVisvalingamWhyatt(100, false)
+
+		if assert.NotNil(t, simplified) {
+			assert.Equal(t, 3, len(simplified.Points()))
+			assert.True(t, simplified.Start().Equals(simplified.End()))
+		}
+	})
+}
+
+// makeHookPolyline returns a polyline whose simplification without preserving the topology
+// intersects itself: the small bump in the first segments is close to the hook's tip.
+func makeHookPolyline() *Polyline {
+	polyline, _ := MakePolyline(
+		MakePoint(0, 0),
+		MakePoint(5, 0.8),
+		MakePoint(10, 0),
+		MakePoint(10, -3),
+		MakePoint(5, 0.3),
+		MakePoint(0, -3),
+	)
+
+	return polyline
+}
+
+func hasSelfIntersections(polyline *Polyline) bool {
+	var (
+		points  = polyline.Points()
+		indices = make([]int, len(points))
+	)
+
+	for i := range indices {
+		indices[i] = i
+	}
+
+	for i := 0; i+1 < len(indices); i++ {
+		if chainSegmentIntersects(points, indices, i, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestSimplificationPreservingTopology(t *testing.T) {
+	polyline := makeHookPolyline()
+
+	t.Run("Douglas–Peucker", func(t *testing.T) {
+		assert.True(t, hasSelfIntersections(polyline.SimplifiedDouglasPeucker(1, false)))
+		assert.False(t, hasSelfIntersections(polyline.SimplifiedDouglasPeucker(1, true)))
+	})
+
+	t.Run("Visvalingam–Whyatt", func(t *testing.T) {
+		assert.True(t, hasSelfIntersections(polyline.SimplifiedVisvalingamWhyatt(5, false)))
+		assert.False(t, hasSelfIntersections(polyline.SimplifiedVisvalingamWhyatt(5, true)))
+	})
+}
+
+func TestPolygonSimplification(t *testing.T) {
+	polygon, _ := MakePolygon(
+		MakePoint(0, 0),
+		MakePoint(5, 0.1),
+		MakePoint(10, 0),
+		MakePoint(10.1, 5),
+		MakePoint(10, 10),
+		MakePoint(5, 9.9),
+		MakePoint(0, 10),
+		MakePoint(-0.1, 5),
+	)
+	want := []*Point{MakePoint(0, 0), MakePoint(10, 0), MakePoint(10, 10), MakePoint(0, 10)}
+
+	t.Run("Douglas–Peucker", func(t *testing.T) {
+		simplified := polygon.SimplifiedDouglasPeucker(0.5, true)
+
+		assertPointsEqual(t, want, simplified.Vertices())
+	})
+
+	t.Run("Visvalingam–Whyatt", func(t *testing.T) {
+		simplified := polygon.SimplifiedVisvalingamWhyatt(1, true)
+
+		assertPointsEqual(t, want, simplified.Vertices())
+	})
+
+	t.Run("keeps at least three vertices", func(t *testing.T) {
+		assert.Equal(t, 3, len(polygon.SimplifiedDouglasPeucker(100, false).Vertices()))
+		assert.Equal(t, 3, len(polygon.SimplifiedVisvalingamWhyatt(1000, false).Vertices()))
+	})
+}
@@ -0,0 +1,202 @@
+package svg
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+// A Group is a <g> element: a set of elements which share a style.
+type Group struct {
+	id       string
+	style    Style
+	children []element
+}
+
+// An element is a node of the drawing.
+type element interface {
+	// write writes the element, indented the given depth, to the builder.
+	write(b *strings.Builder, depth int)
+	// boundPoints returns the points which the element's bounding rectangle must contain.
+	boundPoints() []*g2d.Point
+}
+
+// AddGroup adds a nested group with the given id and style, and returns it.
+// The id isn't written if it's empty.
+func (g *Group) AddGroup(id string, style Style) *Group {
+	group := &Group{id: id, style: style}
+	g.children = append(g.children, group)
+
+	return group
+}
+
+// AddPoint adds the point as a circle with the given radius.
+func (g *Group) AddPoint(p *g2d.Point, radius float64, style Style) {
+	g.children = append(g.children, &circle{p, radius, style})
+}
+
+// AddSegment adds the segment as a line.
+func (g *Group) AddSegment(s *g2d.Segment, style Style) {
+	g.children = append(g.children, &shape{
+		tag:    "line",
+		points: []*g2d.Point{s.Start(), s.End()},
+		style:  style,
+	})
+}
+
+// AddRect adds the rectangle.
+func (g *Group) AddRect(r *g2d.Rect, style Style) {
+	g.children = append(g.children, &rect{r, style})
+}
+
+// AddPolyline adds the polyline, which isn't filled unless the style sets the Fill.
+func (g *Group) AddPolyline(p *g2d.Polyline, style Style) {
+	if style.Fill == "" {
+		style.Fill = "none"
+	}
+
+	g.children = append(g.children, &shape{tag: "polyline", points: p.Points(), style: style})
+}
+
+// AddPolygon adds the polygon.
+func (g *Group) AddPolygon(p *g2d.Polygon, style Style) {
+	g.children = append(g.children, &shape{tag: "polygon", points: p.Vertices(), style: style})
+}
+
+// AddText adds a text label whose baseline starts, or is anchored, at the given position.
+func (g *Group) AddText(position *g2d.Point, text string, style TextStyle) {
+	g.children = append(g.children, &label{position, text, style})
+}
+
+func (g *Group) write(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("<g")
+	writeAttr(b, "id", g.id)
+	g.style.writeAttrs(b)
+	b.WriteString(">\n")
+	g.writeChildren(b, depth+1)
+	indent(b, depth)
+	b.WriteString("</g>\n")
+}
+
+func (g *Group) writeChildren(b *strings.Builder, depth int) {
+	for _, child := range g.children {
+		child.write(b, depth)
+	}
+}
+
+func (g *Group) boundPoints() []*g2d.Point {
+	var points []*g2d.Point
+	for _, child := range g.children {
+		points = append(points, child.boundPoints()...)
+	}
+
+	return points
+}
+
+/* <-- Elements --> */
+
+type circle struct {
+	center *g2d.Point
+	radius float64
+	style  Style
+}
+
+func (c *circle) write(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("<circle")
+	writeAttr(b, "cx", formatNumber(c.center.X()))
+	writeAttr(b, "cy", formatNumber(flipY(c.center.Y())))
+	writeAttr(b, "r", formatNumber(c.radius))
+	c.style.writeAttrs(b)
+	b.WriteString("/>\n")
+}
+
+func (c *circle) boundPoints() []*g2d.Point {
+	return []*g2d.Point{
+		g2d.MakePoint(c.center.X()-c.radius, c.center.Y()-c.radius),
+		g2d.MakePoint(c.center.X()+c.radius, c.center.Y()+c.radius),
+	}
+}
+
+type rect struct {
+	rect  *g2d.Rect
+	style Style
+}
+
+func (r *rect) write(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("<rect")
+	writeAttr(b, "x", formatNumber(r.rect.Left()))
+	writeAttr(b, "y", formatNumber(flipY(r.rect.Top())))
+	writeAttr(b, "width", formatNumber(r.rect.Width()))
+	writeAttr(b, "height", formatNumber(r.rect.Height()))
+	r.style.writeAttrs(b)
+	b.WriteString("/>\n")
+}
+
+func (r *rect) boundPoints() []*g2d.Point {
+	return []*g2d.Point{
+		g2d.MakePoint(r.rect.Left(), r.rect.Bottom()),
+		g2d.MakePoint(r.rect.Right(), r.rect.Top()),
+	}
+}
+
+// A shape is an element defined by a list of points: a line, a polyline or a polygon.
+type shape struct {
+	tag    string
+	points []*g2d.Point
+	style  Style
+}
+
+func (s *shape) write(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("<" + s.tag)
+
+	if s.tag == "line" {
+		writeAttr(b, "x1", formatNumber(s.points[0].X()))
+		writeAttr(b, "y1", formatNumber(flipY(s.points[0].Y())))
+		writeAttr(b, "x2", formatNumber(s.points[1].X()))
+		writeAttr(b, "y2", formatNumber(flipY(s.points[1].Y())))
+	} else {
+		coords := make([]string, len(s.points))
+		for i, p := range s.points {
+			coords[i] = formatNumber(p.X()) + "," + formatNumber(flipY(p.Y()))
+		}
+		writeAttr(b, "points", strings.Join(coords, " "))
+	}
+
+	s.style.writeAttrs(b)
+	b.WriteString("/>\n")
+}
+
+func (s *shape) boundPoints() []*g2d.Point {
+	return s.points
+}
+
+type label struct {
+	position *g2d.Point
+	text     string
+	style    TextStyle
+}
+
+func (l *label) write(b *strings.Builder, depth int) {
+	indent(b, depth)
+	b.WriteString("<text")
+	writeAttr(b, "x", formatNumber(l.position.X()))
+	writeAttr(b, "y", formatNumber(flipY(l.position.Y())))
+	l.style.writeAttrs(b)
+	b.WriteByte('>')
+	xml.EscapeText(b, []byte(l.text))
+	b.WriteString("</text>\n")
+}
+
+// boundPoints returns the label's position, as the extent of the text depends on the font.
+func (l *label) boundPoints() []*g2d.Point {
+	return []*g2d.Point{l.position}
+}
+
+func indent(b *strings.Builder, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+}
@@ -0,0 +1,87 @@
+package svg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func writeElement(e element) string {
+	var b strings.Builder
+	e.write(&b, 0)
+
+	return b.String()
+}
+
+func TestGroupElements(t *testing.T) {
+	t.Run("point", func(t *testing.T) {
+		var g Group
+		g.AddPoint(g2d.MakePoint(1, 2), 0.1, Style{Fill: "red"})
+
+		want := `<circle cx="1" cy="-2" r="0.1" fill="red"/>` + "\n"
+		if got := writeElement(g.children[0]); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("rect", func(t *testing.T) {
+		var (
+			g    Group
+			r, _ = g2d.MakeRect(g2d.MakePoint(1, 2), 3, 4)
+		)
+		g.AddRect(r, Style{})
+
+		want := `<rect x="1" y="-6" width="3" height="4"/>` + "\n"
+		if got := writeElement(g.children[0]); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("polyline isn't filled by default", func(t *testing.T) {
+		var (
+			g       Group
+			line, _ = g2d.MakePolyline(g2d.MakePoint(0, 0), g2d.MakePoint(1, 1), g2d.MakePoint(2, 0))
+		)
+		g.AddPolyline(line, Style{Stroke: "blue"})
+
+		want := `<polyline points="0,0 1,-1 2,0" stroke="blue" fill="none"/>` + "\n"
+		if got := writeElement(g.children[0]); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		var (
+			g          Group
+			polygon, _ = g2d.MakePolygon(g2d.MakePoint(0, 0), g2d.MakePoint(2, 0), g2d.MakePoint(1, 1.5))
+		)
+		g.AddPolygon(polygon, Style{Fill: "grey"})
+
+		want := `<polygon points="0,0 2,0 1,-1.5" fill="grey"/>` + "\n"
+		if got := writeElement(g.children[0]); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("nested groups", func(t *testing.T) {
+		var (
+			root  Group
+			outer = root.AddGroup("outer", Style{})
+			inner = outer.AddGroup("", Style{Fill: "none"})
+		)
+		inner.AddText(g2d.MakePoint(0, 1), "A", TextStyle{})
+
+		want := "<g id=\"outer\">\n" +
+			"  <g fill=\"none\">\n" +
+			"    <text x=\"0\" y=\"-1\">A</text>\n" +
+			"  </g>\n" +
+			"</g>\n"
+		if got := writeElement(outer); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+		if got := len(root.boundPoints()); got != 1 {
+			t.Errorf("Want 1 bound point, got %d", got)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package svg
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Piece is one of the curves a subpath is made of: a *g2d.Segment, *g2d.QuadBezier,
+// *g2d.CubicBezier or *g2d.Arc.
+type Piece interface {
+	Start() *g2d.Point
+	End() *g2d.Point
+}
+
+// A Subpath is a sequence of connected pieces, where each piece starts at the end of the
+// previous one.
+//
+// A subpath is Closed when its path data ends with a Z command. The last piece of a closed
+// subpath ends at the start of the first one.
+type Subpath struct {
+	Pieces []Piece
+	Closed bool
+}
+
+// Start is the point where the subpath starts.
+func (s *Subpath) Start() *g2d.Point {
+	return s.Pieces[0].Start()
+}
+
+// End is the point where the subpath ends.
+func (s *Subpath) End() *g2d.Point {
+	return s.Pieces[len(s.Pieces)-1].End()
+}
+
+// Flattened approximates the subpath by a polyline whose distance to the curved pieces is
+// smaller than the tolerance. The polyline of a closed subpath ends at its start point.
+//
+// The curved pieces are split in a bounded number of parts, so tolerances which are zero,
+// negative or too small give the finest approximation instead.
+//
+// ErrZeroLength is returned if all the pieces of the subpath are single points.
+func (s *Subpath) Flattened(tolerance float64) (*g2d.Polyline, error) {
+	points := []*g2d.Point{s.Start()}
+
+	for _, piece := range s.Pieces {
+		switch p := piece.(type) {
+		case *g2d.QuadBezier:
+			points = appendFlattenedCurve(points, p.Flattened, tolerance)
+		case *g2d.CubicBezier:
+			points = appendFlattenedCurve(points, p.Flattened, tolerance)
+		case *g2d.Arc:
+			points = append(points, flattenedArc(p, tolerance)...)
+		default:
+			points = append(points, p.End())
+		}
+	}
+
+	polyline, err := g2d.MakePolyline(points...)
+	if err != nil {
+		return nil, ErrZeroLength
+	}
+
+	return polyline, nil
+}
+
+// FlattenedPolygon approximates the closed subpath by a polygon whose distance to the curved
+// pieces is smaller than the tolerance.
+//
+// ErrOpenSubpath is returned if the subpath isn't closed, and ErrZeroLength if all its pieces
+// are single points.
+func (s *Subpath) FlattenedPolygon(tolerance float64) (*g2d.Polygon, error) {
+	if !s.Closed {
+		return nil, ErrOpenSubpath
+	}
+
+	polyline, err := s.Flattened(tolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	return g2d.MakePolygon(polyline.Points()...)
+}
+
+// appendFlattenedCurve appends the points of the flattened curve, excluding its start point.
+// Curves whose control points coincide are a single point, so nothing is appended for them.
+func appendFlattenedCurve(
+	points []*g2d.Point,
+	flattened func(float64) (*g2d.Polyline, error),
+	tolerance float64,
+) []*g2d.Point {
+	polyline, err := flattened(tolerance)
+	if err != nil {
+		return points
+	}
+
+	return append(points, polyline.Points()[1:]...)
+}
+
+// maxArcPieces caps the number of pieces an arc is split in, as the Bézier curves are capped at a
+// subdivision depth of 16, for tolerances too small to be achieved.
+const maxArcPieces = 1 << 16
+
+// flattenedArc returns the points of a polyline that approximates the arc within the tolerance,
+// excluding the arc's start point. The arc is split in equal pieces whose sagitta, the distance
+// from the chord to the arc, is smaller than the tolerance.
+func flattenedArc(arc *g2d.Arc, tolerance float64) []*g2d.Point {
+	pieces := 1
+	if tolerance <= 0 {
+		pieces = maxArcPieces
+	} else if tolerance < arc.Radius() {
+		maxPieceAngle := 2 * math.Acos(1-tolerance/arc.Radius())
+		pieces = int(math.Ceil(math.Min(math.Abs(arc.SweepAngle())/maxPieceAngle, maxArcPieces)))
+		if pieces < 1 {
+			pieces = 1
+		}
+	}
+
+	points := make([]*g2d.Point, pieces)
+	for i := 1; i < pieces; i++ {
+		points[i-1] = arc.PointAt(nums.MakeTParam(float64(i) / float64(pieces)))
+	}
+	points[pieces-1] = arc.End()
+
+	return points
+}
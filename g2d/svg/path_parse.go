@@ -0,0 +1,474 @@
+package svg
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// ParsePath parses SVG path data into subpaths.
+//
+// All the commands are supported, in their absolute (uppercase) and relative (lowercase) forms:
+// M, L, H and V produce segments, Q and T quadratic Béziers and C and S cubic Béziers. The arcs
+// of the A command produce a g2d.Arc if they're circular. Elliptical arcs can't be represented
+// exactly with g2d types, so they're approximated by cubic Béziers, each spanning at most a
+// quarter of the ellipse. The Z command closes the subpath with a segment back to its start,
+// unless the subpath already ends there.
+//
+// Pieces whose points all coincide, like the line in "M 1 1 L 1 1", are discarded, and so are
+// the subpaths left without pieces, like the one in "M 1 1". A non-nil error wrapping ErrSyntax
+// is returned if the path data is malformed.
+func ParsePath(data string) ([]*Subpath, error) {
+	p := &pathParser{scanner: pathScanner{data: data}}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+
+	return p.subpaths, nil
+}
+
+// A pathParser keeps the state of the path while its commands are parsed. The coordinates
+// are kept in the SVG frame, with the Y axis pointing downwards, and only flipped when the g2d
+// pieces are created.
+type pathParser struct {
+	scanner  pathScanner
+	subpaths []*Subpath
+	current  *Subpath
+
+	x, y           float64
+	startX, startY float64
+
+	// The control point of the last Bézier piece, which the S and T commands reflect.
+	ctrlX, ctrlY float64
+	lastCommand  byte
+}
+
+func (p *pathParser) parse() error {
+	p.scanner.skipSeparators()
+	if p.scanner.done() {
+		return nil
+	}
+
+	for !p.scanner.done() {
+		command := p.scanner.next()
+		if p.lastCommand == 0 && command != 'M' && command != 'm' {
+			return p.scanner.errorf("the path data must start with a move command")
+		}
+
+		if err := p.parseCommand(command); err != nil {
+			return err
+		}
+		p.scanner.skipSeparators()
+	}
+
+	p.endSubpath(false)
+	return nil
+}
+
+// parseCommand parses the arguments of the command, repeating it while there are arguments.
+func (p *pathParser) parseCommand(command byte) error {
+	var (
+		relative = command >= 'a' && command <= 'z'
+		upper    = command &^ 0x20
+	)
+
+	if upper == 'Z' {
+		p.closeSubpath()
+		p.lastCommand = upper
+		return nil
+	}
+
+	arity, known := commandArities[upper]
+	if !known {
+		return p.scanner.errorf("unknown command %q", command)
+	}
+
+	for first := true; first || p.scanner.startsNumber(); first = false {
+		args := make([]float64, arity)
+		for i := range args {
+			var err error
+			if upper == 'A' && (i == 3 || i == 4) {
+				args[i], err = p.scanner.flag()
+			} else {
+				args[i], err = p.scanner.number()
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		p.apply(upper, relative, args)
+
+		// The coordinate pairs after a move are implicit lines.
+		if upper == 'M' {
+			upper = 'L'
+		}
+	}
+
+	return nil
+}
+
+var commandArities = map[byte]int{
+	'M': 2, 'L': 2, 'H': 1, 'V': 1, 'C': 6, 'S': 4, 'Q': 4, 'T': 2, 'A': 7,
+}
+
+// apply executes the command with the given arguments.
+func (p *pathParser) apply(command byte, relative bool, args []float64) {
+	var dx, dy float64
+	if relative {
+		dx, dy = p.x, p.y
+	}
+
+	switch command {
+	case 'M':
+		p.endSubpath(false)
+		p.x, p.y = args[0]+dx, args[1]+dy
+		p.startX, p.startY = p.x, p.y
+
+	case 'L':
+		p.lineTo(args[0]+dx, args[1]+dy)
+
+	case 'H':
+		p.lineTo(args[0]+dx, p.y)
+
+	case 'V':
+		p.lineTo(p.x, args[0]+dy)
+
+	case 'C':
+		p.cubicTo(args[0]+dx, args[1]+dy, args[2]+dx, args[3]+dy, args[4]+dx, args[5]+dy)
+
+	case 'S':
+		cx, cy := p.reflectedControl('C', 'S')
+		p.cubicTo(cx, cy, args[0]+dx, args[1]+dy, args[2]+dx, args[3]+dy)
+
+	case 'Q':
+		p.quadTo(args[0]+dx, args[1]+dy, args[2]+dx, args[3]+dy)
+
+	case 'T':
+		cx, cy := p.reflectedControl('Q', 'T')
+		p.quadTo(cx, cy, args[0]+dx, args[1]+dy)
+
+	case 'A':
+		p.arcTo(args[0], args[1], args[2], args[3] != 0, args[4] != 0, args[5]+dx, args[6]+dy)
+	}
+
+	p.lastCommand = command
+}
+
+func (p *pathParser) lineTo(x, y float64) {
+	if !p.isCurrentPoint(x, y) {
+		p.addPiece(g2d.MakeSegment(p.point(p.x, p.y), p.point(x, y)))
+	}
+	p.x, p.y = x, y
+}
+
+func (p *pathParser) cubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	if !p.isCurrentPoint(c1x, c1y, c2x, c2y, x, y) {
+		p.addPiece(g2d.MakeCubicBezier(p.point(p.x, p.y), p.point(c1x, c1y), p.point(c2x, c2y), p.point(x, y)))
+	}
+	p.x, p.y = x, y
+	p.ctrlX, p.ctrlY = c2x, c2y
+}
+
+func (p *pathParser) quadTo(cx, cy, x, y float64) {
+	if !p.isCurrentPoint(cx, cy, x, y) {
+		p.addPiece(g2d.MakeQuadBezier(p.point(p.x, p.y), p.point(cx, cy), p.point(x, y)))
+	}
+	p.x, p.y = x, y
+	p.ctrlX, p.ctrlY = cx, cy
+}
+
+// isCurrentPoint checks whether all the given coordinate pairs are the current point, in which
+// case the piece through them is a single point.
+func (p *pathParser) isCurrentPoint(coords ...float64) bool {
+	for i := 0; i < len(coords); i += 2 {
+		if coords[i] != p.x || coords[i+1] != p.y {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reflectedControl returns the reflection of the last control point about the current point
+// if the previous command was one of the given ones, or the current point otherwise.
+func (p *pathParser) reflectedControl(commands ...byte) (float64, float64) {
+	for _, command := range commands {
+		if p.lastCommand == command {
+			return 2*p.x - p.ctrlX, 2*p.y - p.ctrlY
+		}
+	}
+
+	return p.x, p.y
+}
+
+// arcTo adds the elliptical arc to the given point, converting the SVG endpoint
+// parametrization into the center one, as described in the implementation notes of the SVG
+// specification.
+func (p *pathParser) arcTo(rx, ry, rotationDegs float64, largeArc, sweep bool, x, y float64) {
+	if p.x == x && p.y == y {
+		return
+	}
+
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		p.lineTo(x, y)
+		return
+	}
+
+	var (
+		phi        = rotationDegs * math.Pi / 180
+		sinPhi     = math.Sin(phi)
+		cosPhi     = math.Cos(phi)
+		halfDx     = 0.5 * (p.x - x)
+		halfDy     = 0.5 * (p.y - y)
+		x1         = cosPhi*halfDx + sinPhi*halfDy
+		y1         = -sinPhi*halfDx + cosPhi*halfDy
+		radiiScale = x1*x1/(rx*rx) + y1*y1/(ry*ry)
+	)
+
+	// Radii too small to reach the end point are scaled up until they do.
+	if radiiScale > 1 {
+		rx *= math.Sqrt(radiiScale)
+		ry *= math.Sqrt(radiiScale)
+	}
+
+	var (
+		rx2, ry2 = rx * rx, ry * ry
+		num      = rx2*ry2 - rx2*y1*y1 - ry2*x1*x1
+		den      = rx2*y1*y1 + ry2*x1*x1
+		coef     = math.Sqrt(math.Max(0, num/den))
+	)
+
+	if largeArc == sweep {
+		coef = -coef
+	}
+
+	var (
+		cx1        = coef * rx * y1 / ry
+		cy1        = -coef * ry * x1 / rx
+		cx         = cosPhi*cx1 - sinPhi*cy1 + 0.5*(p.x+x)
+		cy         = sinPhi*cx1 + cosPhi*cy1 + 0.5*(p.y+y)
+		startAngle = math.Atan2((y1-cy1)/ry, (x1-cx1)/rx)
+		endAngle   = math.Atan2((-y1-cy1)/ry, (-x1-cx1)/rx)
+		sweepAngle = endAngle - startAngle
+	)
+
+	if sweep && sweepAngle < 0 {
+		sweepAngle += 2 * math.Pi
+	} else if !sweep && sweepAngle > 0 {
+		sweepAngle -= 2 * math.Pi
+	}
+
+	if nums.FloatsEqual(rx, ry) {
+		p.circularArcTo(cx, cy, rx, startAngle+phi, sweepAngle, x, y)
+	} else {
+		p.ellipticalArcTo(cx, cy, rx, ry, phi, startAngle, sweepAngle, x, y)
+	}
+}
+
+// circularArcTo adds the arc of the circle with the given center and radius which starts at the
+// angle and sweeps the given angle, positive in the SVG frame's direction of rotation.
+//
+// Flipping the Y axis negates the angles, so an arc with a positive sweep in the SVG frame is
+// clockwise in the g2d one.
+func (p *pathParser) circularArcTo(cx, cy, radius, startAngle, sweepAngle, x, y float64) {
+	orientation := g2d.CounterClockwise
+	if sweepAngle > 0 {
+		orientation = g2d.Clockwise
+	}
+
+	arc, _ := g2d.MakeArc(p.point(cx, cy), radius, -startAngle, -(startAngle + sweepAngle), orientation)
+	p.addPiece(arc)
+	p.x, p.y = x, y
+}
+
+// ellipticalArcTo approximates the arc of the ellipse by cubic Béziers, each spanning at most a
+// quarter of the ellipse, whose control points lie on the ellipse's tangents at their ends.
+func (p *pathParser) ellipticalArcTo(cx, cy, rx, ry, phi, startAngle, sweepAngle, x, y float64) {
+	var (
+		pieces     = int(math.Ceil(math.Abs(sweepAngle) / (0.5 * math.Pi)))
+		pieceAngle = sweepAngle / float64(pieces)
+		handle     = 4.0 / 3.0 * math.Tan(0.25*pieceAngle)
+		sinPhi     = math.Sin(phi)
+		cosPhi     = math.Cos(phi)
+	)
+
+	// ellipseAt returns the point and derivative of the ellipse at the given angle.
+	ellipseAt := func(angle float64) (px, py, dx, dy float64) {
+		var (
+			ex, ey   = rx * math.Cos(angle), ry * math.Sin(angle)
+			edx, edy = -rx * math.Sin(angle), ry * math.Cos(angle)
+		)
+
+		return cx + cosPhi*ex - sinPhi*ey, cy + sinPhi*ex + cosPhi*ey,
+			cosPhi*edx - sinPhi*edy, sinPhi*edx + cosPhi*edy
+	}
+
+	for i := 0; i < pieces; i++ {
+		var (
+			_, _, startDx, startDy   = ellipseAt(startAngle + float64(i)*pieceAngle)
+			endX, endY, endDx, endDy = ellipseAt(startAngle + float64(i+1)*pieceAngle)
+		)
+
+		// The last piece ends exactly at the arc's end point, free of rounding errors.
+		if i == pieces-1 {
+			endX, endY = x, y
+		}
+
+		p.cubicTo(
+			p.x+handle*startDx, p.y+handle*startDy,
+			endX-handle*endDx, endY-handle*endDy,
+			endX, endY,
+		)
+	}
+}
+
+// closeSubpath closes the current subpath, adding a segment back to its start if needed.
+func (p *pathParser) closeSubpath() {
+	if p.current != nil && (p.x != p.startX || p.y != p.startY) {
+		p.lineTo(p.startX, p.startY)
+	}
+
+	p.x, p.y = p.startX, p.startY
+	p.endSubpath(true)
+}
+
+// endSubpath finishes the current subpath, if it has any pieces.
+func (p *pathParser) endSubpath(closed bool) {
+	if p.current != nil {
+		p.current.Closed = closed
+		p.subpaths = append(p.subpaths, p.current)
+	}
+
+	p.current = nil
+}
+
+// addPiece adds the piece to the current subpath, starting a new one if needed, for example
+// after closing the previous one.
+func (p *pathParser) addPiece(piece Piece) {
+	if p.current == nil {
+		p.current = &Subpath{}
+	}
+
+	p.current.Pieces = append(p.current.Pieces, piece)
+}
+
+// point creates the g2d point corresponding to the given SVG coordinates.
+func (p *pathParser) point(x, y float64) *g2d.Point {
+	return g2d.MakePoint(x, flipY(y))
+}
+
+// A pathScanner reads the commands, numbers and flags of the path data.
+type pathScanner struct {
+	data string
+	pos  int
+}
+
+func (s *pathScanner) done() bool {
+	return s.pos >= len(s.data)
+}
+
+func (s *pathScanner) next() byte {
+	c := s.data[s.pos]
+	s.pos++
+
+	return c
+}
+
+// skipSeparators advances past the whitespace and commas.
+func (s *pathScanner) skipSeparators() {
+	for !s.done() {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\n', '\r', '\f', ',':
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+// startsNumber checks whether the next token, after the separators, is a number.
+func (s *pathScanner) startsNumber() bool {
+	s.skipSeparators()
+	if s.done() {
+		return false
+	}
+
+	c := s.data[s.pos]
+	return c == '+' || c == '-' || c == '.' || isDigit(c)
+}
+
+// number reads the next number. Numbers don't need separators when there's no ambiguity, as in
+// "1-2" or "0.5.5".
+func (s *pathScanner) number() (float64, error) {
+	s.skipSeparators()
+
+	var (
+		start     = s.pos
+		hasDigits = false
+	)
+
+	if !s.done() && (s.data[s.pos] == '+' || s.data[s.pos] == '-') {
+		s.pos++
+	}
+	for !s.done() && isDigit(s.data[s.pos]) {
+		s.pos++
+		hasDigits = true
+	}
+	if !s.done() && s.data[s.pos] == '.' {
+		s.pos++
+		for !s.done() && isDigit(s.data[s.pos]) {
+			s.pos++
+			hasDigits = true
+		}
+	}
+
+	if !hasDigits {
+		s.pos = start
+		return 0, s.errorf("expected a number")
+	}
+
+	if !s.done() && (s.data[s.pos] == 'e' || s.data[s.pos] == 'E') {
+		exponent := s.pos + 1
+		if exponent < len(s.data) && (s.data[exponent] == '+' || s.data[exponent] == '-') {
+			exponent++
+		}
+		if exponent < len(s.data) && isDigit(s.data[exponent]) {
+			for exponent < len(s.data) && isDigit(s.data[exponent]) {
+				exponent++
+			}
+			s.pos = exponent
+		}
+	}
+
+	value, err := strconv.ParseFloat(s.data[start:s.pos], 64)
+	if err != nil || math.IsInf(value, 0) {
+		s.pos = start
+		return 0, s.errorf("invalid number")
+	}
+
+	return value, nil
+}
+
+// flag reads the next arc flag: a single 0 or 1, which doesn't need separators, as in "a1 1 0 01
+// 5 5".
+func (s *pathScanner) flag() (float64, error) {
+	s.skipSeparators()
+	if s.done() || (s.data[s.pos] != '0' && s.data[s.pos] != '1') {
+		return 0, s.errorf("expected an arc flag")
+	}
+
+	return float64(s.next() - '0'), nil
+}
+
+func (s *pathScanner) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrSyntax, fmt.Sprintf(format, args...), s.pos)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,259 @@
+package svg
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func mustParsePath(t *testing.T, data string) []*Subpath {
+	t.Helper()
+
+	subpaths, err := ParsePath(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return subpaths
+}
+
+func assertPoint(t *testing.T, want, got *g2d.Point) {
+	t.Helper()
+
+	if !got.EqualsTol(want, nums.MakeAbsoluteTolerance(1e-9)) {
+		t.Errorf("Want %v, got %v", want, got)
+	}
+}
+
+func TestParsePathLines(t *testing.T) {
+	t.Run("absolute and relative forms are equivalent", func(t *testing.T) {
+		var (
+			absolute = mustParsePath(t, "M 10 20 L 30 20 H 40 V 50 Z")
+			relative = mustParsePath(t, "m10,20 l20,0 h10 v30 z")
+		)
+
+		if len(absolute) != 1 || len(relative) != 1 {
+			t.Fatalf("Want one subpath each, got %d and %d", len(absolute), len(relative))
+		}
+
+		for i, want := range []*g2d.Point{
+			g2d.MakePoint(30, -20), g2d.MakePoint(40, -20), g2d.MakePoint(40, -50), g2d.MakePoint(10, -20),
+		} {
+			assertPoint(t, want, absolute[0].Pieces[i].End())
+			assertPoint(t, want, relative[0].Pieces[i].End())
+		}
+
+		if !absolute[0].Closed || !relative[0].Closed {
+			t.Error("Expected the subpaths to be closed")
+		}
+	})
+
+	t.Run("implicit lines after a move", func(t *testing.T) {
+		subpaths := mustParsePath(t, "m 1 1 2 0 0 2")
+
+		if got := len(subpaths[0].Pieces); got != 2 {
+			t.Fatalf("Want 2 pieces, got %d", got)
+		}
+		assertPoint(t, g2d.MakePoint(3, -3), subpaths[0].End())
+	})
+
+	t.Run("close doesn't repeat the start point", func(t *testing.T) {
+		subpaths := mustParsePath(t, "M0 0L1 0L0 0Z")
+
+		if got := len(subpaths[0].Pieces); got != 2 {
+			t.Errorf("Want 2 pieces, got %d", got)
+		}
+	})
+
+	t.Run("several subpaths", func(t *testing.T) {
+		subpaths := mustParsePath(t, "M0 0 L1 1 M5 5 M 2 2 L3 3 Z l1 0")
+
+		if len(subpaths) != 3 {
+			t.Fatalf("Want 3 subpaths, got %d", len(subpaths))
+		}
+		if subpaths[0].Closed || !subpaths[1].Closed || subpaths[2].Closed {
+			t.Error("Unexpected closed subpaths")
+		}
+		assertPoint(t, g2d.MakePoint(2, -2), subpaths[2].Start())
+	})
+
+	t.Run("compact numbers", func(t *testing.T) {
+		subpaths := mustParsePath(t, "M.5.5L-1-2e1l1e-1+1")
+
+		assertPoint(t, g2d.MakePoint(0.5, -0.5), subpaths[0].Start())
+		assertPoint(t, g2d.MakePoint(-1, 20), subpaths[0].Pieces[0].End())
+		assertPoint(t, g2d.MakePoint(-0.9, 19), subpaths[0].End())
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		if got := mustParsePath(t, "  "); len(got) != 0 {
+			t.Errorf("Want no subpaths, got %d", len(got))
+		}
+	})
+}
+
+func TestParsePathBeziers(t *testing.T) {
+	t.Run("smooth cubic reflects the control point", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M0 0 C 0 1 1 1 1 0 s 1 -1 1 0")
+			smooth   = subpaths[0].Pieces[1].(*g2d.CubicBezier)
+		)
+
+		assertPoint(t, g2d.MakePoint(1, 1), smooth.ControlA())
+		assertPoint(t, g2d.MakePoint(2, 1), smooth.ControlB())
+		assertPoint(t, g2d.MakePoint(2, 0), smooth.End())
+	})
+
+	t.Run("smooth quadratic reflects the control point", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M0 0 Q1 -1 2 0 T4 0 T6 0")
+			second   = subpaths[0].Pieces[1].(*g2d.QuadBezier)
+			third    = subpaths[0].Pieces[2].(*g2d.QuadBezier)
+		)
+
+		assertPoint(t, g2d.MakePoint(3, -1), second.Control())
+		assertPoint(t, g2d.MakePoint(5, 1), third.Control())
+	})
+
+	t.Run("smooth command without a previous curve", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M0 0 L1 0 T2 0")
+			quad     = subpaths[0].Pieces[1].(*g2d.QuadBezier)
+		)
+
+		assertPoint(t, g2d.MakePoint(1, 0), quad.Control())
+	})
+}
+
+func TestParsePathArcs(t *testing.T) {
+	t.Run("circular arc", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M 10 0 A 10 10 0 0 1 0 10")
+			arc      = subpaths[0].Pieces[0].(*g2d.Arc)
+		)
+
+		assertPoint(t, g2d.MakePoint(0, 0), arc.Center())
+		assertPoint(t, g2d.MakePoint(10, 0), arc.Start())
+		assertPoint(t, g2d.MakePoint(0, -10), arc.End())
+
+		if arc.Orientation() != g2d.Clockwise {
+			t.Errorf("Want a clockwise arc, got %v", arc.Orientation())
+		}
+		if !nums.FloatsEqual(arc.SweepAngle(), 0.5*math.Pi) {
+			t.Errorf("Want a sweep of π/2, got %f", arc.SweepAngle())
+		}
+	})
+
+	t.Run("large arc with compact flags", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M10 0a10 10 0 10-10 10")
+			arc      = subpaths[0].Pieces[0].(*g2d.Arc)
+		)
+
+		assertPoint(t, g2d.MakePoint(0, 0), arc.Center())
+		if !nums.FloatsEqual(arc.SweepAngle(), 1.5*math.Pi) {
+			t.Errorf("Want a sweep of 3π/2, got %f", arc.SweepAngle())
+		}
+	})
+
+	t.Run("radii too small are scaled up", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M0 0 A 1 1 0 0 0 4 0")
+			arc      = subpaths[0].Pieces[0].(*g2d.Arc)
+		)
+
+		if !nums.FloatsEqual(arc.Radius(), 2) {
+			t.Errorf("Want a radius of 2, got %f", arc.Radius())
+		}
+		assertPoint(t, g2d.MakePoint(2, 0), arc.Center())
+	})
+
+	t.Run("elliptical arc", func(t *testing.T) {
+		var (
+			subpaths = mustParsePath(t, "M 20 0 A 20 10 0 1 0 0 10")
+			pieces   = subpaths[0].Pieces
+		)
+
+		if len(pieces) != 3 {
+			t.Fatalf("Want 3 cubic pieces, got %d", len(pieces))
+		}
+
+		for _, piece := range pieces {
+			var (
+				bezier = piece.(*g2d.CubicBezier)
+				mid    = bezier.PointAt(nums.HalfT)
+				onEdge = mid.X()*mid.X()/400 + mid.Y()*mid.Y()/100
+			)
+
+			if math.Abs(onEdge-1) > 1e-3 {
+				t.Errorf("Expected %v to be on the ellipse", mid)
+			}
+		}
+		assertPoint(t, g2d.MakePoint(0, -10), subpaths[0].End())
+	})
+
+	t.Run("degenerate arcs", func(t *testing.T) {
+		subpaths := mustParsePath(t, "M0 0 A 5 5 0 0 0 0 0 A 0 5 0 0 0 3 4")
+
+		if len(subpaths[0].Pieces) != 1 {
+			t.Fatalf("Want a single piece, got %d", len(subpaths[0].Pieces))
+		}
+		if _, isSegment := subpaths[0].Pieces[0].(*g2d.Segment); !isSegment {
+			t.Errorf("Want a segment, got %T", subpaths[0].Pieces[0])
+		}
+	})
+}
+
+func TestParsePathZeroLengthPieces(t *testing.T) {
+	for _, test := range []struct {
+		data   string
+		pieces int
+	}{
+		{"M0 0 L0 0", 0},
+		{"M 0 0 Q 0 0 0 0", 0},
+		{"M 0 0 T 0 0 0 11", 1},
+		{"M 0 0 C 0 0 0 0 0 0 L 5 5", 1},
+		{"M 0 0 Q 0 0 0 0 T 3 4 Z", 2},
+	} {
+		t.Run(test.data, func(t *testing.T) {
+			var (
+				subpaths = mustParsePath(t, test.data)
+				pieces   = 0
+			)
+
+			for _, subpath := range subpaths {
+				pieces += len(subpath.Pieces)
+
+				if _, err := subpath.Flattened(0.1); err != nil {
+					t.Errorf("Want no error flattening, got %v", err)
+				}
+			}
+
+			if pieces != test.pieces {
+				t.Errorf("Want %d pieces, got %d", test.pieces, pieces)
+			}
+		})
+	}
+}
+
+func TestParsePathErrors(t *testing.T) {
+	for _, data := range []string{
+		"L 1 1",
+		"M 1",
+		"M 1 1 L 2",
+		"M 1 1 X 2 2",
+		"M 1 1 A 1 1 0 2 0 3 3",
+		"M 1 1 L 2 . 3",
+		"M 1 1 Z 4",
+		"M 1e999 0",
+	} {
+		t.Run(data, func(t *testing.T) {
+			if _, err := ParsePath(data); !errors.Is(err, ErrSyntax) {
+				t.Errorf("Want ErrSyntax, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package svg
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestSubpathFlattened(t *testing.T) {
+	t.Run("segments keep their points", func(t *testing.T) {
+		polyline, _ := mustParsePath(t, "M0 0 H 2 V 2")[0].Flattened(0.01)
+
+		if got := len(polyline.Points()); got != 3 {
+			t.Errorf("Want 3 points, got %d", got)
+		}
+	})
+
+	t.Run("arcs within the tolerance", func(t *testing.T) {
+		var (
+			tolerance   = 0.01
+			polyline, _ = mustParsePath(t, "M 10 0 A 10 10 0 0 0 -10 0")[0].Flattened(tolerance)
+			points      = polyline.Points()
+		)
+
+		if len(points) < 10 {
+			t.Errorf("Want at least 10 points, got %d", len(points))
+		}
+
+		for i := 1; i < len(points); i++ {
+			var (
+				mid      = g2d.MakeSegment(points[i-1], points[i]).PointAt(nums.HalfT)
+				distance = 10 - mid.DistanceTo(g2d.MakePoint(0, 0))
+			)
+
+			if distance > tolerance {
+				t.Errorf("Chord %d is %f away from the arc", i, distance)
+			}
+		}
+
+		assertPoint(t, g2d.MakePoint(-10, 0), polyline.End())
+	})
+
+	t.Run("arcs with a tolerance too small", func(t *testing.T) {
+		subpath := mustParsePath(t, "M 10 0 A 10 10 0 0 0 -10 0")[0]
+
+		for _, tolerance := range []float64{0, -1, 1e-300} {
+			polyline, _ := subpath.Flattened(tolerance)
+			points := polyline.Points()
+
+			if got := len(points); got != maxArcPieces+1 {
+				t.Errorf("Want %d points for tolerance %g, got %d", maxArcPieces+1, tolerance, got)
+			}
+			assertPoint(t, g2d.MakePoint(-10, 0), points[len(points)-1])
+		}
+	})
+
+	t.Run("curves end at their end points", func(t *testing.T) {
+		polyline, _ := mustParsePath(t, "M0 0 Q 1 2 2 0 C 3 -2 4 2 5 0")[0].Flattened(0.001)
+
+		assertPoint(t, g2d.MakePoint(0, 0), polyline.Start())
+		assertPoint(t, g2d.MakePoint(5, 0), polyline.End())
+	})
+
+	t.Run("curves whose points coincide", func(t *testing.T) {
+		var (
+			p       = g2d.MakePoint(1, 1)
+			subpath = &Subpath{Pieces: []Piece{
+				g2d.MakeQuadBezier(p, p, p),
+				g2d.MakeSegment(p, g2d.MakePoint(2, 2)),
+				g2d.MakeCubicBezier(g2d.MakePoint(2, 2), g2d.MakePoint(2, 2), g2d.MakePoint(2, 2), g2d.MakePoint(2, 2)),
+			}}
+			polyline, err = subpath.Flattened(0.1)
+		)
+
+		if err != nil || len(polyline.Points()) != 2 {
+			t.Errorf("Want a single segment, got %v (%v)", polyline, err)
+		}
+	})
+
+	t.Run("subpath of zero length", func(t *testing.T) {
+		var (
+			p       = g2d.MakePoint(1, 1)
+			subpath = &Subpath{Pieces: []Piece{g2d.MakeSegment(p, p)}, Closed: true}
+		)
+
+		if _, err := subpath.Flattened(0.1); !errors.Is(err, ErrZeroLength) {
+			t.Errorf("Want ErrZeroLength, got %v", err)
+		}
+		if _, err := subpath.FlattenedPolygon(0.1); !errors.Is(err, ErrZeroLength) {
+			t.Errorf("Want ErrZeroLength, got %v", err)
+		}
+	})
+}
+
+func TestSubpathFlattenedPolygon(t *testing.T) {
+	t.Run("circle", func(t *testing.T) {
+		var (
+			subpath    = mustParsePath(t, "M 1 0 A 1 1 0 0 0 -1 0 A 1 1 0 0 0 1 0 Z")[0]
+			polygon, _ = subpath.FlattenedPolygon(1e-4)
+		)
+
+		if got := math.Abs(polygon.Area()); math.Abs(got-math.Pi) > 1e-3 {
+			t.Errorf("Want an area close to π, got %f", got)
+		}
+	})
+
+	t.Run("open subpath", func(t *testing.T) {
+		subpath := mustParsePath(t, "M 0 0 L 1 0 L 1 1")[0]
+
+		if _, err := subpath.FlattenedPolygon(0.1); !errors.Is(err, ErrOpenSubpath) {
+			t.Errorf("Want ErrOpenSubpath, got %v", err)
+		}
+	})
+}
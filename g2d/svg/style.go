@@ -0,0 +1,73 @@
+package svg
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// A Style sets how the shapes are stroked and filled.
+//
+// Empty fields aren't written, so the shapes inherit them from the enclosing groups or take the
+// SVG defaults: no stroke and a black fill. Polylines are the exception: they aren't filled
+// unless the Fill is set.
+type Style struct {
+	// Stroke is the color of the outline, such as "black" or "#ff0000".
+	Stroke string
+	// StrokeWidth is the width of the outline, in drawing units.
+	StrokeWidth float64
+	// Fill is the color of the interior, or "none".
+	Fill string
+	// Opacity, between 0 and 1, applies to both the stroke and the fill.
+	Opacity float64
+	// DashArray is the pattern of dashes and gaps of the outline, in drawing units.
+	DashArray []float64
+}
+
+// A TextStyle sets how the text labels are rendered.
+// Like with Style, empty fields aren't written.
+type TextStyle struct {
+	// FontSize is the height of the font, in drawing units.
+	FontSize float64
+	// FontFamily is the name of the font, such as "sans-serif".
+	FontFamily string
+	// Fill is the color of the text.
+	Fill string
+	// Anchor aligns the text with its position: "start", "middle" or "end".
+	Anchor string
+}
+
+func (s Style) writeAttrs(b *strings.Builder) {
+	writeAttr(b, "stroke", s.Stroke)
+	if s.StrokeWidth > 0 {
+		writeAttr(b, "stroke-width", formatNumber(s.StrokeWidth))
+	}
+	writeAttr(b, "fill", s.Fill)
+	if s.Opacity > 0 {
+		writeAttr(b, "opacity", formatNumber(s.Opacity))
+	}
+	if len(s.DashArray) > 0 {
+		writeAttr(b, "stroke-dasharray", formatNumbers(s.DashArray...))
+	}
+}
+
+func (s TextStyle) writeAttrs(b *strings.Builder) {
+	if s.FontSize > 0 {
+		writeAttr(b, "font-size", formatNumber(s.FontSize))
+	}
+	writeAttr(b, "font-family", s.FontFamily)
+	writeAttr(b, "fill", s.Fill)
+	writeAttr(b, "text-anchor", s.Anchor)
+}
+
+// writeAttr writes the attribute, with its value escaped, unless the value is empty.
+func writeAttr(b *strings.Builder, name, value string) {
+	if value == "" {
+		return
+	}
+
+	b.WriteByte(' ')
+	b.WriteString(name)
+	b.WriteString(`="`)
+	xml.EscapeText(b, []byte(value))
+	b.WriteByte('"')
+}
@@ -0,0 +1,53 @@
+package svg
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStyleAttrs(t *testing.T) {
+	t.Run("empty style", func(t *testing.T) {
+		var b strings.Builder
+		Style{}.writeAttrs(&b)
+
+		if got := b.String(); got != "" {
+			t.Errorf("Want no attributes, got %q", got)
+		}
+	})
+
+	t.Run("full style", func(t *testing.T) {
+		var b strings.Builder
+		Style{
+			Stroke:      "#ff0000",
+			StrokeWidth: 0.25,
+			Fill:        "none",
+			Opacity:     0.5,
+			DashArray:   []float64{1, 0.5},
+		}.writeAttrs(&b)
+
+		want := ` stroke="#ff0000" stroke-width="0.25" fill="none" opacity="0.5" stroke-dasharray="1 0.5"`
+		if got := b.String(); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("text style", func(t *testing.T) {
+		var b strings.Builder
+		TextStyle{FontSize: 12, FontFamily: "sans-serif", Fill: "blue", Anchor: "end"}.writeAttrs(&b)
+
+		want := ` font-size="12" font-family="sans-serif" fill="blue" text-anchor="end"`
+		if got := b.String(); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("escapes the values", func(t *testing.T) {
+		var b strings.Builder
+		TextStyle{FontFamily: `"Open Sans" & co`}.writeAttrs(&b)
+
+		want := ` font-family="&#34;Open Sans&#34; &amp; co"`
+		if got := b.String(); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+}
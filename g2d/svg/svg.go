@@ -0,0 +1,112 @@
+// Package svg renders two-dimensional geometry as Scalable Vector Graphics.
+//
+// A Drawing holds a tree of elements: points, segments, rectangles, polylines, polygons and
+// text labels, organized in groups which share a style. When written, the drawing's viewBox is
+// fitted to the bounding rectangle of every element plus a margin.
+//
+// The geometry is given in the g2d coordinates, where the Y axis points upwards, but the SVG
+// Y axis points downwards. The Y coordinates are negated when written, instead of flipping the
+// whole drawing with a transform, so that text labels aren't rendered upside down.
+//
+// ParsePath reads SVG path data, such as the "d" attribute of the paths drawn in Inkscape, into
+// g2d geometry. The Y coordinates are negated too, so a path written and parsed back keeps its
+// coordinates.
+package svg
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+var (
+	// ErrEmpty is returned when writing a drawing without elements, whose viewBox can't be
+	// fitted.
+	ErrEmpty = errors.New("can't fit the viewBox of an empty drawing")
+	// ErrSyntax is returned when parsing invalid SVG path data.
+	ErrSyntax = errors.New("invalid SVG path data")
+	// ErrOpenSubpath is returned when converting a subpath which isn't closed into a polygon.
+	ErrOpenSubpath = errors.New("the subpath isn't closed")
+	// ErrZeroLength is returned when flattening a subpath whose pieces are all single points.
+	ErrZeroLength = errors.New("the subpath has zero length")
+)
+
+// A Drawing is an SVG document whose viewBox is fitted to its contents.
+// Elements are added to the drawing's root group.
+type Drawing struct {
+	Group
+	margin float64
+}
+
+// MakeDrawing creates an empty drawing whose viewBox leaves the given margin around the
+// geometry, in drawing units.
+func MakeDrawing(margin float64) *Drawing {
+	return &Drawing{margin: margin}
+}
+
+// ViewBox computes the rectangle, in g2d coordinates, that the drawing displays: the one
+// containing all the elements, extended by the margin.
+func (d *Drawing) ViewBox() (*g2d.Rect, error) {
+	points := d.boundPoints()
+	if len(points) == 0 {
+		return nil, ErrEmpty
+	}
+
+	bounds, err := g2d.MakeRectContaining(points)
+	if err != nil {
+		return nil, err
+	}
+
+	return bounds.WithMargins(d.margin, d.margin)
+}
+
+// WriteTo writes the SVG document to the writer.
+func (d *Drawing) WriteTo(w io.Writer) (int64, error) {
+	viewBox, err := d.ViewBox()
+	if err != nil {
+		return 0, err
+	}
+
+	var b strings.Builder
+	b.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="`)
+	b.WriteString(formatNumbers(viewBox.Left(), flipY(viewBox.Top()), viewBox.Width(), viewBox.Height()))
+	b.WriteString(`">`)
+	b.WriteByte('\n')
+	d.Group.writeChildren(&b, 1)
+	b.WriteString("</svg>\n")
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// String returns the SVG document, or an empty string if the drawing is empty.
+func (d *Drawing) String() string {
+	var b strings.Builder
+	if _, err := d.WriteTo(&b); err != nil {
+		return ""
+	}
+
+	return b.String()
+}
+
+// flipY converts a g2d Y coordinate into an SVG one.
+// Subtracting from zero, instead of negating, avoids writing "-0".
+func flipY(y float64) float64 {
+	return 0 - y
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func formatNumbers(values ...float64) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatNumber(value)
+	}
+
+	return strings.Join(formatted, " ")
+}
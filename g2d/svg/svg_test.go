@@ -0,0 +1,68 @@
+package svg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestDrawingViewBox(t *testing.T) {
+	t.Run("fits the elements and the margin", func(t *testing.T) {
+		drawing := MakeDrawing(1)
+		drawing.AddSegment(g2d.MakeSegmentFromCoords(0, 0, 10, 5), Style{})
+		drawing.AddGroup("", Style{}).AddPoint(g2d.MakePoint(12, -2), 0.5, Style{})
+
+		got, err := drawing.ViewBox()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := g2d.MakeRect(g2d.MakePoint(-1, -3.5), 14.5, 9.5)
+		if !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("empty drawing", func(t *testing.T) {
+		drawing := MakeDrawing(1)
+		drawing.AddGroup("empty", Style{})
+
+		if _, err := drawing.ViewBox(); !errors.Is(err, ErrEmpty) {
+			t.Errorf("Want ErrEmpty, got %v", err)
+		}
+		if got := drawing.String(); got != "" {
+			t.Errorf("Want an empty string, got %q", got)
+		}
+	})
+}
+
+func TestDrawingWrite(t *testing.T) {
+	drawing := MakeDrawing(0.5)
+	structure := drawing.AddGroup("structure", Style{Stroke: "black", StrokeWidth: 0.1})
+	structure.AddSegment(g2d.MakeSegmentFromCoords(0, 0, 4, 0), Style{})
+	structure.AddSegment(g2d.MakeSegmentFromCoords(4, 0, 4, 3), Style{DashArray: []float64{0.2, 0.1}})
+	drawing.AddText(g2d.MakePoint(2, 0), "Beam <1>", TextStyle{FontSize: 0.3, Anchor: "middle"})
+
+	var b strings.Builder
+	n, err := drawing.WriteTo(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `<svg xmlns="http://www.w3.org/2000/svg" viewBox="-0.5 -3.5 5 4">
+  <g id="structure" stroke="black" stroke-width="0.1">
+    <line x1="0" y1="0" x2="4" y2="0"/>
+    <line x1="4" y1="0" x2="4" y2="-3" stroke-dasharray="0.2 0.1"/>
+  </g>
+  <text x="2" y="0" font-size="0.3" text-anchor="middle">Beam &lt;1&gt;</text>
+</svg>
+`
+	if got := b.String(); got != want {
+		t.Errorf("Want:\n%s\ngot:\n%s", want, got)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("Want %d bytes written, got %d", len(want), n)
+	}
+}
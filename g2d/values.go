@@ -0,0 +1,75 @@
+package g2d
+
+// The operations on points and vectors return newly allocated results, which is convenient but
+// slow when processing large numbers of them. The functions in this file work with values and
+// caller-owned destinations instead, so that hot loops don't allocate:
+//
+//	var (
+//		p     = MakePointVal(1, 2)
+//		dir   = MakeVectorVal(3, 4)
+//		sum   Vector
+//		moved Point
+//	)
+//
+//	dir.PlusInto(&dir, &sum)
+//	p.DisplacedInto(&sum, 0.5, &moved)
+//
+// The destination of the "Into" operations may be the receiver or one of the operands. It's the
+// only value these operations change, so it should never be a shared instance, like the versors
+// defined in this package.
+
+// MakePointVal creates a new point value with the given X and Y coordinates.
+func MakePointVal(x, y float64) Point {
+	return Point{x, y}
+}
+
+// MakeVectorVal creates a new vector value with the given projections.
+func MakeVectorVal(x, y float64) Vector {
+	return Vector{x, y}
+}
+
+// VectorToInto stores the vector from this point to the other in dst, and returns it.
+func (from *Point) VectorToInto(to *Point, dst *Vector) *Vector {
+	dst.x, dst.y = to.x-from.x, to.y-from.y
+	return dst
+}
+
+// DisplacedInto stores the point resulting from displacing this one by the vector scaled the
+// given number of times in dst, and returns it.
+func (p *Point) DisplacedInto(vector *Vector, times float64, dst *Point) *Point {
+	dst.x, dst.y = p.x+vector.x*times, p.y+vector.y*times
+	return dst
+}
+
+// PlusInto stores the sum of this and the other vector in dst, and returns it.
+func (v *Vector) PlusInto(other, dst *Vector) *Vector {
+	dst.x, dst.y = v.x+other.x, v.y+other.y
+	return dst
+}
+
+// MinusInto stores the difference between this and the other vector in dst, and returns it.
+func (v *Vector) MinusInto(other, dst *Vector) *Vector {
+	dst.x, dst.y = v.x-other.x, v.y-other.y
+	return dst
+}
+
+// ScaledInto stores the vector with the projections scaled by the given factor in dst, and
+// returns it.
+func (v *Vector) ScaledInto(factor float64, dst *Vector) *Vector {
+	dst.x, dst.y = v.x*factor, v.y*factor
+	return dst
+}
+
+// PerpendicularInto stores the result of rotating this vector π/2 radians in dst, and returns
+// it.
+func (v *Vector) PerpendicularInto(dst *Vector) *Vector {
+	dst.x, dst.y = -v.y, v.x
+	return dst
+}
+
+// ToVersorInto stores the versor with the same direction as this vector in dst, and returns it.
+func (v *Vector) ToVersorInto(dst *Vector) *Vector {
+	length := computeLength(v.x, v.y)
+	dst.x, dst.y = v.x/length, v.y/length
+	return dst
+}
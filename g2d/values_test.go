@@ -0,0 +1,89 @@
+package g2d
+
+import "testing"
+
+func TestValueOperations(t *testing.T) {
+	var (
+		p = MakePointVal(1, 2)
+		q = MakePointVal(4, 6)
+		v Vector
+	)
+
+	t.Run("vector to", func(t *testing.T) {
+		if got := p.VectorToInto(&q, &v); !got.Equals(MakeVector(3, 4)) || got != &v {
+			t.Errorf("Want {3, 4} in the destination, got %v", got)
+		}
+	})
+
+	t.Run("displaced", func(t *testing.T) {
+		var moved Point
+		if got := p.DisplacedInto(&v, 1, &moved); !got.Equals(&q) {
+			t.Errorf("Want %v, got %v", q, got)
+		}
+	})
+
+	t.Run("destination aliasing an operand", func(t *testing.T) {
+		u := MakeVectorVal(1, 2)
+		u.PlusInto(&u, &u)
+		if !u.Equals(MakeVector(2, 4)) {
+			t.Errorf("Want {2, 4}, got %v", u)
+		}
+
+		u.PerpendicularInto(&u)
+		if !u.Equals(MakeVector(-4, 2)) {
+			t.Errorf("Want {-4, 2}, got %v", u)
+		}
+	})
+
+	t.Run("scaled, minus and versor", func(t *testing.T) {
+		var (
+			u = MakeVectorVal(3, 4)
+			w Vector
+		)
+
+		if got := u.ScaledInto(2, &w).MinusInto(&u, &w); !got.Equals(&u) {
+			t.Errorf("Want %v, got %v", u, got)
+		}
+		if got := u.ToVersorInto(&w); !got.Equals(MakeVector(0.6, 0.8)) {
+			t.Errorf("Want {0.6, 0.8}, got %v", got)
+		}
+	})
+
+	t.Run("no allocations", func(t *testing.T) {
+		var (
+			a, b = MakeVectorVal(1, 2), MakeVectorVal(3, 4)
+			dst  Vector
+		)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			a.PlusInto(&b, &dst).ScaledInto(2, &dst).MinusInto(&a, &dst)
+		})
+		if allocs != 0 {
+			t.Errorf("Want no allocations, got %f", allocs)
+		}
+	})
+}
+
+var benchVector *Vector
+
+func BenchmarkVectorOperations(b *testing.B) {
+	var (
+		u = MakeVector(1, 2)
+		v = MakeVector(3, 4)
+	)
+
+	b.Run("pointers", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchVector = u.Plus(v).Scaled(2).Minus(u)
+		}
+	})
+
+	b.Run("into destination", func(b *testing.B) {
+		b.ReportAllocs()
+		var dst Vector
+		for i := 0; i < b.N; i++ {
+			benchVector = u.PlusInto(v, &dst).ScaledInto(2, &dst).MinusInto(u, &dst)
+		}
+	})
+}
@@ -43,13 +43,23 @@ func (v *Vector) Length() float64 {
 
 // IsVersor returns true if the vector has a length of 1.
 func (v *Vector) IsVersor() bool {
-	return nums.IsCloseToOne(v.Length())
+	return v.IsVersorTol(nums.DefaultTolerance)
+}
+
+// IsVersorTol returns true if the vector has a length of 1 within the given tolerance.
+func (v *Vector) IsVersorTol(tol nums.Tolerance) bool {
+	return tol.IsOne(v.Length())
 }
 
 // Equals returns true if the projections of this and other vector are equal.
 func (v *Vector) Equals(other *Vector) bool {
-	return nums.FloatsEqual(v.x, other.x) &&
-		nums.FloatsEqual(v.y, other.y)
+	return v.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol returns true if the projections of this and other vector are equal within the
+// given tolerance.
+func (v *Vector) EqualsTol(other *Vector, tol nums.Tolerance) bool {
+	return tol.Equal(v.x, other.x) && tol.Equal(v.y, other.y)
 }
 
 // ToVersor returns a versor with the same direction as this vector.
@@ -91,6 +101,28 @@ func (v *Vector) CrossTimes(other *Vector) float64 {
 	return (v.x * other.y) - (v.y * other.x)
 }
 
+// IsParallelTo checks whether this and other vectors have the same direction (are parallel),
+// that is, their cross product is zero.
+func (v *Vector) IsParallelTo(other *Vector) bool {
+	return v.IsParallelToTol(other, nums.DefaultTolerance)
+}
+
+// IsParallelToTol checks whether this and other vectors have the same direction (are parallel),
+// that is, their cross product is zero within the given tolerance.
+func (v *Vector) IsParallelToTol(other *Vector, tol nums.Tolerance) bool {
+	return tol.IsZero(v.CrossTimes(other))
+}
+
+// IsParallelToExact checks whether this and other vectors have the same direction (are
+// parallel), without rounding errors.
+//
+// The vectors are parallel only if their cross product is exactly zero. Vectors which are
+// parallel but have been computed with rounding errors (for example, normalized) may not be
+// considered parallel.
+func (v *Vector) IsParallelToExact(other *Vector) bool {
+	return nums.Orient2D([2]float64{v.x, v.y}, [2]float64{other.x, other.y}, [2]float64{0, 0}) == 0
+}
+
 // AngleInRadsFromX returns the angle (in radians) between this vector and the X axis.
 // The returned angle is in the range [-π, π].
 func (v *Vector) AngleInRadsFromX() float64 {
@@ -78,6 +78,50 @@ func TestVectorsCrossProduct(t *testing.T) {
 	assert.True(t, nums.FloatsEqual(p, -1.0))
 }
 
+func TestVectorParallelism(t *testing.T) {
+	t.Run("parallel vectors", func(t *testing.T) {
+		assert.True(t, MakeVector(1, 2).IsParallelTo(MakeVector(-3, -6)))
+	})
+
+	t.Run("non parallel vectors", func(t *testing.T) {
+		assert.False(t, MakeVector(1, 2).IsParallelTo(MakeVector(3, 5)))
+	})
+
+	t.Run("parallel to its versor", func(t *testing.T) {
+		assert.True(t, MakeVersor(1, 3).IsParallelTo(MakeVector(1, 3)))
+	})
+
+	t.Run("nearly parallel within a tolerance", func(t *testing.T) {
+		var (
+			u   = MakeVector(1, 2)
+			v   = MakeVector(1, 2.001)
+			tol = nums.MakeAbsoluteTolerance(0.01)
+		)
+
+		assert.False(t, u.IsParallelTo(v))
+		assert.True(t, u.IsParallelToTol(v, tol))
+	})
+}
+
+func TestVectorExactParallelism(t *testing.T) {
+	t.Run("parallel vectors", func(t *testing.T) {
+		assert.True(t, MakeVector(1, 2).IsParallelToExact(MakeVector(-3, -6)))
+	})
+
+	t.Run("nearly parallel tiny vectors", func(t *testing.T) {
+		assert.False(t, MakeVector(1e-9, 2e-9).IsParallelToExact(MakeVector(1e-9, 2.1e-9)))
+	})
+
+	t.Run("nearly parallel huge vectors", func(t *testing.T) {
+		var (
+			u = MakeVector(1e20, 1e20)
+			v = MakeVector(1e20, math.Nextafter(1e20, math.Inf(1)))
+		)
+
+		assert.False(t, u.IsParallelToExact(v))
+	})
+}
+
 func TestAngle(t *testing.T) {
 	t.Run("Angle of (1, 0) is 0", func(t *testing.T) {
 		angle := MakeVector(1, 0).AngleInRadsFromX()
@@ -0,0 +1,213 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// DecodePoint decodes a WKB Point.
+func DecodePoint(data []byte) (*g2d.Point, error) {
+	d, err := decodeGeometry(data, pointType)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := d.point()
+	if err != nil {
+		return nil, err
+	}
+
+	return p, d.end()
+}
+
+// DecodeLineString decodes a WKB LineString as a polyline.
+func DecodeLineString(data []byte) (*g2d.Polyline, error) {
+	points, err := decodeLineStringPoints(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return g2d.MakePolyline(points...)
+}
+
+// DecodeSegment decodes a WKB LineString with exactly two points as a segment.
+func DecodeSegment(data []byte) (*g2d.Segment, error) {
+	points, err := decodeLineStringPoints(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) != 2 {
+		return nil, fmt.Errorf("%w: a segment must have exactly two points, got %d", ErrUnexpectedType, len(points))
+	}
+
+	return g2d.MakeSegment(points[0], points[1]), nil
+}
+
+// DecodePolygon decodes a WKB Polygon, returning its shell and holes.
+func DecodePolygon(data []byte) (*g2d.Polygon, []*g2d.Polygon, error) {
+	d, err := decodeGeometry(data, polygonType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count, err := d.count(4)
+	if err != nil {
+		return nil, nil, err
+	}
+	if count == 0 {
+		return nil, nil, fmt.Errorf("%w: empty polygons aren't supported", ErrUnsupported)
+	}
+
+	rings := make([]*g2d.Polygon, count)
+	for i := range rings {
+		points, err := d.pointList()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rings[i], err = makeRing(points); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rings[0], rings[1:], d.end()
+}
+
+func decodeLineStringPoints(data []byte) ([]*g2d.Point, error) {
+	d, err := decodeGeometry(data, lineStringType)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := d.pointList()
+	if err != nil {
+		return nil, err
+	}
+
+	return points, d.end()
+}
+
+// makeRing creates the polygon for a closed ring of points.
+func makeRing(points []*g2d.Point) (*g2d.Polygon, error) {
+	if len(points) < 2 {
+		return nil, ErrOpenRing
+	}
+
+	first, last := points[0], points[len(points)-1]
+	if first.X() != last.X() || first.Y() != last.Y() {
+		return nil, ErrOpenRing
+	}
+
+	return g2d.MakePolygon(points[:len(points)-1]...)
+}
+
+// A decoder reads the values of a WKB geometry in its byte order.
+type decoder struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// decodeGeometry reads the byte order and the geometry type, checking that it's the expected
+// one, and returns a decoder positioned after them.
+func decodeGeometry(data []byte, wantType uint32) (*decoder, error) {
+	if len(data) < 5 {
+		return nil, fmt.Errorf("%w: the data is too short", ErrInvalid)
+	}
+
+	d := &decoder{data: data[1:]}
+	switch data[0] {
+	case littleEndian:
+		d.order = binary.LittleEndian
+	case bigEndian:
+		d.order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%w: unknown byte order %d", ErrInvalid, data[0])
+	}
+
+	gotType, _ := d.uint32()
+	switch gotType {
+	case pointType, lineStringType, polygonType:
+	default:
+		return nil, fmt.Errorf("%w: geometry type %d", ErrUnsupported, gotType)
+	}
+
+	if gotType != wantType {
+		return nil, fmt.Errorf("%w: want type %d, got %d", ErrUnexpectedType, wantType, gotType)
+	}
+
+	return d, nil
+}
+
+// count reads the number of elements that follow, each of which takes at least minSize bytes,
+// checking that there is enough data for them.
+func (d *decoder) count(minSize int) (int, error) {
+	count, err := d.uint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(count)*uint64(minSize) > uint64(len(d.data)) {
+		return 0, fmt.Errorf("%w: the data is too short for %d elements", ErrInvalid, count)
+	}
+
+	return int(count), nil
+}
+
+func (d *decoder) pointList() ([]*g2d.Point, error) {
+	count, err := d.count(pointSize)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]*g2d.Point, count)
+	for i := range points {
+		if points[i], err = d.point(); err != nil {
+			return nil, err
+		}
+	}
+
+	return points, nil
+}
+
+func (d *decoder) point() (*g2d.Point, error) {
+	if len(d.data) < pointSize {
+		return nil, fmt.Errorf("%w: the data is too short for a point", ErrInvalid)
+	}
+
+	var (
+		x = math.Float64frombits(d.order.Uint64(d.data))
+		y = math.Float64frombits(d.order.Uint64(d.data[8:]))
+	)
+
+	d.data = d.data[pointSize:]
+
+	// WKB represents empty points with NaN coordinates.
+	if math.IsNaN(x) && math.IsNaN(y) {
+		return nil, fmt.Errorf("%w: empty points aren't supported", ErrUnsupported)
+	}
+
+	return g2d.MakePoint(x, y), floatenc.CheckFinite(x, y)
+}
+
+func (d *decoder) uint32() (uint32, error) {
+	if len(d.data) < 4 {
+		return 0, fmt.Errorf("%w: the data is too short", ErrInvalid)
+	}
+
+	value := d.order.Uint32(d.data)
+	d.data = d.data[4:]
+
+	return value, nil
+}
+
+// end checks that all the data has been read.
+func (d *decoder) end() error {
+	if len(d.data) > 0 {
+		return fmt.Errorf("%w: %d unexpected trailing bytes", ErrInvalid, len(d.data))
+	}
+
+	return nil
+}
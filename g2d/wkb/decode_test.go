@@ -0,0 +1,126 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func mustDecodeHex(t *testing.T, text string) []byte {
+	t.Helper()
+
+	data, err := hex.DecodeString(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestDecodePoint(t *testing.T) {
+	t.Run("little endian", func(t *testing.T) {
+		p, err := DecodePoint(mustDecodeHex(t, "0101000000000000000000f03f0000000000000040"))
+		if err != nil || !p.Equals(g2d.MakePoint(1, 2)) {
+			t.Errorf("Want (1, 2), got %v (%v)", p, err)
+		}
+	})
+
+	t.Run("big endian", func(t *testing.T) {
+		p, err := DecodePoint(mustDecodeHex(t, "00000000013ff00000000000004000000000000000"))
+		if err != nil || !p.Equals(g2d.MakePoint(1, 2)) {
+			t.Errorf("Want (1, 2), got %v (%v)", p, err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		hex     string
+		wantErr error
+	}{
+		{"truncated", "0101000000000000000000f03f", ErrInvalid},
+		{"trailing bytes", "0101000000000000000000f03f000000000000004000", ErrInvalid},
+		{"unknown byte order", "0501000000000000000000f03f0000000000000040", ErrInvalid},
+		{"three dimensions", "01e9030000000000000000f03f00000000000000400000000000000840", ErrUnsupported},
+		{"other type", "010200000000000000", ErrUnexpectedType},
+		{"empty point", "0101000000000000000000f87f000000000000f87f", ErrUnsupported},
+		{"infinite coordinate", "0101000000000000000000f07f0000000000000040", nums.ErrNotFinite},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodePoint(mustDecodeHex(t, tt.hex)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDecodeLineString(t *testing.T) {
+	polyline, _ := g2d.MakePolyline(g2d.MakePoint(0, 0), g2d.MakePoint(3, 4), g2d.MakePoint(3, 10))
+
+	t.Run("round trip", func(t *testing.T) {
+		got, err := DecodeLineString(EncodePolyline(polyline))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nums.FloatsEqual(got.Length(), 11) {
+			t.Errorf("Want length 11, got %f", got.Length())
+		}
+	})
+
+	t.Run("segment", func(t *testing.T) {
+		segment, err := DecodeSegment(EncodeSegment(g2d.MakeSegmentFromCoords(1, 2, 3, 4)))
+		if err != nil || !segment.Start().Equals(g2d.MakePoint(1, 2)) {
+			t.Errorf("Want start (1, 2), got %v", err)
+		}
+
+		if _, err := DecodeSegment(EncodePolyline(polyline)); !errors.Is(err, ErrUnexpectedType) {
+			t.Errorf("Want ErrUnexpectedType, got %v", err)
+		}
+	})
+
+	t.Run("point count larger than the data", func(t *testing.T) {
+		if _, err := DecodeLineString(mustDecodeHex(t, "0102000000ffffffff")); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Want ErrInvalid, got %v", err)
+		}
+	})
+}
+
+func TestDecodePolygon(t *testing.T) {
+	var (
+		shell, _ = g2d.MakePolygon(g2d.MakePoint(0, 0), g2d.MakePoint(10, 0), g2d.MakePoint(10, 10), g2d.MakePoint(0, 10))
+		hole, _  = g2d.MakePolygon(g2d.MakePoint(2, 2), g2d.MakePoint(2, 4), g2d.MakePoint(4, 4))
+	)
+
+	t.Run("round trip", func(t *testing.T) {
+		gotShell, gotHoles, err := DecodePolygon(EncodePolygon(shell, hole))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !nums.FloatsEqual(gotShell.Area(), 100) {
+			t.Errorf("Want area 100, got %f", gotShell.Area())
+		}
+		if len(gotHoles) != 1 || !nums.FloatsEqual(gotHoles[0].Area(), 2) {
+			t.Errorf("Want a hole with area 2, got %v", gotHoles)
+		}
+	})
+
+	t.Run("open ring", func(t *testing.T) {
+		data := EncodePolygon(shell)
+		// Moves the last point, which closes the ring.
+		data[len(data)-1] = 0x40
+
+		if _, _, err := DecodePolygon(data); !errors.Is(err, ErrOpenRing) {
+			t.Errorf("Want ErrOpenRing, got %v", err)
+		}
+	})
+
+	t.Run("empty polygon", func(t *testing.T) {
+		if _, _, err := DecodePolygon(mustDecodeHex(t, "010300000000000000")); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("Want ErrUnsupported, got %v", err)
+		}
+	})
+}
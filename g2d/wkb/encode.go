@@ -0,0 +1,71 @@
+package wkb
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+// EncodePoint returns the point as a WKB Point.
+func EncodePoint(p *g2d.Point) []byte {
+	data := appendHeader(make([]byte, 0, 5+pointSize), pointType)
+	return appendPoint(data, p)
+}
+
+// EncodeSegment returns the segment as a WKB LineString with two points.
+func EncodeSegment(s *g2d.Segment) []byte {
+	return encodeLineString([]*g2d.Point{s.Start(), s.End()})
+}
+
+// EncodePolyline returns the polyline as a WKB LineString.
+func EncodePolyline(p *g2d.Polyline) []byte {
+	return encodeLineString(p.Points())
+}
+
+// EncodePolygon returns the polygon as a WKB Polygon, with the given holes as interior rings.
+func EncodePolygon(shell *g2d.Polygon, holes ...*g2d.Polygon) []byte {
+	rings := append([]*g2d.Polygon{shell}, holes...)
+
+	data := appendHeader(nil, polygonType)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(rings)))
+	for _, ring := range rings {
+		data = appendPointList(data, closedRing(ring))
+	}
+
+	return data
+}
+
+func encodeLineString(points []*g2d.Point) []byte {
+	data := appendHeader(make([]byte, 0, 9+pointSize*len(points)), lineStringType)
+	return appendPointList(data, points)
+}
+
+func appendHeader(data []byte, geometryType uint32) []byte {
+	data = append(data, littleEndian)
+	return binary.LittleEndian.AppendUint32(data, geometryType)
+}
+
+func appendPointList(data []byte, points []*g2d.Point) []byte {
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(points)))
+	for _, p := range points {
+		data = appendPoint(data, p)
+	}
+
+	return data
+}
+
+func appendPoint(data []byte, p *g2d.Point) []byte {
+	data = binary.LittleEndian.AppendUint64(data, math.Float64bits(p.X()))
+	return binary.LittleEndian.AppendUint64(data, math.Float64bits(p.Y()))
+}
+
+// closedRing returns a new slice with the polygon's vertices followed by the first one. The
+// polygon's own slice of vertices is never appended to, as its backing array may be shared.
+func closedRing(polygon *g2d.Polygon) []*g2d.Point {
+	vertices := polygon.Vertices()
+
+	ring := make([]*g2d.Point, 0, len(vertices)+1)
+	ring = append(ring, vertices...)
+	return append(ring, vertices[0])
+}
@@ -0,0 +1,63 @@
+package wkb
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestEncode(t *testing.T) {
+	t.Run("point", func(t *testing.T) {
+		var (
+			got  = hex.EncodeToString(EncodePoint(g2d.MakePoint(1, 2)))
+			want = "0101000000" + "000000000000f03f" + "0000000000000040"
+		)
+
+		if got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("segment", func(t *testing.T) {
+		var (
+			got  = hex.EncodeToString(EncodeSegment(g2d.MakeSegmentFromCoords(0, 0, 1, 1)))
+			want = "0102000000" + "02000000" +
+				"0000000000000000" + "0000000000000000" +
+				"000000000000f03f" + "000000000000f03f"
+		)
+
+		if got != want {
+			t.Errorf("Want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("polygon rings are closed", func(t *testing.T) {
+		var (
+			shell, _ = g2d.MakePolygon(g2d.MakePoint(0, 0), g2d.MakePoint(1, 0), g2d.MakePoint(0, 1))
+			data     = EncodePolygon(shell)
+		)
+
+		// Header, ring count, point count and four points.
+		if want := 5 + 4 + 4 + 4*pointSize; len(data) != want {
+			t.Fatalf("Want %d bytes, got %d", want, len(data))
+		}
+		if first, last := data[13:29], data[len(data)-pointSize:]; hex.EncodeToString(first) != hex.EncodeToString(last) {
+			t.Error("Expected the ring to be closed")
+		}
+	})
+
+	t.Run("doesn't modify the caller's points", func(t *testing.T) {
+		var (
+			points   = []*g2d.Point{g2d.MakePoint(0, 0), g2d.MakePoint(1, 0), g2d.MakePoint(0, 1), g2d.MakePoint(5, 5)}
+			shell, _ = g2d.MakePolygon(points[:3]...)
+			fourth   = points[3]
+		)
+
+		EncodePolygon(shell)
+
+		if points[3] != fourth {
+			t.Errorf("Want the fourth point untouched, got %v", points[3])
+		}
+	})
+}
@@ -0,0 +1,41 @@
+// Package wkb reads and writes two-dimensional geometry in the Well-Known Binary format, defined
+// by the OGC Simple Features specification.
+//
+// Points are written as Point, segments and polylines as LineString and polygons as Polygon,
+// whose first ring is the shell and the rest are holes. Rings are closed in the binary data,
+// repeating the first vertex at the end, but the g2d polygons don't repeat it.
+//
+// Geometry is written in little-endian byte order, and read in either byte order. Only
+// two-dimensional geometry is supported: the Z and M variants and the extended WKB with an SRID
+// are rejected when decoding.
+package wkb
+
+import "errors"
+
+var (
+	// ErrInvalid is returned when the data isn't valid Well-Known Binary, for example, because
+	// it's truncated.
+	ErrInvalid = errors.New("invalid WKB data")
+	// ErrUnexpectedType is returned when the data holds a different type of geometry than the
+	// expected one.
+	ErrUnexpectedType = errors.New("unexpected WKB geometry type")
+	// ErrUnsupported is returned for valid Well-Known Binary which this package doesn't support.
+	ErrUnsupported = errors.New("unsupported WKB geometry")
+	// ErrOpenRing is returned when the first and last points of a polygon's ring are different.
+	ErrOpenRing = errors.New("the polygon rings must be closed")
+)
+
+const (
+	bigEndian    byte = 0
+	littleEndian byte = 1
+)
+
+// The WKB geometry type codes.
+const (
+	pointType      uint32 = 1
+	lineStringType uint32 = 2
+	polygonType    uint32 = 3
+)
+
+// Bytes used by the coordinates of a point.
+const pointSize = 16
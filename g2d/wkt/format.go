@@ -0,0 +1,78 @@
+package wkt
+
+import (
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// FormatPoint returns the point as a WKT POINT.
+func FormatPoint(p *g2d.Point) string {
+	var b strings.Builder
+	b.WriteString(pointType + " (")
+	writeCoords(&b, p)
+	b.WriteByte(')')
+
+	return b.String()
+}
+
+// FormatSegment returns the segment as a WKT LINESTRING with two points.
+func FormatSegment(s *g2d.Segment) string {
+	return formatLineString(s.Start(), s.End())
+}
+
+// FormatPolyline returns the polyline as a WKT LINESTRING.
+func FormatPolyline(p *g2d.Polyline) string {
+	return formatLineString(p.Points()...)
+}
+
+// FormatPolygon returns the polygon as a WKT POLYGON, with the given holes as interior rings.
+func FormatPolygon(shell *g2d.Polygon, holes ...*g2d.Polygon) string {
+	var b strings.Builder
+	b.WriteString(polygonType + " (")
+
+	for i, ring := range append([]*g2d.Polygon{shell}, holes...) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+
+		writePointList(&b, closedRing(ring))
+	}
+
+	b.WriteByte(')')
+	return b.String()
+}
+
+func formatLineString(points ...*g2d.Point) string {
+	var b strings.Builder
+	b.WriteString(lineStringType + " ")
+	writePointList(&b, points)
+
+	return b.String()
+}
+
+func writePointList(b *strings.Builder, points []*g2d.Point) {
+	b.WriteByte('(')
+	for i, p := range points {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeCoords(b, p)
+	}
+	b.WriteByte(')')
+}
+
+func writeCoords(b *strings.Builder, p *g2d.Point) {
+	b.Write(floatenc.FormatText(p.X(), p.Y()))
+}
+
+// closedRing returns a new slice with the polygon's vertices followed by the first one. The
+// polygon's own slice of vertices is never appended to, as its backing array may be shared.
+func closedRing(polygon *g2d.Polygon) []*g2d.Point {
+	vertices := polygon.Vertices()
+
+	ring := make([]*g2d.Point, 0, len(vertices)+1)
+	ring = append(ring, vertices...)
+	return append(ring, vertices[0])
+}
@@ -0,0 +1,55 @@
+package wkt
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("point", func(t *testing.T) {
+		if got := FormatPoint(g2d.MakePoint(1.5, -2)); got != "POINT (1.5 -2)" {
+			t.Errorf("Unexpected WKT %q", got)
+		}
+	})
+
+	t.Run("segment", func(t *testing.T) {
+		got := FormatSegment(g2d.MakeSegmentFromCoords(0, 0, 10, 0.25))
+		if want := "LINESTRING (0 0, 10 0.25)"; got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("polyline", func(t *testing.T) {
+		polyline, _ := g2d.MakePolyline(g2d.MakePoint(0, 0), g2d.MakePoint(1, 1), g2d.MakePoint(2, 0))
+		if want, got := "LINESTRING (0 0, 1 1, 2 0)", FormatPolyline(polyline); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("polygon with a hole", func(t *testing.T) {
+		var (
+			shell, _ = g2d.MakePolygon(g2d.MakePoint(0, 0), g2d.MakePoint(10, 0), g2d.MakePoint(10, 10), g2d.MakePoint(0, 10))
+			hole, _  = g2d.MakePolygon(g2d.MakePoint(2, 2), g2d.MakePoint(2, 4), g2d.MakePoint(4, 4))
+			want     = "POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (2 2, 2 4, 4 4, 2 2))"
+		)
+
+		if got := FormatPolygon(shell, hole); got != want {
+			t.Errorf("Want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("doesn't modify the caller's points", func(t *testing.T) {
+		var (
+			points   = []*g2d.Point{g2d.MakePoint(0, 0), g2d.MakePoint(1, 0), g2d.MakePoint(0, 1), g2d.MakePoint(5, 5)}
+			shell, _ = g2d.MakePolygon(points[:3]...)
+			fourth   = points[3]
+		)
+
+		FormatPolygon(shell)
+
+		if points[3] != fourth {
+			t.Errorf("Want the fourth point untouched, got %v", points[3])
+		}
+	})
+}
@@ -0,0 +1,253 @@
+package wkt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// ParsePoint parses a WKT POINT.
+func ParsePoint(text string) (*g2d.Point, error) {
+	p, err := parseGeometry(text, pointType)
+	if err != nil {
+		return nil, err
+	}
+
+	coords, err := p.pointList()
+	if err != nil {
+		return nil, err
+	}
+	if len(coords) != 1 {
+		return nil, fmt.Errorf("%w: a point must have exactly one coordinate pair", ErrSyntax)
+	}
+
+	return coords[0], p.end()
+}
+
+// ParseLineString parses a WKT LINESTRING as a polyline.
+func ParseLineString(text string) (*g2d.Polyline, error) {
+	points, err := parseLineStringPoints(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return g2d.MakePolyline(points...)
+}
+
+// ParseSegment parses a WKT LINESTRING with exactly two points as a segment.
+func ParseSegment(text string) (*g2d.Segment, error) {
+	points, err := parseLineStringPoints(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) != 2 {
+		return nil, fmt.Errorf("%w: a segment must have exactly two points, got %d", ErrUnexpectedType, len(points))
+	}
+
+	return g2d.MakeSegment(points[0], points[1]), nil
+}
+
+// ParsePolygon parses a WKT POLYGON, returning its shell and holes.
+func ParsePolygon(text string) (*g2d.Polygon, []*g2d.Polygon, error) {
+	p, err := parseGeometry(text, polygonType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := p.expect('('); err != nil {
+		return nil, nil, err
+	}
+
+	var rings []*g2d.Polygon
+	for {
+		points, err := p.pointList()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ring, err := makeRing(points)
+		if err != nil {
+			return nil, nil, err
+		}
+		rings = append(rings, ring)
+
+		if !p.accept(',') {
+			break
+		}
+	}
+
+	if err := p.expect(')'); err != nil {
+		return nil, nil, err
+	}
+
+	return rings[0], rings[1:], p.end()
+}
+
+func parseLineStringPoints(text string) ([]*g2d.Point, error) {
+	p, err := parseGeometry(text, lineStringType)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := p.pointList()
+	if err != nil {
+		return nil, err
+	}
+
+	return points, p.end()
+}
+
+// makeRing creates the polygon for a closed ring of points.
+func makeRing(points []*g2d.Point) (*g2d.Polygon, error) {
+	if len(points) < 2 {
+		return nil, ErrOpenRing
+	}
+
+	first, last := points[0], points[len(points)-1]
+	if first.X() != last.X() || first.Y() != last.Y() {
+		return nil, ErrOpenRing
+	}
+
+	return g2d.MakePolygon(points[:len(points)-1]...)
+}
+
+// The characters a number can start with.
+const numberChars = "+-.0123456789"
+
+// A parser reads the tokens of a WKT geometry.
+type parser struct {
+	text string
+	pos  int
+}
+
+// parseGeometry checks that the text starts with the expected geometry type, and returns a
+// parser positioned after it.
+func parseGeometry(text, wantType string) (*parser, error) {
+	p := &parser{text: text}
+
+	gotType := strings.ToUpper(p.word())
+	switch gotType {
+	case pointType, lineStringType, polygonType:
+	case "":
+		return nil, fmt.Errorf("%w: missing geometry type", ErrSyntax)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, gotType)
+	}
+
+	if gotType != wantType {
+		return nil, fmt.Errorf("%w: want %s, got %s", ErrUnexpectedType, wantType, gotType)
+	}
+
+	if modifier := strings.ToUpper(p.word()); modifier != "" {
+		return nil, fmt.Errorf("%w: %s %s", ErrUnsupported, gotType, modifier)
+	}
+
+	return p, nil
+}
+
+// pointList reads a parenthesized list of coordinate pairs separated by commas.
+func (p *parser) pointList() ([]*g2d.Point, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	var points []*g2d.Point
+	for {
+		x, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+
+		if next := p.peek(); next != 0 && strings.IndexByte(numberChars, next) >= 0 {
+			return nil, fmt.Errorf("%w: only two coordinates per point are supported", ErrUnsupported)
+		}
+
+		points = append(points, g2d.MakePoint(x, y))
+
+		if !p.accept(',') {
+			break
+		}
+	}
+
+	return points, p.expect(')')
+}
+
+// word reads a sequence of letters, returning an empty string if there is none.
+func (p *parser) word() string {
+	p.skipSpaces()
+
+	start := p.pos
+	for p.pos < len(p.text) && unicode.IsLetter(rune(p.text[p.pos])) {
+		p.pos++
+	}
+
+	return p.text[start:p.pos]
+}
+
+func (p *parser) number() (float64, error) {
+	p.skipSpaces()
+
+	start := p.pos
+	for p.pos < len(p.text) && strings.IndexByte(numberChars+"eE", p.text[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	value, err := strconv.ParseFloat(p.text[start:p.pos], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid number at position %d", ErrSyntax, start)
+	}
+
+	return value, floatenc.CheckFinite(value)
+}
+
+// peek returns the next non-space character, or zero at the end of the text.
+func (p *parser) peek() byte {
+	p.skipSpaces()
+	if p.pos == len(p.text) {
+		return 0
+	}
+
+	return p.text[p.pos]
+}
+
+// accept consumes the next character if it's the given one, and reports whether it was.
+func (p *parser) accept(char byte) bool {
+	if p.peek() != char {
+		return false
+	}
+
+	p.pos++
+	return true
+}
+
+func (p *parser) expect(char byte) error {
+	if !p.accept(char) {
+		return fmt.Errorf("%w: expected '%c' at position %d", ErrSyntax, char, p.pos)
+	}
+
+	return nil
+}
+
+// end checks that there is nothing but spaces left in the text.
+func (p *parser) end() error {
+	if p.peek() != 0 {
+		return fmt.Errorf("%w: unexpected text at position %d", ErrSyntax, p.pos)
+	}
+
+	return nil
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.text) && unicode.IsSpace(rune(p.text[p.pos])) {
+		p.pos++
+	}
+}
@@ -0,0 +1,141 @@
+package wkt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestParsePoint(t *testing.T) {
+	t.Run("valid points", func(t *testing.T) {
+		for _, text := range []string{"POINT (1.5 -2)", "point(1.5 -2)", "  Point ( 1.5   -2 )  ", "POINT (15e-1 -2.0)"} {
+			p, err := ParsePoint(text)
+			if err != nil {
+				t.Fatalf("%q: %v", text, err)
+			}
+			if !p.Equals(g2d.MakePoint(1.5, -2)) {
+				t.Errorf("%q: want (1.5, -2), got %v", text, p)
+			}
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		original := g2d.MakePoint(0.1, 1e-20)
+		if p, err := ParsePoint(FormatPoint(original)); err != nil || p.X() != 0.1 || p.Y() != 1e-20 {
+			t.Errorf("Want %v, got %v (%v)", original, p, err)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		text    string
+		wantErr error
+	}{
+		{"empty point", "POINT EMPTY", ErrUnsupported},
+		{"three dimensions", "POINT Z (1 2 3)", ErrUnsupported},
+		{"three coordinates", "POINT (1 2 3)", ErrUnsupported},
+		{"other type", "LINESTRING (0 0, 1 1)", ErrUnexpectedType},
+		{"unknown type", "CIRCLE (0 0)", ErrUnsupported},
+		{"missing parenthesis", "POINT (1 2", ErrSyntax},
+		{"trailing text", "POINT (1 2) foo", ErrSyntax},
+		{"invalid number", "POINT (1 x)", ErrSyntax},
+		{"two points", "POINT (1 2, 3 4)", ErrSyntax},
+		{"not finite", "POINT (1 Inf)", ErrSyntax},
+		{"no text", "", ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePoint(tt.text); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("overflowing number", func(t *testing.T) {
+		if _, err := ParsePoint("POINT (1 1e400)"); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestParseLineString(t *testing.T) {
+	t.Run("polyline", func(t *testing.T) {
+		polyline, err := ParseLineString("LINESTRING (0 0, 3 4, 3 10)")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := polyline.Length(); !nums.FloatsEqual(got, 11) {
+			t.Errorf("Want length 11, got %f", got)
+		}
+	})
+
+	t.Run("segment", func(t *testing.T) {
+		segment, err := ParseSegment("LINESTRING (0 0, 3 4)")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !segment.End().Equals(g2d.MakePoint(3, 4)) {
+			t.Errorf("Want end (3, 4), got %v", segment.End())
+		}
+	})
+
+	t.Run("segment with more points", func(t *testing.T) {
+		if _, err := ParseSegment("LINESTRING (0 0, 3 4, 5 5)"); !errors.Is(err, ErrUnexpectedType) {
+			t.Errorf("Want ErrUnexpectedType, got %v", err)
+		}
+	})
+
+	t.Run("single point", func(t *testing.T) {
+		if _, err := ParseLineString("LINESTRING (0 0)"); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestParsePolygon(t *testing.T) {
+	t.Run("shell and hole", func(t *testing.T) {
+		shell, holes, err := ParsePolygon("POLYGON ((0 0, 10 0, 10 10, 0 10, 0 0), (2 2, 2 4, 4 4, 2 2))")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := shell.Area(); !nums.FloatsEqual(got, 100) {
+			t.Errorf("Want shell area 100, got %f", got)
+		}
+		if len(holes) != 1 || len(holes[0].Vertices()) != 3 {
+			t.Errorf("Want a hole with three vertices, got %v", holes)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		text := "POLYGON ((0 0, 4 0, 0 3, 0 0))"
+
+		shell, holes, err := ParsePolygon(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := FormatPolygon(shell, holes...); got != text {
+			t.Errorf("Want %q, got %q", text, got)
+		}
+	})
+
+	t.Run("open ring", func(t *testing.T) {
+		if _, _, err := ParsePolygon("POLYGON ((0 0, 4 0, 0 3))"); !errors.Is(err, ErrOpenRing) {
+			t.Errorf("Want ErrOpenRing, got %v", err)
+		}
+	})
+
+	t.Run("degenerate ring", func(t *testing.T) {
+		if _, _, err := ParsePolygon("POLYGON ((0 0, 4 0, 0 0))"); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("missing outer parenthesis", func(t *testing.T) {
+		if _, _, err := ParsePolygon("POLYGON (0 0, 4 0, 0 3, 0 0)"); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Want ErrSyntax, got %v", err)
+		}
+	})
+}
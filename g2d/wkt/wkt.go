@@ -0,0 +1,31 @@
+// Package wkt reads and writes two-dimensional geometry in the Well-Known Text format, defined
+// by the OGC Simple Features specification.
+//
+// Points are written as POINT, segments and polylines as LINESTRING and polygons as POLYGON,
+// whose first ring is the shell and the rest are holes. Rings are closed in the text, repeating
+// the first vertex at the end, but the g2d polygons don't repeat it.
+//
+// Only two-dimensional geometry is supported: the Z and M variants, as well as EMPTY geometry,
+// are rejected when parsing.
+package wkt
+
+import "errors"
+
+var (
+	// ErrSyntax is returned when the text isn't valid Well-Known Text.
+	ErrSyntax = errors.New("invalid WKT syntax")
+	// ErrUnexpectedType is returned when the text holds a different type of geometry than the
+	// expected one.
+	ErrUnexpectedType = errors.New("unexpected WKT geometry type")
+	// ErrUnsupported is returned for valid Well-Known Text which this package doesn't support:
+	// EMPTY geometry and coordinates with more than two dimensions.
+	ErrUnsupported = errors.New("unsupported WKT geometry")
+	// ErrOpenRing is returned when the first and last points of a polygon's ring are different.
+	ErrOpenRing = errors.New("the polygon rings must be closed")
+)
+
+const (
+	pointType      = "POINT"
+	lineStringType = "LINESTRING"
+	polygonType    = "POLYGON"
+)
@@ -0,0 +1,22 @@
+package g3d
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// IntegrateTetrahedron approximates the integral of the function over the tetrahedron with
+// vertices a, b, c and d, using the given tetrahedron rule (see nums.MakeTetrahedronRule).
+func IntegrateTetrahedron(a, b, c, d *Point, f func(p *Point) float64, rule *nums.ElementRule) float64 {
+	var (
+		ab       = a.VectorTo(b)
+		ac       = a.VectorTo(c)
+		ad       = a.VectorTo(d)
+		jacobian = math.Abs(ab.CrossTimes(ac).DotTimes(ad))
+	)
+
+	return jacobian * rule.Integrate(func(coords []float64) float64 {
+		return f(a.Displaced(ab, coords[0]).Displaced(ac, coords[1]).Displaced(ad, coords[2]))
+	})
+}
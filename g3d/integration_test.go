@@ -0,0 +1,30 @@
+package g3d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestIntegrateTetrahedron(t *testing.T) {
+	var (
+		a, b    = MakePoint(0, 0, 0), MakePoint(2, 0, 0)
+		c, d    = MakePoint(0, 3, 0), MakePoint(0, 0, 4)
+		rule, _ = nums.MakeTetrahedronRule(2)
+	)
+
+	t.Run("the integral of one is the volume", func(t *testing.T) {
+		got := IntegrateTetrahedron(a, b, c, d, func(*Point) float64 { return 1 }, rule)
+		if !nums.FloatsEqual(got, 4) {
+			t.Errorf("Want 4, got %f", got)
+		}
+	})
+
+	t.Run("integrates a quadratic function", func(t *testing.T) {
+		// The integral of z² is 6V · 4² · 2! / 5! = 24 · 16 / 60.
+		got := IntegrateTetrahedron(a, b, c, d, func(p *Point) float64 { return p.z * p.z }, rule)
+		if want := 6.4; !nums.FloatsEqual(got, want) {
+			t.Errorf("Want %f, got %f", want, got)
+		}
+	})
+}
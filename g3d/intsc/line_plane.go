@@ -17,9 +17,16 @@ type LinePlane struct {
 // If the line and the plane are parallel, no intersection is recorded. In any other case, the
 // intersection yields the intersection point.
 func ComputeLinePlane(line *g3d.Line, plane *g3d.Plane) *LinePlane {
+	return ComputeLinePlaneTol(line, plane, nums.DefaultTolerance)
+}
+
+// ComputeLinePlaneTol computes the intersection between a line and a plane, which are taken as
+// parallel when the dot product of the line's direction and the plane's normal is zero within
+// the given tolerance.
+func ComputeLinePlaneTol(line *g3d.Line, plane *g3d.Plane, tol nums.Tolerance) *LinePlane {
 	dirDotNorm := plane.NormalVector().DotTimes(line.Direction())
 
-	if nums.IsCloseToZero(dirDotNorm) {
+	if tol.IsZero(dirDotNorm) {
 		return &LinePlane{false, nil}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
 )
 
 func TestLinePlaneIntersection(t *testing.T) {
@@ -38,4 +39,18 @@ func TestLinePlaneIntersection(t *testing.T) {
 			t.Errorf("Want intersection point %v, got %v", want, intersection.Point)
 		}
 	})
+
+	t.Run("parallelism is decided with the given tolerance", func(t *testing.T) {
+		var (
+			plane, _ = g3d.MakePlaneFromPointAndNormal(g3d.MakePoint(1, 1, 1), g3d.KVersor)
+			line, _  = g3d.MakeLine(g3d.Origin, g3d.MakeVector(1, 0, 1e-4))
+		)
+
+		if !ComputeLinePlane(line, plane).HasIntersection {
+			t.Error("Expected intersection")
+		}
+		if ComputeLinePlaneTol(line, plane, nums.MakeAbsoluteTolerance(1e-3)).HasIntersection {
+			t.Error("Expected no intersection within the tolerance")
+		}
+	})
 }
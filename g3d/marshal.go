@@ -0,0 +1,350 @@
+package g3d
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// The primitives are encoded as:
+//
+//   - JSON: objects with the fields "x", "y" and "z" for points and vectors, "point" and
+//     "normal" for planes, and "origin" and "direction" for lines.
+//   - Text: their numbers separated by spaces. "x y z" for points and vectors, the coordinates
+//     of the point followed by the projections of the normal vector for planes, and the
+//     coordinates of the origin followed by the projections of the direction for lines.
+//   - Binary: the same numbers as in the text encoding, as little-endian IEEE 754 values.
+//
+// Decoding validates the values: coordinates must be finite, and the normal of a plane and
+// the direction of a line can't be zero vectors.
+
+// ErrMissingFields is returned when decoding a JSON object without some of the required fields.
+var ErrMissingFields = errors.New("the JSON object is missing required fields")
+
+var (
+	_ json.Marshaler             = (*Point)(nil)
+	_ json.Unmarshaler           = (*Point)(nil)
+	_ encoding.TextMarshaler     = (*Point)(nil)
+	_ encoding.TextUnmarshaler   = (*Point)(nil)
+	_ encoding.BinaryMarshaler   = (*Point)(nil)
+	_ encoding.BinaryUnmarshaler = (*Point)(nil)
+
+	_ json.Marshaler             = (*Vector)(nil)
+	_ json.Unmarshaler           = (*Vector)(nil)
+	_ encoding.TextMarshaler     = (*Vector)(nil)
+	_ encoding.TextUnmarshaler   = (*Vector)(nil)
+	_ encoding.BinaryMarshaler   = (*Vector)(nil)
+	_ encoding.BinaryUnmarshaler = (*Vector)(nil)
+
+	_ json.Marshaler             = (*Plane)(nil)
+	_ json.Unmarshaler           = (*Plane)(nil)
+	_ encoding.TextMarshaler     = (*Plane)(nil)
+	_ encoding.TextUnmarshaler   = (*Plane)(nil)
+	_ encoding.BinaryMarshaler   = (*Plane)(nil)
+	_ encoding.BinaryUnmarshaler = (*Plane)(nil)
+
+	_ json.Marshaler             = (*Line)(nil)
+	_ json.Unmarshaler           = (*Line)(nil)
+	_ encoding.TextMarshaler     = (*Line)(nil)
+	_ encoding.TextUnmarshaler   = (*Line)(nil)
+	_ encoding.BinaryMarshaler   = (*Line)(nil)
+	_ encoding.BinaryUnmarshaler = (*Line)(nil)
+)
+
+type xyzJSON struct {
+	X *float64 `json:"x"`
+	Y *float64 `json:"y"`
+	Z *float64 `json:"z"`
+}
+
+func decodeXYZJSON(data []byte) (float64, float64, float64, error) {
+	var xyz xyzJSON
+	if err := json.Unmarshal(data, &xyz); err != nil {
+		return 0, 0, 0, err
+	}
+	if xyz.X == nil || xyz.Y == nil || xyz.Z == nil {
+		return 0, 0, 0, ErrMissingFields
+	}
+
+	return *xyz.X, *xyz.Y, *xyz.Z, nil
+}
+
+/* <-- Point --> */
+
+// MarshalJSON encodes the point as a JSON object with its x, y and z coordinates.
+func (p Point) MarshalJSON() ([]byte, error) {
+	return json.Marshal(xyzJSON{&p.x, &p.y, &p.z})
+}
+
+// UnmarshalJSON decodes the point from a JSON object with its x, y and z coordinates.
+// Returns an ErrMissingFields error if any of the coordinates is missing.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	x, y, z, err := decodeXYZJSON(data)
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y, p.z = x, y, z
+	return nil
+}
+
+// MarshalText encodes the point as its coordinates separated by spaces.
+func (p Point) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(p.x, p.y, p.z), nil
+}
+
+// UnmarshalText decodes the point from its coordinates separated by spaces.
+func (p *Point) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 3)
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y, p.z = values[0], values[1], values[2]
+	return nil
+}
+
+// MarshalBinary encodes the point as its three coordinates.
+func (p Point) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(p.x, p.y, p.z), nil
+}
+
+// UnmarshalBinary decodes the point from its three coordinates.
+func (p *Point) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 3)
+	if err != nil {
+		return err
+	}
+
+	p.x, p.y, p.z = values[0], values[1], values[2]
+	return nil
+}
+
+/* <-- Vector --> */
+
+// MarshalJSON encodes the vector as a JSON object with its x, y and z projections.
+func (v Vector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(xyzJSON{&v.x, &v.y, &v.z})
+}
+
+// UnmarshalJSON decodes the vector from a JSON object with its x, y and z projections.
+// Returns an ErrMissingFields error if any of the projections is missing.
+func (v *Vector) UnmarshalJSON(data []byte) error {
+	x, y, z, err := decodeXYZJSON(data)
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y, v.z = x, y, z
+	return nil
+}
+
+// MarshalText encodes the vector as its projections separated by spaces.
+func (v Vector) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(v.x, v.y, v.z), nil
+}
+
+// UnmarshalText decodes the vector from its projections separated by spaces.
+func (v *Vector) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 3)
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y, v.z = values[0], values[1], values[2]
+	return nil
+}
+
+// MarshalBinary encodes the vector as its three projections.
+func (v Vector) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(v.x, v.y, v.z), nil
+}
+
+// UnmarshalBinary decodes the vector from its three projections.
+func (v *Vector) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 3)
+	if err != nil {
+		return err
+	}
+
+	v.x, v.y, v.z = values[0], values[1], values[2]
+	return nil
+}
+
+/* <-- Plane --> */
+
+type planeJSON struct {
+	Point  *Point  `json:"point"`
+	Normal *Vector `json:"normal"`
+}
+
+// MarshalJSON encodes the plane as a JSON object with its base point and normal vector.
+func (p Plane) MarshalJSON() ([]byte, error) {
+	return json.Marshal(planeJSON{p.point, p.normalVector})
+}
+
+// UnmarshalJSON decodes the plane from a JSON object with its base point and normal vector.
+// Returns an ErrMissingFields error if any of them is missing, and an ErrZeroVector error if
+// the normal vector has zero length.
+func (p *Plane) UnmarshalJSON(data []byte) error {
+	var plane planeJSON
+	if err := json.Unmarshal(data, &plane); err != nil {
+		return err
+	}
+	if plane.Point == nil || plane.Normal == nil {
+		return ErrMissingFields
+	}
+
+	return p.set(plane.Point, plane.Normal)
+}
+
+// MarshalText encodes the plane as the coordinates of its base point and the projections of
+// its normal vector, separated by spaces.
+func (p Plane) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(p.planeValues()...), nil
+}
+
+// UnmarshalText decodes the plane from the coordinates of its base point and the projections
+// of its normal vector, separated by spaces. Returns an ErrZeroVector error if the normal vector
+// has zero length.
+func (p *Plane) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 6)
+	if err != nil {
+		return err
+	}
+
+	return p.setValues(values)
+}
+
+// MarshalBinary encodes the plane as the coordinates of its base point and the projections of
+// its normal vector.
+func (p Plane) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(p.planeValues()...), nil
+}
+
+// UnmarshalBinary decodes the plane from the coordinates of its base point and the projections
+// of its normal vector. Returns an ErrZeroVector error if the normal vector has zero length.
+func (p *Plane) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 6)
+	if err != nil {
+		return err
+	}
+
+	return p.setValues(values)
+}
+
+func (p *Plane) planeValues() []float64 {
+	return []float64{
+		p.point.x, p.point.y, p.point.z,
+		p.normalVector.x, p.normalVector.y, p.normalVector.z,
+	}
+}
+
+func (p *Plane) setValues(values []float64) error {
+	return p.set(
+		MakePoint(values[0], values[1], values[2]),
+		MakeVector(values[3], values[4], values[5]),
+	)
+}
+
+func (p *Plane) set(point *Point, normal *Vector) error {
+	plane, err := MakePlaneFromPointAndNormal(point, normal)
+	if err != nil {
+		return err
+	}
+
+	*p = *plane
+	return nil
+}
+
+/* <-- Line --> */
+
+type lineJSON struct {
+	Origin    *Point  `json:"origin"`
+	Direction *Vector `json:"direction"`
+}
+
+// MarshalJSON encodes the line as a JSON object with its origin and direction.
+func (l Line) MarshalJSON() ([]byte, error) {
+	return json.Marshal(lineJSON{l.origin, l.direction})
+}
+
+// UnmarshalJSON decodes the line from a JSON object with its origin and direction.
+// Returns an ErrMissingFields error if any of them is missing, and an ErrZeroVector error if
+// the direction has zero length.
+func (l *Line) UnmarshalJSON(data []byte) error {
+	var line lineJSON
+	if err := json.Unmarshal(data, &line); err != nil {
+		return err
+	}
+	if line.Origin == nil || line.Direction == nil {
+		return ErrMissingFields
+	}
+
+	return l.set(line.Origin, line.Direction)
+}
+
+// MarshalText encodes the line as the coordinates of its origin and the projections of its
+// direction, separated by spaces.
+func (l Line) MarshalText() ([]byte, error) {
+	return floatenc.FormatText(l.lineValues()...), nil
+}
+
+// UnmarshalText decodes the line from the coordinates of its origin and the projections of its
+// direction, separated by spaces. Returns an ErrZeroVector error if the direction has zero
+// length.
+func (l *Line) UnmarshalText(text []byte) error {
+	values, err := floatenc.ParseText(text, 6)
+	if err != nil {
+		return err
+	}
+
+	return l.setValues(values)
+}
+
+// MarshalBinary encodes the line as the coordinates of its origin and the projections of its
+// direction.
+func (l Line) MarshalBinary() ([]byte, error) {
+	return floatenc.EncodeBinary(l.lineValues()...), nil
+}
+
+// UnmarshalBinary decodes the line from the coordinates of its origin and the projections of
+// its direction. Returns an ErrZeroVector error if the direction has zero length.
+func (l *Line) UnmarshalBinary(data []byte) error {
+	values, err := floatenc.DecodeBinary(data, 6)
+	if err != nil {
+		return err
+	}
+
+	return l.setValues(values)
+}
+
+func (l *Line) lineValues() []float64 {
+	return []float64{
+		l.origin.x, l.origin.y, l.origin.z,
+		l.direction.x, l.direction.y, l.direction.z,
+	}
+}
+
+func (l *Line) setValues(values []float64) error {
+	return l.set(
+		MakePoint(values[0], values[1], values[2]),
+		MakeVector(values[3], values[4], values[5]),
+	)
+}
+
+func (l *Line) set(origin *Point, direction *Vector) error {
+	if direction.IsZero() {
+		return ErrZeroVector
+	}
+
+	line, err := MakeLine(origin, direction)
+	if err != nil {
+		return err
+	}
+
+	*l = *line
+	return nil
+}
@@ -0,0 +1,163 @@
+package g3d
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPointMarshaling(t *testing.T) {
+	assert := assert.New(t)
+	p := MakePoint(1, -2.5, 0.1)
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(p)
+		assert.Nil(err)
+		assert.Equal(`{"x":1,"y":-2.5,"z":0.1}`, string(data))
+
+		var got Point
+		assert.Nil(json.Unmarshal(data, &got))
+		assert.True(got.Equals(p))
+	})
+
+	t.Run("JSON of a value", func(t *testing.T) {
+		data, err := json.Marshal(MakePointVal(1, -2.5, 0.1))
+		assert.Nil(err)
+		assert.Equal(`{"x":1,"y":-2.5,"z":0.1}`, string(data))
+
+		data, err = json.Marshal([]Vector{MakeVectorVal(0, 0, 1)})
+		assert.Nil(err)
+		assert.Equal(`[{"x":0,"y":0,"z":1}]`, string(data))
+	})
+
+	t.Run("JSON missing a coordinate", func(t *testing.T) {
+		var got Point
+		assert.Equal(ErrMissingFields, json.Unmarshal([]byte(`{"x":1,"y":2}`), &got))
+	})
+
+	t.Run("text and binary", func(t *testing.T) {
+		var (
+			text, _    = p.MarshalText()
+			binary, _  = p.MarshalBinary()
+			fromText   Point
+			fromBinary Point
+		)
+
+		assert.Equal("1 -2.5 0.1", string(text))
+		assert.Nil(fromText.UnmarshalText(text))
+		assert.True(fromText.Equals(p))
+		assert.Nil(fromBinary.UnmarshalBinary(binary))
+		assert.Equal(*p, fromBinary)
+	})
+
+	t.Run("non-finite coordinates", func(t *testing.T) {
+		var (
+			binary, _ = MakePoint(math.NaN(), 0, 0).MarshalBinary()
+			got       Point
+		)
+
+		assert.True(errors.Is(got.UnmarshalBinary(binary), nums.ErrNotFinite))
+	})
+}
+
+func TestVectorMarshaling(t *testing.T) {
+	assert := assert.New(t)
+	v := MakeVector(1, 2, 3)
+
+	var fromJSON Vector
+	assert.Nil(json.Unmarshal(mustMarshalJSON(t, v), &fromJSON))
+	assert.True(fromJSON.Equals(v))
+
+	var fromText Vector
+	assert.NotNil(fromText.UnmarshalText([]byte("1 2")))
+	assert.Nil(fromText.UnmarshalText([]byte("1 2 3")))
+	assert.True(fromText.Equals(v))
+}
+
+func TestPlaneMarshaling(t *testing.T) {
+	assert := assert.New(t)
+	plane, _ := MakePlaneFromPointAndNormal(MakePoint(1, 2, 3), MakeVector(0, 0, 2))
+
+	t.Run("JSON", func(t *testing.T) {
+		data := mustMarshalJSON(t, plane)
+		assert.Equal(`{"point":{"x":1,"y":2,"z":3},"normal":{"x":0,"y":0,"z":2}}`, string(data))
+
+		var got Plane
+		assert.Nil(json.Unmarshal(data, &got))
+		assert.True(got.NormalVersor().Equals(KVersor))
+		assert.True(got.ContainsPoint(MakePoint(10, -4, 3)))
+	})
+
+	t.Run("zero normal", func(t *testing.T) {
+		var got Plane
+		err := json.Unmarshal([]byte(`{"point":{"x":0,"y":0,"z":0},"normal":{"x":0,"y":0,"z":0}}`), &got)
+		assert.Equal(ErrZeroVector, err)
+		assert.Equal(ErrZeroVector, got.UnmarshalText([]byte("1 2 3 0 0 0")))
+	})
+
+	t.Run("missing normal", func(t *testing.T) {
+		var got Plane
+		assert.Equal(ErrMissingFields, json.Unmarshal([]byte(`{"point":{"x":0,"y":0,"z":0}}`), &got))
+	})
+
+	t.Run("text and binary", func(t *testing.T) {
+		var (
+			text, _    = plane.MarshalText()
+			binary, _  = plane.MarshalBinary()
+			fromText   Plane
+			fromBinary Plane
+		)
+
+		assert.Equal("1 2 3 0 0 2", string(text))
+		assert.Nil(fromText.UnmarshalText(text))
+		assert.True(fromText.Point().Equals(plane.Point()))
+		assert.Nil(fromBinary.UnmarshalBinary(binary))
+		assert.True(fromBinary.NormalVector().Equals(plane.NormalVector()))
+	})
+}
+
+func TestLineMarshaling(t *testing.T) {
+	assert := assert.New(t)
+	line, _ := MakeLine(MakePoint(1, 1, 1), MakeVector(0, 3, 0))
+
+	t.Run("JSON", func(t *testing.T) {
+		data := mustMarshalJSON(t, line)
+		assert.Equal(`{"origin":{"x":1,"y":1,"z":1},"direction":{"x":0,"y":1,"z":0}}`, string(data))
+
+		var got Line
+		assert.Nil(json.Unmarshal(data, &got))
+		assert.True(got.Origin().Equals(line.Origin()))
+		assert.True(got.Direction().Equals(JVersor))
+	})
+
+	t.Run("zero direction", func(t *testing.T) {
+		var got Line
+		assert.Equal(ErrZeroVector, got.UnmarshalText([]byte("0 0 0 0 0 0")))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		var (
+			binary, _ = line.MarshalBinary()
+			got       Line
+		)
+
+		assert.Nil(got.UnmarshalBinary(binary))
+		assert.True(got.PointAt(2).Equals(MakePoint(1, 3, 1)))
+		assert.NotNil(got.UnmarshalBinary(binary[:40]))
+	})
+}
+
+func mustMarshalJSON(t *testing.T, value any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
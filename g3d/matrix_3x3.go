@@ -30,6 +30,14 @@ func (m *Matrix3x3) TimesProj(proj Projectable) *Vector {
 	)
 }
 
+// TimesCoords multiplies the matrix by the column vector with the given projections, returning
+// the projections of the result. Unlike TimesProj, it doesn't allocate.
+func (m *Matrix3x3) TimesCoords(x, y, z float64) (float64, float64, float64) {
+	return m.a*x + m.d*y + m.g*z,
+		m.b*x + m.e*y + m.h*z,
+		m.c*x + m.f*y + m.i*z
+}
+
 func (m *Matrix3x3) Plus(other *Matrix3x3) *Matrix3x3 {
 	return Make3x3Matrix(
 		m.a+other.a, m.d+other.d, m.g+other.g,
@@ -46,14 +54,21 @@ func (m *Matrix3x3) Minus(other *Matrix3x3) *Matrix3x3 {
 	)
 }
 
+// Equals returns true if all the elements of this and the other matrix are equal.
 func (m *Matrix3x3) Equals(other *Matrix3x3) bool {
-	return nums.FloatsEqual(m.a, other.a) &&
-		nums.FloatsEqual(m.d, other.d) &&
-		nums.FloatsEqual(m.g, other.g) &&
-		nums.FloatsEqual(m.b, other.b) &&
-		nums.FloatsEqual(m.e, other.e) &&
-		nums.FloatsEqual(m.h, other.h) &&
-		nums.FloatsEqual(m.c, other.c) &&
-		nums.FloatsEqual(m.f, other.f) &&
-		nums.FloatsEqual(m.i, other.i)
+	return m.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol returns true if all the elements of this and the other matrix are equal within the
+// given tolerance.
+func (m *Matrix3x3) EqualsTol(other *Matrix3x3, tol nums.Tolerance) bool {
+	return tol.Equal(m.a, other.a) &&
+		tol.Equal(m.d, other.d) &&
+		tol.Equal(m.g, other.g) &&
+		tol.Equal(m.b, other.b) &&
+		tol.Equal(m.e, other.e) &&
+		tol.Equal(m.h, other.h) &&
+		tol.Equal(m.c, other.c) &&
+		tol.Equal(m.f, other.f) &&
+		tol.Equal(m.i, other.i)
 }
@@ -0,0 +1,42 @@
+package mesh
+
+import "github.com/angelsolaorbaiceta/inkgeom/g3d"
+
+// A builder creates a mesh from triangles given by the coordinates of their corners, merging
+// the corners with the same coordinates into a single vertex.
+type builder struct {
+	mesh    Mesh
+	indices map[[3]float64]int
+}
+
+func makeBuilder() *builder {
+	return &builder{indices: make(map[[3]float64]int)}
+}
+
+// addTriangle adds the triangle with the given corner coordinates.
+func (b *builder) addTriangle(corners [3][3]float64) {
+	var triangle Triangle
+	for i, corner := range corners {
+		triangle[i] = b.vertexIndex(corner)
+	}
+
+	b.mesh.Triangles = append(b.mesh.Triangles, triangle)
+}
+
+// vertexIndex returns the index of the vertex with the given coordinates, adding it if it's
+// new. Negative zeros are taken as zeros, so that the merged vertices have equal coordinates.
+func (b *builder) vertexIndex(coords [3]float64) int {
+	for i := range coords {
+		coords[i] += 0
+	}
+
+	if index, exists := b.indices[coords]; exists {
+		return index
+	}
+
+	index := len(b.mesh.Vertices)
+	b.indices[coords] = index
+	b.mesh.Vertices = append(b.mesh.Vertices, g3d.MakePoint(coords[0], coords[1], coords[2]))
+
+	return index
+}
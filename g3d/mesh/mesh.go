@@ -0,0 +1,94 @@
+// Package mesh represents triangle meshes, like the envelopes of 3D models, and reads and
+// writes them in the STL, Wavefront OBJ and PLY formats.
+//
+// A Mesh is indexed: the triangles refer to the positions of their corners in the list of
+// vertices, so that the vertices shared by several triangles are stored once. STL files aren't
+// indexed, so the vertices of their triangles with the same coordinates are merged when read.
+//
+// The polygons of OBJ and PLY files with more than three vertices are split into triangles
+// sharing their first vertex, which assumes that the polygons are convex.
+package mesh
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+var (
+	// ErrInvalid is returned when the data isn't valid in its format, or when a mesh's triangles
+	// refer to vertices that don't exist.
+	ErrInvalid = errors.New("invalid mesh")
+	// ErrUnsupported is returned for valid data which this package doesn't support.
+	ErrUnsupported = errors.New("unsupported mesh data")
+)
+
+// A Triangle holds the indices of its three corners in the mesh's vertices, in
+// counter-clockwise order when seen from the outside of the mesh.
+type Triangle [3]int
+
+// A Mesh is a surface in space made of triangles.
+//
+// The Normals are optional: if present, there is a normal versor for each vertex.
+type Mesh struct {
+	Vertices  []*g3d.Point
+	Triangles []Triangle
+	Normals   []*g3d.Vector
+}
+
+// MakeMesh creates a mesh with the given vertices and triangles, without normals.
+//
+// A non-nil error is returned if any triangle refers to a vertex that doesn't exist.
+func MakeMesh(vertices []*g3d.Point, triangles []Triangle) (*Mesh, error) {
+	mesh := &Mesh{Vertices: vertices, Triangles: triangles}
+	if err := mesh.Validate(); err != nil {
+		return nil, err
+	}
+
+	return mesh, nil
+}
+
+// Validate checks that the triangles refer to existing vertices and, if there are normals, that
+// there is one per vertex.
+func (m *Mesh) Validate() error {
+	for i, triangle := range m.Triangles {
+		for _, index := range triangle {
+			if index < 0 || index >= len(m.Vertices) {
+				return fmt.Errorf("%w: triangle %d refers to vertex %d, but there are %d", ErrInvalid, i, index, len(m.Vertices))
+			}
+		}
+	}
+
+	if len(m.Normals) > 0 && len(m.Normals) != len(m.Vertices) {
+		return fmt.Errorf("%w: %d normals for %d vertices", ErrInvalid, len(m.Normals), len(m.Vertices))
+	}
+
+	return nil
+}
+
+// TriangleCorners returns the three corner points of the triangle at the given index.
+func (m *Mesh) TriangleCorners(index int) (*g3d.Point, *g3d.Point, *g3d.Point) {
+	triangle := m.Triangles[index]
+	return m.Vertices[triangle[0]], m.Vertices[triangle[1]], m.Vertices[triangle[2]]
+}
+
+// TriangleNormal computes the normal versor of the triangle at the given index, pointing to the
+// side from which its corners are seen in counter-clockwise order.
+//
+// A g3d.ErrZeroVersor error is returned if the triangle is degenerate: its corners are aligned.
+func (m *Mesh) TriangleNormal(index int) (*g3d.Vector, error) {
+	a, b, c := m.TriangleCorners(index)
+	return a.VectorTo(b).CrossTimes(a.VectorTo(c)).ToVersor()
+}
+
+// fanTriangles splits the polygon with the given vertex indices into triangles sharing the
+// first vertex.
+func fanTriangles(polygon []int) []Triangle {
+	triangles := make([]Triangle, 0, len(polygon)-2)
+	for i := 1; i+1 < len(polygon); i++ {
+		triangles = append(triangles, Triangle{polygon[0], polygon[i], polygon[i+1]})
+	}
+
+	return triangles
+}
@@ -0,0 +1,111 @@
+package mesh
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+// makeTetrahedron returns a tetrahedron whose triangles are counter-clockwise when seen from
+// the outside.
+func makeTetrahedron(t *testing.T) *Mesh {
+	t.Helper()
+
+	mesh, err := MakeMesh(
+		[]*g3d.Point{
+			g3d.MakePoint(0, 0, 0),
+			g3d.MakePoint(1, 0, 0),
+			g3d.MakePoint(0, 1, 0),
+			g3d.MakePoint(0, 0, 1),
+		},
+		[]Triangle{{0, 2, 1}, {0, 1, 3}, {0, 3, 2}, {1, 2, 3}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mesh
+}
+
+// assertSameMesh checks that both meshes have the same vertices, triangles and normals.
+func assertSameMesh(t *testing.T, want, got *Mesh) {
+	t.Helper()
+
+	if len(got.Vertices) != len(want.Vertices) || len(got.Triangles) != len(want.Triangles) {
+		t.Fatalf("Want %d vertices and %d triangles, got %d and %d",
+			len(want.Vertices), len(want.Triangles), len(got.Vertices), len(got.Triangles))
+	}
+	for i, v := range want.Vertices {
+		if !got.Vertices[i].Equals(v) {
+			t.Errorf("Want vertex %d at %v, got %v", i, v, got.Vertices[i])
+		}
+	}
+	for i, triangle := range want.Triangles {
+		if got.Triangles[i] != triangle {
+			t.Errorf("Want triangle %d to be %v, got %v", i, triangle, got.Triangles[i])
+		}
+	}
+	if len(got.Normals) != len(want.Normals) {
+		t.Fatalf("Want %d normals, got %d", len(want.Normals), len(got.Normals))
+	}
+	for i, n := range want.Normals {
+		if !got.Normals[i].Equals(n) {
+			t.Errorf("Want normal %d to be %v, got %v", i, n, got.Normals[i])
+		}
+	}
+}
+
+func TestMakeMesh(t *testing.T) {
+	t.Run("rejects indices out of range", func(t *testing.T) {
+		_, err := MakeMesh([]*g3d.Point{g3d.MakePoint(0, 0, 0)}, []Triangle{{0, 0, 1}})
+		if !errors.Is(err, ErrInvalid) {
+			t.Errorf("Want ErrInvalid, got %v", err)
+		}
+	})
+
+	t.Run("rejects normals not matching the vertices", func(t *testing.T) {
+		mesh := makeTetrahedron(t)
+		mesh.Normals = []*g3d.Vector{g3d.KVersor}
+
+		if err := mesh.Validate(); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Want ErrInvalid, got %v", err)
+		}
+	})
+}
+
+func TestTriangleNormal(t *testing.T) {
+	mesh := makeTetrahedron(t)
+
+	t.Run("points outwards", func(t *testing.T) {
+		normal, err := mesh.TriangleNormal(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := g3d.MakeVector(0, 0, -1); !normal.Equals(want) {
+			t.Errorf("Want %v, got %v", want, normal)
+		}
+	})
+
+	t.Run("degenerate triangle", func(t *testing.T) {
+		mesh.Triangles = append(mesh.Triangles, Triangle{0, 1, 1})
+
+		if _, err := mesh.TriangleNormal(4); !errors.Is(err, g3d.ErrZeroVersor) {
+			t.Errorf("Want ErrZeroVersor, got %v", err)
+		}
+	})
+}
+
+func TestFanTriangles(t *testing.T) {
+	got := fanTriangles([]int{4, 5, 6, 7, 8})
+	want := []Triangle{{4, 5, 6}, {4, 6, 7}, {4, 7, 8}}
+
+	if len(got) != len(want) {
+		t.Fatalf("Want %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	}
+}
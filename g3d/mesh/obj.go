@@ -0,0 +1,214 @@
+package mesh
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+// ReadOBJ reads the vertices (v), normals (vn) and faces (f) of a Wavefront OBJ file. The rest
+// of the statements, like texture coordinates, groups or materials, are ignored.
+//
+// The mesh has normals if all the face vertices refer to one. Since a mesh has a normal per
+// vertex, a vertex used with different normals, like the corners of a box, is duplicated for
+// each of them. Otherwise, the mesh has no normals and all the OBJ vertices are kept in order.
+// The faces may refer to zero-length normals, which can't be normalized, but then the mesh has
+// no normals either.
+func ReadOBJ(r io.Reader) (*Mesh, error) {
+	var (
+		positions  []*g3d.Point
+		normals    []*g3d.Vector
+		faces      [][]objRef
+		allNormals = true
+		scanner    = bufio.NewScanner(r)
+		line       = 0
+	)
+
+	for scanner.Scan() {
+		line++
+
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "v":
+			// The vertices can have a weight, or a color in some extensions, after X, Y and Z.
+			coords, err := objNumbers(fields[1:], 3, line)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, g3d.MakePoint(coords[0], coords[1], coords[2]))
+
+		case "vn":
+			coords, err := objNumbers(fields[1:], 3, line)
+			if err != nil {
+				return nil, err
+			}
+
+			// Zero normals are kept as nil, so that the next ones keep their indices.
+			normal, _ := g3d.MakeVersor(coords[0], coords[1], coords[2])
+			normals = append(normals, normal)
+
+		case "f":
+			if len(fields) < 4 {
+				return nil, fmt.Errorf("%w: a face needs at least three vertices at line %d", ErrInvalid, line)
+			}
+
+			face := make([]objRef, len(fields)-1)
+			for i, field := range fields[1:] {
+				ref, err := parseOBJRef(field, len(positions), len(normals))
+				if err != nil {
+					return nil, fmt.Errorf("%w at line %d", err, line)
+				}
+
+				face[i] = ref
+				allNormals = allNormals && ref.normal >= 0 && normals[ref.normal] != nil
+			}
+			faces = append(faces, face)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if allNormals && len(faces) > 0 {
+		return makeOBJMeshWithNormals(positions, normals, faces), nil
+	}
+
+	mesh := &Mesh{Vertices: positions}
+	for _, face := range faces {
+		polygon := make([]int, len(face))
+		for i, ref := range face {
+			polygon[i] = ref.vertex
+		}
+		mesh.Triangles = append(mesh.Triangles, fanTriangles(polygon)...)
+	}
+
+	return mesh, nil
+}
+
+// An objRef is a face vertex: the zero-based indices of its position and normal, which is -1
+// if the vertex doesn't refer to one.
+type objRef struct {
+	vertex, normal int
+}
+
+// parseOBJRef parses a face vertex, which is "v", "v/vt", "v//vn" or "v/vt/vn". The one-based
+// indices can be negative, referring to the last elements defined.
+func parseOBJRef(field string, positions, normals int) (objRef, error) {
+	parts := strings.Split(field, "/")
+	if len(parts) > 3 {
+		return objRef{}, fmt.Errorf("%w: invalid face vertex %q", ErrInvalid, field)
+	}
+
+	vertex, err := objIndex(parts[0], positions)
+	if err != nil {
+		return objRef{}, err
+	}
+
+	ref := objRef{vertex: vertex, normal: -1}
+	if len(parts) == 3 && parts[2] != "" {
+		if ref.normal, err = objIndex(parts[2], normals); err != nil {
+			return objRef{}, err
+		}
+	}
+
+	return ref, nil
+}
+
+// objIndex converts a one-based, possibly negative, OBJ index into a zero-based one, checking
+// that it refers to one of the count elements defined so far.
+func objIndex(text string, count int) (int, error) {
+	index, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid index %q", ErrInvalid, text)
+	}
+
+	if index < 0 {
+		index += count
+	} else {
+		index--
+	}
+
+	if index < 0 || index >= count {
+		return 0, fmt.Errorf("%w: index %s out of range", ErrInvalid, text)
+	}
+
+	return index, nil
+}
+
+func objNumbers(fields []string, n, line int) ([]float64, error) {
+	if len(fields) < n {
+		return nil, fmt.Errorf("%w: expected %d numbers at line %d", ErrInvalid, n, line)
+	}
+
+	s := &tokenScanner{tokens: fields}
+	values, err := s.numbers(n)
+	if err != nil {
+		return nil, fmt.Errorf("%w at line %d", err, line)
+	}
+
+	return values, nil
+}
+
+// makeOBJMeshWithNormals creates a mesh with a vertex for each distinct pair of position and
+// normal used by the faces.
+func makeOBJMeshWithNormals(positions []*g3d.Point, normals []*g3d.Vector, faces [][]objRef) *Mesh {
+	var (
+		mesh    = &Mesh{}
+		indices = make(map[objRef]int)
+	)
+
+	for _, face := range faces {
+		polygon := make([]int, len(face))
+		for i, ref := range face {
+			index, exists := indices[ref]
+			if !exists {
+				index = len(mesh.Vertices)
+				indices[ref] = index
+				mesh.Vertices = append(mesh.Vertices, positions[ref.vertex])
+				mesh.Normals = append(mesh.Normals, normals[ref.normal])
+			}
+
+			polygon[i] = index
+		}
+
+		mesh.Triangles = append(mesh.Triangles, fanTriangles(polygon)...)
+	}
+
+	return mesh
+}
+
+// WriteOBJ writes the mesh as a Wavefront OBJ file, with a normal for each vertex if the mesh
+// has normals.
+func WriteOBJ(w io.Writer, m *Mesh) error {
+	bw := bufio.NewWriter(w)
+
+	for _, v := range m.Vertices {
+		fmt.Fprintf(bw, "v %s\n", formatCoords(v.X(), v.Y(), v.Z()))
+	}
+	for _, n := range m.Normals {
+		fmt.Fprintf(bw, "vn %s\n", formatCoords(n.X(), n.Y(), n.Z()))
+	}
+
+	for _, triangle := range m.Triangles {
+		bw.WriteString("f")
+		for _, index := range triangle {
+			if len(m.Normals) > 0 {
+				fmt.Fprintf(bw, " %d//%d", index+1, index+1)
+			} else {
+				fmt.Fprintf(bw, " %d", index+1)
+			}
+		}
+		bw.WriteString("\n")
+	}
+
+	return bw.Flush()
+}
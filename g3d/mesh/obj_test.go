@@ -0,0 +1,145 @@
+package mesh
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestReadOBJ(t *testing.T) {
+	t.Run("quad without normals", func(t *testing.T) {
+		data := `# a square
+o square
+v 0 0 0
+v 1 0 0 1.0
+v 1 1 0
+v 0 1 0
+vt 0 0
+usemtl concrete
+f 1/1 2/1 -2/1 -1/1
+`
+		mesh, err := ReadOBJ(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := MakeMesh(
+			[]*g3d.Point{
+				g3d.MakePoint(0, 0, 0), g3d.MakePoint(1, 0, 0), g3d.MakePoint(1, 1, 0), g3d.MakePoint(0, 1, 0),
+			},
+			[]Triangle{{0, 1, 2}, {0, 2, 3}},
+		)
+		assertSameMesh(t, want, mesh)
+	})
+
+	t.Run("vertices with different normals are split", func(t *testing.T) {
+		data := `v 0 0 0
+v 1 0 0
+v 0 1 0
+v 0 0 1
+vn 0 0 -2
+vn 0 -1 0
+f 1//1 3//1 2//1
+f 1//2 2//2 4//2
+`
+		mesh, err := ReadOBJ(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mesh.Vertices) != 6 || len(mesh.Normals) != 6 {
+			t.Fatalf("Want 6 vertices with normals, got %d and %d", len(mesh.Vertices), len(mesh.Normals))
+		}
+		if !mesh.Normals[0].Equals(g3d.MakeVector(0, 0, -1)) {
+			t.Errorf("Want the normals normalized, got %v", mesh.Normals[0])
+		}
+		if want := (Triangle{3, 4, 5}); mesh.Triangles[1] != want {
+			t.Errorf("Want %v, got %v", want, mesh.Triangles[1])
+		}
+	})
+
+	t.Run("zero normal drops the normals", func(t *testing.T) {
+		data := `v 0 0 0
+v 1 0 0
+v 0 1 0
+vn 0 0 1
+vn 0 0 0
+f 1//1 2//2 3//1
+`
+		mesh, err := ReadOBJ(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mesh.Vertices) != 3 || mesh.Normals != nil {
+			t.Errorf("Want 3 vertices without normals, got %d and %d", len(mesh.Vertices), len(mesh.Normals))
+		}
+	})
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"index out of range", "v 0 0 0\nv 1 0 0\nf 1 2 3\n"},
+		{"zero index", "v 0 0 0\nv 1 0 0\nv 1 1 0\nf 0 1 2\n"},
+		{"short face", "v 0 0 0\nv 1 0 0\nf 1 2\n"},
+		{"short vertex", "v 0 0\n"},
+		{"invalid number", "v 0 0 x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadOBJ(strings.NewReader(tt.data)); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Want ErrInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+func TestOBJRoundTrip(t *testing.T) {
+	t.Run("without normals", func(t *testing.T) {
+		var (
+			tetrahedron = makeTetrahedron(t)
+			b           strings.Builder
+		)
+
+		if err := WriteOBJ(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+
+		mesh, err := ReadOBJ(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameMesh(t, tetrahedron, mesh)
+	})
+
+	t.Run("with normals", func(t *testing.T) {
+		var (
+			tetrahedron = makeTetrahedron(t)
+			b           strings.Builder
+		)
+
+		for _, v := range tetrahedron.Vertices {
+			normal, _ := g3d.MakeVersor(v.X()-0.25, v.Y()-0.25, v.Z()-0.25)
+			tetrahedron.Normals = append(tetrahedron.Normals, normal)
+		}
+
+		if err := WriteOBJ(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(b.String(), "f 1//1 3//3 2//2\n") {
+			t.Errorf("Expected the faces to refer to the normals:\n%s", b.String())
+		}
+
+		mesh, err := ReadOBJ(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(mesh.Vertices) != 4 || len(mesh.Normals) != 4 {
+			t.Errorf("Want 4 vertices with normals, got %d and %d", len(mesh.Vertices), len(mesh.Normals))
+		}
+	})
+}
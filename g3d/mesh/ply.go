@@ -0,0 +1,391 @@
+package mesh
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// The PLY formats.
+const (
+	plyASCII        = "ascii"
+	plyLittleEndian = "binary_little_endian"
+	plyBigEndian    = "binary_big_endian"
+)
+
+// ReadPLY reads a PLY file, in the ASCII or any of the binary formats.
+//
+// The mesh takes the x, y and z properties of the vertex elements, and the nx, ny and nz ones as
+// normals if present, unless any of them has zero length. The faces are given by the vertex_indices (or vertex_index) list property
+// of the face elements. The rest of the elements and properties are ignored.
+func ReadPLY(r io.Reader) (*Mesh, error) {
+	br := bufio.NewReader(r)
+
+	header, err := readPLYHeader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	var values plyValues
+	switch header.format {
+	case plyASCII:
+		scanner := bufio.NewScanner(br)
+		scanner.Split(bufio.ScanWords)
+		values = &plyTextValues{scanner}
+	case plyLittleEndian:
+		values = &plyBinaryValues{br, binary.LittleEndian}
+	case plyBigEndian:
+		values = &plyBinaryValues{br, binary.BigEndian}
+	}
+
+	mesh := &Mesh{}
+	for _, element := range header.elements {
+		for i := 0; i < element.count; i++ {
+			if err := element.read(values, mesh); err != nil {
+				return nil, fmt.Errorf("%w: %s %d: %v", ErrInvalid, element.name, i, err)
+			}
+		}
+	}
+
+	for _, normal := range mesh.Normals {
+		if normal == nil {
+			mesh.Normals = nil
+			break
+		}
+	}
+
+	if err := mesh.Validate(); err != nil {
+		return nil, err
+	}
+
+	return mesh, nil
+}
+
+type plyHeader struct {
+	format   string
+	elements []*plyElement
+}
+
+// A plyElement is the declaration of an element in the header: its name, how many there are
+// and their properties.
+type plyElement struct {
+	name       string
+	count      int
+	properties []plyProperty
+}
+
+// A plyProperty is the declaration of a property. List properties have a count, of the
+// countType, followed by that number of values of the valueType.
+type plyProperty struct {
+	name      string
+	valueType string
+	countType string
+	isList    bool
+}
+
+func readPLYHeader(r *bufio.Reader) (*plyHeader, error) {
+	var (
+		header  = &plyHeader{}
+		element *plyElement
+	)
+
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("%w: the PLY header isn't terminated", ErrInvalid)
+		}
+
+		fields := strings.Fields(line)
+		if lineNumber == 1 {
+			if len(fields) != 1 || fields[0] != "ply" {
+				return nil, fmt.Errorf("%w: the data isn't a PLY file", ErrInvalid)
+			}
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "format":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("%w: invalid format line %q", ErrInvalid, strings.TrimSpace(line))
+			}
+			switch fields[1] {
+			case plyASCII, plyLittleEndian, plyBigEndian:
+			default:
+				return nil, fmt.Errorf("%w: PLY format %q", ErrUnsupported, fields[1])
+			}
+			if fields[2] != "1.0" {
+				return nil, fmt.Errorf("%w: PLY version %q", ErrUnsupported, fields[2])
+			}
+			header.format = fields[1]
+
+		case "element":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("%w: invalid element line %q", ErrInvalid, strings.TrimSpace(line))
+			}
+
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return nil, fmt.Errorf("%w: invalid element count %q", ErrInvalid, fields[2])
+			}
+
+			element = &plyElement{name: fields[1], count: count}
+			header.elements = append(header.elements, element)
+
+		case "property":
+			if element == nil {
+				return nil, fmt.Errorf("%w: property outside an element", ErrInvalid)
+			}
+
+			property, err := parsePLYProperty(fields[1:])
+			if err != nil {
+				return nil, err
+			}
+			element.properties = append(element.properties, property)
+
+		case "end_header":
+			if header.format == "" {
+				return nil, fmt.Errorf("%w: the PLY header has no format", ErrInvalid)
+			}
+			return header, nil
+
+		case "comment", "obj_info":
+
+		default:
+			return nil, fmt.Errorf("%w: unknown header line %q", ErrInvalid, strings.TrimSpace(line))
+		}
+	}
+}
+
+func parsePLYProperty(fields []string) (plyProperty, error) {
+	if len(fields) == 4 && fields[0] == "list" {
+		property := plyProperty{name: fields[3], countType: fields[1], valueType: fields[2], isList: true}
+		if plyTypeSize(property.countType) == 0 || plyTypeSize(property.valueType) == 0 {
+			return plyProperty{}, fmt.Errorf("%w: invalid types of list property %q", ErrInvalid, property.name)
+		}
+
+		return property, nil
+	}
+
+	if len(fields) != 2 || plyTypeSize(fields[0]) == 0 {
+		return plyProperty{}, fmt.Errorf("%w: invalid property %q", ErrInvalid, strings.Join(fields, " "))
+	}
+
+	return plyProperty{name: fields[1], valueType: fields[0]}, nil
+}
+
+// read reads an element, adding it to the mesh if it's a vertex or a face.
+func (e *plyElement) read(values plyValues, mesh *Mesh) error {
+	var (
+		scalars = make(map[string]float64)
+		indices []int
+	)
+
+	for _, property := range e.properties {
+		if !property.isList {
+			value, err := values.next(property.valueType)
+			if err != nil {
+				return err
+			}
+
+			scalars[property.name] = value
+			continue
+		}
+
+		count, err := values.next(property.countType)
+		if err != nil {
+			return err
+		}
+		if count < 0 {
+			return fmt.Errorf("negative list count")
+		}
+
+		isFaceList := property.name == "vertex_indices" || property.name == "vertex_index"
+		for i := 0; i < int(count); i++ {
+			value, err := values.next(property.valueType)
+			if err != nil {
+				return err
+			}
+
+			if isFaceList {
+				indices = append(indices, int(value))
+			}
+		}
+	}
+
+	switch e.name {
+	case "vertex":
+		return addPLYVertex(scalars, mesh)
+	case "face":
+		if len(indices) < 3 {
+			return fmt.Errorf("a face needs at least three vertices")
+		}
+		mesh.Triangles = append(mesh.Triangles, fanTriangles(indices)...)
+	}
+
+	return nil
+}
+
+func addPLYVertex(scalars map[string]float64, mesh *Mesh) error {
+	x, hasX := scalars["x"]
+	y, hasY := scalars["y"]
+	z, hasZ := scalars["z"]
+	if !hasX || !hasY || !hasZ {
+		return fmt.Errorf("the vertices need x, y and z properties")
+	}
+	mesh.Vertices = append(mesh.Vertices, g3d.MakePoint(x, y, z))
+
+	nx, hasNX := scalars["nx"]
+	ny, hasNY := scalars["ny"]
+	nz, hasNZ := scalars["nz"]
+	if hasNX && hasNY && hasNZ {
+		// Zero normals are kept as nil, and the mesh's normals dropped after reading.
+		normal, _ := g3d.MakeVersor(nx, ny, nz)
+		mesh.Normals = append(mesh.Normals, normal)
+	}
+
+	return nil
+}
+
+// plyTypeSize returns the size in bytes of the PLY type, or zero if the type doesn't exist.
+func plyTypeSize(plyType string) int {
+	switch plyType {
+	case "char", "uchar", "int8", "uint8":
+		return 1
+	case "short", "ushort", "int16", "uint16":
+		return 2
+	case "int", "uint", "float", "int32", "uint32", "float32":
+		return 4
+	case "double", "float64":
+		return 8
+	default:
+		return 0
+	}
+}
+
+// plyValues reads the values of the elements, in the ASCII or a binary format.
+type plyValues interface {
+	next(plyType string) (float64, error)
+}
+
+type plyTextValues struct {
+	scanner *bufio.Scanner
+}
+
+func (v *plyTextValues) next(string) (float64, error) {
+	if !v.scanner.Scan() {
+		if err := v.scanner.Err(); err != nil {
+			return 0, err
+		}
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return parseNumber(v.scanner.Text())
+}
+
+type plyBinaryValues struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+}
+
+func (v *plyBinaryValues) next(plyType string) (float64, error) {
+	var buf [8]byte
+	data := buf[:plyTypeSize(plyType)]
+	if _, err := io.ReadFull(v.r, data); err != nil {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	var value float64
+	switch plyType {
+	case "char", "int8":
+		value = float64(int8(data[0]))
+	case "uchar", "uint8":
+		value = float64(data[0])
+	case "short", "int16":
+		value = float64(int16(v.order.Uint16(data)))
+	case "ushort", "uint16":
+		value = float64(v.order.Uint16(data))
+	case "int", "int32":
+		value = float64(int32(v.order.Uint32(data)))
+	case "uint", "uint32":
+		value = float64(v.order.Uint32(data))
+	case "float", "float32":
+		value = float64(math.Float32frombits(v.order.Uint32(data)))
+	case "double", "float64":
+		value = math.Float64frombits(v.order.Uint64(data))
+	}
+
+	return value, floatenc.CheckFinite(value)
+}
+
+// WritePLYText writes the mesh as an ASCII PLY file.
+func WritePLYText(w io.Writer, m *Mesh) error {
+	bw := bufio.NewWriter(w)
+	writePLYHeader(bw, m, plyASCII)
+
+	for i, v := range m.Vertices {
+		bw.WriteString(formatCoords(v.X(), v.Y(), v.Z()))
+		if len(m.Normals) > 0 {
+			n := m.Normals[i]
+			bw.WriteString(" " + formatCoords(n.X(), n.Y(), n.Z()))
+		}
+		bw.WriteString("\n")
+	}
+
+	for _, triangle := range m.Triangles {
+		fmt.Fprintf(bw, "3 %d %d %d\n", triangle[0], triangle[1], triangle[2])
+	}
+
+	return bw.Flush()
+}
+
+// WritePLYBinary writes the mesh as a little-endian binary PLY file.
+func WritePLYBinary(w io.Writer, m *Mesh) error {
+	bw := bufio.NewWriter(w)
+	writePLYHeader(bw, m, plyLittleEndian)
+
+	var data []byte
+	for i, v := range m.Vertices {
+		coords := []float64{v.X(), v.Y(), v.Z()}
+		if len(m.Normals) > 0 {
+			n := m.Normals[i]
+			coords = append(coords, n.X(), n.Y(), n.Z())
+		}
+
+		data = data[:0]
+		for _, coord := range coords {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(coord))
+		}
+		bw.Write(data)
+	}
+
+	for _, triangle := range m.Triangles {
+		data = append(data[:0], 3)
+		for _, index := range triangle {
+			data = binary.LittleEndian.AppendUint32(data, uint32(index))
+		}
+		bw.Write(data)
+	}
+
+	return bw.Flush()
+}
+
+func writePLYHeader(w *bufio.Writer, m *Mesh, format string) {
+	fmt.Fprintf(w, "ply\nformat %s 1.0\n", format)
+	fmt.Fprintf(w, "element vertex %d\n", len(m.Vertices))
+	w.WriteString("property double x\nproperty double y\nproperty double z\n")
+	if len(m.Normals) > 0 {
+		w.WriteString("property double nx\nproperty double ny\nproperty double nz\n")
+	}
+	fmt.Fprintf(w, "element face %d\n", len(m.Triangles))
+	w.WriteString("property list uchar int vertex_indices\nend_header\n")
+}
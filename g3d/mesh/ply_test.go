@@ -0,0 +1,157 @@
+package mesh
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestReadPLY(t *testing.T) {
+	t.Run("ascii with extra elements and properties", func(t *testing.T) {
+		data := `ply
+format ascii 1.0
+comment made by hand
+element vertex 4
+property float x
+property float y
+property float z
+property uchar red
+element face 1
+property list uchar int vertex_indices
+property int flags
+element edge 1
+property int vertex1
+property int vertex2
+end_header
+0 0 0 255
+1 0 0 255
+1 1 0 255
+0 1 0 255
+4 0 1 2 3 7
+0 1
+`
+		mesh, err := ReadPLY(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := MakeMesh(
+			[]*g3d.Point{
+				g3d.MakePoint(0, 0, 0), g3d.MakePoint(1, 0, 0), g3d.MakePoint(1, 1, 0), g3d.MakePoint(0, 1, 0),
+			},
+			[]Triangle{{0, 1, 2}, {0, 2, 3}},
+		)
+		assertSameMesh(t, want, mesh)
+	})
+
+	t.Run("big endian with float32 coordinates", func(t *testing.T) {
+		var b bytes.Buffer
+		b.WriteString("ply\nformat binary_big_endian 1.0\nelement vertex 3\n" +
+			"property float x\nproperty float y\nproperty float z\n" +
+			"element face 1\nproperty list uchar ushort vertex_index\nend_header\n")
+
+		for _, coord := range []float32{0, 0, 0, 2, 0, 0, 0, 2, 0.5} {
+			binary.Write(&b, binary.BigEndian, math.Float32bits(coord))
+		}
+		b.WriteByte(3)
+		binary.Write(&b, binary.BigEndian, []uint16{0, 1, 2})
+
+		mesh, err := ReadPLY(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !mesh.Vertices[2].Equals(g3d.MakePoint(0, 2, 0.5)) || mesh.Triangles[0] != (Triangle{0, 1, 2}) {
+			t.Errorf("Unexpected mesh %+v", mesh)
+		}
+	})
+
+	t.Run("zero normal drops the normals", func(t *testing.T) {
+		data := `ply
+format ascii 1.0
+element vertex 3
+property float x
+property float y
+property float z
+property float nx
+property float ny
+property float nz
+element face 1
+property list uchar int vertex_indices
+end_header
+0 0 0 0 0 1
+1 0 0 0 0 0
+0 1 0 0 0 1
+3 0 1 2
+`
+		mesh, err := ReadPLY(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mesh.Vertices) != 3 || mesh.Normals != nil {
+			t.Errorf("Want 3 vertices without normals, got %d and %d", len(mesh.Vertices), len(mesh.Normals))
+		}
+	})
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"not a PLY file", "obj\n", ErrInvalid},
+		{"unterminated header", "ply\nformat ascii 1.0\n", ErrInvalid},
+		{"unknown format", "ply\nformat binary_middle_endian 1.0\nend_header\n", ErrUnsupported},
+		{"unknown type", "ply\nformat ascii 1.0\nelement vertex 1\nproperty float128 x\nend_header\n", ErrInvalid},
+		{"missing coordinates", "ply\nformat ascii 1.0\nelement vertex 1\nproperty float x\nend_header\n1\n", ErrInvalid},
+		{"truncated", "ply\nformat ascii 1.0\nelement vertex 2\nproperty float x\nproperty float y\nproperty float z\nend_header\n1 2 3\n", ErrInvalid},
+		{"index out of range", "ply\nformat ascii 1.0\nelement vertex 0\nelement face 1\nproperty list uchar int vertex_indices\nend_header\n3 0 1 2\n", ErrInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadPLY(strings.NewReader(tt.data)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Want %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPLYRoundTrip(t *testing.T) {
+	tetrahedron := makeTetrahedron(t)
+	for _, v := range tetrahedron.Vertices {
+		normal, _ := g3d.MakeVersor(v.X()-0.25, v.Y()-0.25, v.Z()-0.25)
+		tetrahedron.Normals = append(tetrahedron.Normals, normal)
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var b strings.Builder
+		if err := WritePLYText(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+
+		mesh, err := ReadPLY(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameMesh(t, tetrahedron, mesh)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := WritePLYBinary(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+
+		mesh, err := ReadPLY(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameMesh(t, tetrahedron, mesh)
+	})
+}
@@ -0,0 +1,207 @@
+package mesh
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// Sizes of the parts of a binary STL file.
+const (
+	stlHeaderSize   = 80
+	stlTriangleSize = 50
+)
+
+// ReadSTL reads an STL file, either in the ASCII or in the binary format.
+//
+// The normals of the STL triangles are ignored, as they can be computed from the corners, and
+// the read mesh has no normals.
+func ReadSTL(r io.Reader) (*Mesh, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Binary files can start with "solid" too, so their size is checked first.
+	if len(data) >= stlHeaderSize+4 {
+		count := binary.LittleEndian.Uint32(data[stlHeaderSize:])
+		if uint64(len(data)) == stlHeaderSize+4+uint64(count)*stlTriangleSize {
+			return readBinarySTL(data[stlHeaderSize+4:], int(count))
+		}
+	}
+
+	if fields := strings.Fields(string(data[:minInt(len(data), 256)])); len(fields) > 0 && fields[0] == "solid" {
+		return readTextSTL(data)
+	}
+
+	return nil, fmt.Errorf("%w: the data isn't an STL file", ErrInvalid)
+}
+
+func readBinarySTL(data []byte, count int) (*Mesh, error) {
+	b := makeBuilder()
+
+	for t := 0; t < count; t++ {
+		var (
+			triangle = data[t*stlTriangleSize:]
+			corners  [3][3]float64
+		)
+
+		// The corners follow the normal, which takes the first three values.
+		for i := range corners {
+			for j := range corners[i] {
+				offset := 4 * (3 + 3*i + j)
+				corners[i][j] = float64(math.Float32frombits(binary.LittleEndian.Uint32(triangle[offset:])))
+			}
+
+			if err := floatenc.CheckFinite(corners[i][:]...); err != nil {
+				return nil, fmt.Errorf("%w: triangle %d: %v", ErrInvalid, t, err)
+			}
+		}
+
+		b.addTriangle(corners)
+	}
+
+	return &b.mesh, nil
+}
+
+// readTextSTL reads the solids of an ASCII STL file.
+func readTextSTL(data []byte) (*Mesh, error) {
+	var (
+		b = makeBuilder()
+		s = &tokenScanner{tokens: strings.Fields(string(data))}
+	)
+
+	for !s.done() {
+		if err := s.expect("solid"); err != nil {
+			return nil, err
+		}
+		s.skipUntil("facet", "endsolid")
+
+		for s.peek() == "facet" {
+			if err := s.expect("facet", "normal"); err != nil {
+				return nil, err
+			}
+			if _, err := s.numbers(3); err != nil {
+				return nil, err
+			}
+			if err := s.expect("outer", "loop"); err != nil {
+				return nil, err
+			}
+
+			var corners [3][3]float64
+			for i := range corners {
+				if err := s.expect("vertex"); err != nil {
+					return nil, err
+				}
+
+				coords, err := s.numbers(3)
+				if err != nil {
+					return nil, err
+				}
+				copy(corners[i][:], coords)
+			}
+
+			if err := s.expect("endloop", "endfacet"); err != nil {
+				return nil, err
+			}
+
+			b.addTriangle(corners)
+		}
+
+		if err := s.expect("endsolid"); err != nil {
+			return nil, err
+		}
+		s.skipUntil("solid")
+	}
+
+	return &b.mesh, nil
+}
+
+// WriteSTLText writes the mesh as an ASCII STL file with a solid of the given name.
+// The facet normals are computed from the triangles' corners, and are zero for degenerate
+// triangles.
+func WriteSTLText(w io.Writer, m *Mesh, name string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "solid %s\n", name)
+
+	for i := range m.Triangles {
+		normal := m.triangleNormalOrZero(i)
+		fmt.Fprintf(bw, "  facet normal %s\n    outer loop\n", formatCoords(normal[:]...))
+
+		a, b, c := m.TriangleCorners(i)
+		for _, corner := range []*g3d.Point{a, b, c} {
+			fmt.Fprintf(bw, "      vertex %s\n", formatCoords(corner.X(), corner.Y(), corner.Z()))
+		}
+
+		bw.WriteString("    endloop\n  endfacet\n")
+	}
+
+	fmt.Fprintf(bw, "endsolid %s\n", name)
+	return bw.Flush()
+}
+
+// WriteSTLBinary writes the mesh as a binary STL file, whose coordinates are single precision
+// floats. The facet normals are computed from the triangles' corners, and are zero for
+// degenerate triangles.
+func WriteSTLBinary(w io.Writer, m *Mesh) error {
+	data := make([]byte, stlHeaderSize, stlHeaderSize+4+len(m.Triangles)*stlTriangleSize)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(m.Triangles)))
+
+	for i := range m.Triangles {
+		var (
+			normal  = m.triangleNormalOrZero(i)
+			a, b, c = m.TriangleCorners(i)
+			values  = []float64{
+				normal[0], normal[1], normal[2],
+				a.X(), a.Y(), a.Z(),
+				b.X(), b.Y(), b.Z(),
+				c.X(), c.Y(), c.Z(),
+			}
+		)
+
+		for _, value := range values {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(value)))
+		}
+
+		// The attribute byte count, unused.
+		data = append(data, 0, 0)
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+// triangleNormalOrZero returns the projections of the triangle's normal, or zeros if the
+// triangle is degenerate.
+func (m *Mesh) triangleNormalOrZero(index int) [3]float64 {
+	normal, err := m.TriangleNormal(index)
+	if err != nil {
+		return [3]float64{}
+	}
+
+	return [3]float64{normal.X(), normal.Y(), normal.Z()}
+}
+
+func formatCoords(values ...float64) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
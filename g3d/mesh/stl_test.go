@@ -0,0 +1,135 @@
+package mesh
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadSTLText(t *testing.T) {
+	t.Run("merges the shared vertices", func(t *testing.T) {
+		data := `solid square with a name
+  facet normal 0 0 1
+    outer loop
+      vertex 0 0 0
+      vertex 1 0 0
+      vertex 1 1 0
+    endloop
+  endfacet
+  facet normal 0 0 1
+    outer loop
+      vertex 0 0 0
+      vertex 1 1 0
+      vertex 0 1 -0
+    endloop
+  endfacet
+endsolid square with a name
+`
+		mesh, err := ReadSTL(strings.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(mesh.Vertices) != 4 || len(mesh.Triangles) != 2 {
+			t.Fatalf("Want 4 vertices and 2 triangles, got %d and %d", len(mesh.Vertices), len(mesh.Triangles))
+		}
+		if want := (Triangle{0, 2, 3}); mesh.Triangles[1] != want {
+			t.Errorf("Want %v, got %v", want, mesh.Triangles[1])
+		}
+	})
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not an STL file", "hello"},
+		{"missing vertex", "solid s facet normal 0 0 1 outer loop vertex 0 0 0 vertex 1 0 0 endloop endfacet endsolid"},
+		{"invalid number", "solid s facet normal 0 0 1 outer loop vertex 0 0 x"},
+		{"not terminated", "solid s facet normal 0 0 1 outer loop vertex 0 0 0 vertex 1 0 0 vertex 1 1 0 endloop endfacet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadSTL(strings.NewReader(tt.data)); !errors.Is(err, ErrInvalid) {
+				t.Errorf("Want ErrInvalid, got %v", err)
+			}
+		})
+	}
+}
+
+// assertSameTriangles checks that both meshes have triangles with the same corners, as reading
+// an STL file numbers the vertices in the order they're first used.
+func assertSameTriangles(t *testing.T, want, got *Mesh) {
+	t.Helper()
+
+	if len(got.Triangles) != len(want.Triangles) {
+		t.Fatalf("Want %d triangles, got %d", len(want.Triangles), len(got.Triangles))
+	}
+
+	for i := range want.Triangles {
+		wantA, wantB, wantC := want.TriangleCorners(i)
+		gotA, gotB, gotC := got.TriangleCorners(i)
+
+		if !gotA.Equals(wantA) || !gotB.Equals(wantB) || !gotC.Equals(wantC) {
+			t.Errorf("Want triangle %d at %v %v %v, got %v %v %v", i, wantA, wantB, wantC, gotA, gotB, gotC)
+		}
+	}
+}
+
+func TestSTLRoundTrip(t *testing.T) {
+	tetrahedron := makeTetrahedron(t)
+
+	t.Run("text", func(t *testing.T) {
+		var b strings.Builder
+		if err := WriteSTLText(&b, tetrahedron, "tetra"); err != nil {
+			t.Fatal(err)
+		}
+
+		if !strings.Contains(b.String(), "facet normal 0 0 -1\n") {
+			t.Errorf("Expected the computed normal in:\n%s", b.String())
+		}
+
+		mesh, err := ReadSTL(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameTriangles(t, tetrahedron, mesh)
+		if len(mesh.Vertices) != 4 {
+			t.Errorf("Want 4 vertices, got %d", len(mesh.Vertices))
+		}
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := WriteSTLBinary(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+
+		if got, want := b.Len(), 84+4*50; got != want {
+			t.Errorf("Want %d bytes, got %d", want, got)
+		}
+
+		mesh, err := ReadSTL(&b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameTriangles(t, tetrahedron, mesh)
+	})
+
+	t.Run("binary header starting with solid", func(t *testing.T) {
+		var b bytes.Buffer
+		if err := WriteSTLBinary(&b, tetrahedron); err != nil {
+			t.Fatal(err)
+		}
+
+		data := b.Bytes()
+		copy(data, "solid tetra")
+
+		mesh, err := ReadSTL(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertSameTriangles(t, tetrahedron, mesh)
+	})
+}
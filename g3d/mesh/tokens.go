@@ -0,0 +1,78 @@
+package mesh
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/angelsolaorbaiceta/inkgeom/internal/floatenc"
+)
+
+// A tokenScanner reads the whitespace separated tokens of the text formats.
+type tokenScanner struct {
+	tokens []string
+	pos    int
+}
+
+func (s *tokenScanner) done() bool {
+	return s.pos >= len(s.tokens)
+}
+
+// peek returns the next token without consuming it, or an empty string at the end.
+func (s *tokenScanner) peek() string {
+	if s.done() {
+		return ""
+	}
+
+	return s.tokens[s.pos]
+}
+
+// expect consumes the given tokens, failing if the next ones are different.
+func (s *tokenScanner) expect(tokens ...string) error {
+	for _, token := range tokens {
+		if got := s.peek(); got != token {
+			return fmt.Errorf("%w: expected %q, got %q", ErrInvalid, token, got)
+		}
+		s.pos++
+	}
+
+	return nil
+}
+
+// skipUntil advances until the next token is one of the given ones, or to the end.
+func (s *tokenScanner) skipUntil(tokens ...string) {
+	for ; !s.done(); s.pos++ {
+		for _, token := range tokens {
+			if s.tokens[s.pos] == token {
+				return
+			}
+		}
+	}
+}
+
+// numbers consumes the next n tokens as finite numbers.
+func (s *tokenScanner) numbers(n int) ([]float64, error) {
+	values := make([]float64, n)
+	for i := range values {
+		value, err := parseNumber(s.peek())
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = value
+		s.pos++
+	}
+
+	return values, nil
+}
+
+func parseNumber(token string) (float64, error) {
+	value, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid number %q", ErrInvalid, token)
+	}
+	if err := floatenc.CheckFinite(value); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	return value, nil
+}
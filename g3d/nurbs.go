@@ -0,0 +1,213 @@
+package g3d
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/internal/bspline"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A NURBS is a non-uniform rational B-spline curve, defined by its degree, a list of weighted
+// control points and a knot vector. B-spline curves are NURBS whose weights are all one.
+//
+// The curve is parametrized so that t = 0 corresponds to the start of the knot vector's domain,
+// and t = 1 to its end.
+type NURBS struct {
+	degree  int
+	points  []*Point
+	weights []float64
+	knots   nums.KnotVector
+}
+
+// MakeBSpline creates a new non-rational B-spline curve given its degree, control points and
+// knot vector.
+//
+// A non-nil error is returned if the number of knots isn't the number of control points plus
+// the degree plus one.
+func MakeBSpline(degree int, points []*Point, knots nums.KnotVector) (*NURBS, error) {
+	weights := make([]float64, len(points))
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return MakeNURBS(degree, points, weights, knots)
+}
+
+// MakeClampedBSpline creates a new non-rational B-spline curve with a clamped, uniform knot
+// vector. The curve starts at the first control point and ends at the last one.
+//
+// A non-nil error is returned if there aren't at least degree + 1 control points.
+func MakeClampedBSpline(degree int, points []*Point) (*NURBS, error) {
+	knots, err := nums.MakeClampedUniformKnotVector(degree, len(points))
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeBSpline(degree, points, knots)
+}
+
+// MakeNURBS creates a new NURBS curve given its degree, control points, their weights and the
+// knot vector.
+//
+// A non-nil error is returned if the number of weights doesn't match the number of control
+// points, any of the weights isn't positive, or the number of knots isn't the number of control
+// points plus the degree plus one.
+func MakeNURBS(degree int, points []*Point, weights []float64, knots nums.KnotVector) (*NURBS, error) {
+	if len(weights) != len(points) {
+		return nil, errors.New("there must be a weight for each control point")
+	}
+	for _, weight := range weights {
+		if weight <= 0 {
+			return nil, errors.New("the weights must be greater than zero")
+		}
+	}
+	if err := bspline.Validate(degree, knots, len(points)); err != nil {
+		return nil, err
+	}
+
+	return &NURBS{degree, points, weights, knots}, nil
+}
+
+// InterpolateBSpline creates a non-rational B-spline curve of the given degree which passes
+// through all the given points, in order.
+//
+// A non-nil error is returned if there aren't at least degree + 1 points.
+func InterpolateBSpline(degree int, points []*Point) (*NURBS, error) {
+	if degree < 1 {
+		return nil, errors.New("the degree must be at least one")
+	}
+
+	knots, ctrl, err := bspline.Interpolate(degree, pointsToCoords(points))
+	if err != nil {
+		return nil, err
+	}
+
+	return MakeBSpline(degree, coordsToPoints(ctrl), knots)
+}
+
+// The Degree of the curve's basis functions.
+func (n *NURBS) Degree() int {
+	return n.degree
+}
+
+// ControlPoints returns the curve's control points.
+func (n *NURBS) ControlPoints() []*Point {
+	return n.points
+}
+
+// Weights returns the weights of the control points.
+func (n *NURBS) Weights() []float64 {
+	return n.weights
+}
+
+// Knots returns the curve's knot vector.
+func (n *NURBS) Knots() nums.KnotVector {
+	return n.knots
+}
+
+// IsRational returns true if not all the weights are equal.
+func (n *NURBS) IsRational() bool {
+	for _, weight := range n.weights {
+		if !nums.FloatsEqual(weight, n.weights[0]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PointAt computes an intermediate point in the curve.
+func (n *NURBS) PointAt(t nums.TParam) *Point {
+	return n.derivativesAt(t, 0)[0]
+}
+
+// DerivativeAt computes the derivative of the given order of the curve with respect to t.
+func (n *NURBS) DerivativeAt(t nums.TParam, order int) *Vector {
+	derivative := n.derivativesAt(t, order)[order]
+	return MakeVector(derivative.x, derivative.y, derivative.z)
+}
+
+// TangentAt computes the versor tangent to the curve at the given t parameter.
+// Returns an ErrZeroVersor error if the derivative is zero at that point.
+func (n *NURBS) TangentAt(t nums.TParam) (*Vector, error) {
+	return n.DerivativeAt(t, 1).ToVersor()
+}
+
+// WithInsertedKnot creates a new curve with the same shape as this one, where the knot value u
+// is inserted the given number of times.
+//
+// A non-nil error is returned if the knot is outside of the curve's domain or its resulting
+// multiplicity would be greater than the degree.
+func (n *NURBS) WithInsertedKnot(u float64, times int) (*NURBS, error) {
+	knots, ctrl, err := bspline.InsertKnot(n.degree, n.knots, n.homogeneous(), u, times)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeNURBSFromHomogeneous(n.degree, ctrl, knots)
+}
+
+// WithElevatedDegree creates a new curve with the same shape as this one, whose degree is raised
+// the given number of times.
+//
+// A non-nil error is returned if the knot vector isn't clamped.
+func (n *NURBS) WithElevatedDegree(times int) (*NURBS, error) {
+	knots, ctrl, err := bspline.ElevateDegree(n.degree, n.knots, n.homogeneous(), times)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeNURBSFromHomogeneous(n.degree+times, ctrl, knots)
+}
+
+// derivativesAt computes the point and derivatives up to the given order with respect to t,
+// represented as points.
+func (n *NURBS) derivativesAt(t nums.TParam, order int) []*Point {
+	var (
+		u          = n.knots.ValueAt(n.degree, t)
+		start, end = n.knots.Domain(n.degree)
+		ders       = bspline.RationalDerivatives(n.degree, n.knots, n.homogeneous(), u, order)
+		factor     = 1.0
+	)
+
+	result := make([]*Point, order+1)
+	for k, der := range ders {
+		result[k] = MakePoint(der[0]*factor, der[1]*factor, der[2]*factor)
+		factor *= end - start
+	}
+
+	return result
+}
+
+func (n *NURBS) homogeneous() [][]float64 {
+	return bspline.ToHomogeneous(pointsToCoords(n.points), n.weights)
+}
+
+func (n *NURBS) String() string {
+	return fmt.Sprintf("NURBS{degree: %d, points: %v, weights: %v, knots: %v}",
+		n.degree, n.points, n.weights, n.knots.Knots())
+}
+
+func makeNURBSFromHomogeneous(degree int, ctrl [][]float64, knots nums.KnotVector) (*NURBS, error) {
+	coords, weights := bspline.FromHomogeneous(ctrl)
+	return MakeNURBS(degree, coordsToPoints(coords), weights, knots)
+}
+
+func pointsToCoords(points []*Point) [][]float64 {
+	coords := make([][]float64, len(points))
+	for i, point := range points {
+		coords[i] = []float64{point.x, point.y, point.z}
+	}
+
+	return coords
+}
+
+func coordsToPoints(coords [][]float64) []*Point {
+	points := make([]*Point, len(coords))
+	for i, coord := range coords {
+		points[i] = MakePoint(coord[0], coord[1], coord[2])
+	}
+
+	return points
+}
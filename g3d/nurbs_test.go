@@ -0,0 +1,77 @@
+package g3d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNURBSInSpace(t *testing.T) {
+	var (
+		points = []*Point{
+			MakePoint(0, 0, 0),
+			MakePoint(1, 2, 1),
+			MakePoint(3, 2, 2),
+			MakePoint(4, 0, 3),
+			MakePoint(5, 1, 2),
+		}
+		weights  = []float64{1, 0.5, 2, 1, 1}
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 0, 0.5, 1, 1, 1, 1)
+		curve, _ = MakeNURBS(3, points, weights, knots)
+	)
+
+	t.Run("starts and ends at the control points", func(t *testing.T) {
+		assert.True(t, curve.PointAt(nums.MinT).Equals(points[0]))
+		assert.True(t, curve.PointAt(nums.MaxT).Equals(points[4]))
+	})
+
+	t.Run("derivative", func(t *testing.T) {
+		var (
+			h       = 1e-6
+			before  = curve.PointAt(nums.MakeTParam(0.7 - h))
+			after   = curve.PointAt(nums.MakeTParam(0.7 + h))
+			numeric = before.VectorTo(after).Scaled(0.5 / h)
+			got     = curve.DerivativeAt(nums.MakeTParam(0.7), 1)
+		)
+
+		assert.InDelta(t, numeric.X(), got.X(), 1e-5)
+		assert.InDelta(t, numeric.Y(), got.Y(), 1e-5)
+		assert.InDelta(t, numeric.Z(), got.Z(), 1e-5)
+	})
+
+	t.Run("knot insertion and degree elevation keep the shape", func(t *testing.T) {
+		var (
+			inserted, insErr = curve.WithInsertedKnot(0.25, 1)
+			elevated, elvErr = curve.WithElevatedDegree(1)
+		)
+
+		assert.Nil(t, insErr)
+		assert.Nil(t, elvErr)
+
+		for _, tParam := range nums.SubTParamCompleteRangeTimes(10) {
+			want := curve.PointAt(tParam)
+			assert.True(t, want.Equals(inserted.PointAt(tParam)))
+			assert.True(t, want.Equals(elevated.PointAt(tParam)))
+		}
+	})
+}
+
+func TestInterpolateHelix(t *testing.T) {
+	points := make([]*Point, 9)
+	for i := range points {
+		angle := float64(i) * math.Pi / 4
+		points[i] = MakePoint(math.Cos(angle), math.Sin(angle), float64(i)/4)
+	}
+
+	curve, err := InterpolateBSpline(3, points)
+
+	assert.Nil(t, err)
+	assert.True(t, curve.PointAt(nums.MinT).Equals(points[0]))
+	assert.True(t, curve.PointAt(nums.MaxT).Equals(points[8]))
+
+	tangent, err := curve.TangentAt(nums.HalfT)
+	assert.Nil(t, err)
+	assert.True(t, tangent.IsVersor())
+}
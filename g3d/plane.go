@@ -44,7 +44,14 @@ func MakePlane(a, b, c, d float64) (*Plane, error) {
 // direction is given by the passed in vector.
 // Returns a ErrZeroVector if the resulting normal vector has zero length.
 func MakePlaneFromPointAndNormal(p *Point, normal *Vector) (*Plane, error) {
-	if nums.IsCloseToZero(normal.Length()) {
+	return MakePlaneFromPointAndNormalTol(p, normal, nums.DefaultTolerance)
+}
+
+// MakePlaneFromPointAndNormalTol returns a new plane passing through a given point and whose
+// normal direction is given by the passed in vector.
+// Returns a ErrZeroVector if the length of the normal vector is zero within the given tolerance.
+func MakePlaneFromPointAndNormalTol(p *Point, normal *Vector, tol nums.Tolerance) (*Plane, error) {
+	if tol.IsZero(normal.Length()) {
 		return nil, ErrZeroVector
 	}
 
@@ -92,7 +99,13 @@ func (p *Plane) Point() *Point {
 
 // ContainsPoint checks whether the given point is on the plane.
 func (p *Plane) ContainsPoint(pt *Point) bool {
-	return nums.IsCloseToZero(p.EvaluatePoint(pt))
+	return p.ContainsPointTol(pt, nums.DefaultTolerance)
+}
+
+// ContainsPointTol checks whether the given point is on the plane: the result of evaluating the
+// point in the plane's equation is zero within the given tolerance.
+func (p *Plane) ContainsPointTol(pt *Point, tol nums.Tolerance) bool {
+	return tol.IsZero(p.EvaluatePoint(pt))
 }
 
 // EvaluatePoint returns the result of evaluating a point in the plane's ax + by + cz + d equation.
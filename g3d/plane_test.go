@@ -3,6 +3,7 @@ package g3d
 import (
 	"testing"
 
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -77,6 +78,20 @@ func TestPlaneCreationFromPointAndNormal(t *testing.T) {
 		assert.Nil(plane)
 		assert.Equal(ErrZeroVector, err)
 	})
+
+	t.Run("can't create with a normal vector shorter than the tolerance", func(t *testing.T) {
+		var (
+			normal = MakeVector(0, 0, 1e-6)
+			tol    = nums.MakeAbsoluteTolerance(1e-3)
+		)
+
+		_, err := MakePlaneFromPointAndNormal(p, normal)
+		assert.Nil(err)
+
+		plane, err := MakePlaneFromPointAndNormalTol(p, normal, tol)
+		assert.Nil(plane)
+		assert.Equal(ErrZeroVector, err)
+	})
 }
 
 func TestPlanePoint(t *testing.T) {
@@ -154,3 +169,15 @@ func TestPlanePoint(t *testing.T) {
 		assert.True(plane.ContainsPoint(got))
 	})
 }
+
+func TestPlaneContainsPointTol(t *testing.T) {
+	plane, _ := MakePlane(0, 0, 1, -1000)
+	point := MakePoint(5, 5, 1000+1e-6)
+
+	if plane.ContainsPoint(point) {
+		t.Error("Expected point not to be contained with the default tolerance")
+	}
+	if !plane.ContainsPointTol(point, nums.MakeAbsoluteTolerance(1e-3)) {
+		t.Error("Expected point to be contained with a millimetre tolerance")
+	}
+}
@@ -1,6 +1,10 @@
 package g3d
 
-import "math"
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
 
 var (
 	Origin = MakePoint(0, 0, 0)
@@ -61,5 +65,11 @@ func (from *Point) VectorTo(to *Point) *Vector {
 }
 
 func (p *Point) Equals(other *Point) bool {
-	return projectablesEqual(p, other)
+	return p.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol checks whether this and other point have equal X, Y and Z projections within the
+// given tolerance.
+func (p *Point) EqualsTol(other *Point, tol nums.Tolerance) bool {
+	return projectablesEqual(p, other, tol)
 }
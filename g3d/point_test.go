@@ -69,3 +69,18 @@ func TestCreateVectorBetweenPoints(t *testing.T) {
 		t.Errorf("Want point %v, but got %v", want, got)
 	}
 }
+
+func TestPointEqualsTol(t *testing.T) {
+	var (
+		p   = MakePoint(1e-12, 2e-12, 3e-12)
+		q   = MakePoint(2e-12, 2e-12, 3e-12)
+		tol = nums.MakeRelativeTolerance(1e-6)
+	)
+
+	if !p.Equals(q) {
+		t.Error("Points expected to be equal with the default tolerance")
+	}
+	if p.EqualsTol(q, tol) {
+		t.Error("Points expected to not be equal with a relative tolerance")
+	}
+}
@@ -9,8 +9,8 @@ type Projectable interface {
 	Z() float64
 }
 
-func projectablesEqual(a, b Projectable) bool {
-	return nums.FloatsEqual(a.X(), b.X()) &&
-		nums.FloatsEqual(a.Y(), b.Y()) &&
-		nums.FloatsEqual(a.Z(), b.Z())
+func projectablesEqual(a, b Projectable, tol nums.Tolerance) bool {
+	return tol.Equal(a.X(), b.X()) &&
+		tol.Equal(a.Y(), b.Y()) &&
+		tol.Equal(a.Z(), b.Z())
 }
@@ -0,0 +1,155 @@
+package transf
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+// Inputs with fewer points than this are always transformed in the calling goroutine, as the
+// cost of starting the workers would be greater than the gain.
+const minPointsPerWorker = 4096
+
+var (
+	// ErrBufferSize is returned when the destination of a batch transformation doesn't have the
+	// same length as the source.
+	ErrBufferSize = errors.New("the destination must have the same length as the source")
+	// ErrNotTriples is returned when a flat list of coordinates isn't made of xyz triples.
+	ErrNotTriples = errors.New("the number of coordinates must be a multiple of three")
+)
+
+// ApplyToPoints applies the linear transformation to every point in src, storing the results
+// in the same positions of dst. The destination may be the source itself.
+//
+// An ErrBufferSize error is returned if dst and src have different lengths.
+func (transf *Linear) ApplyToPoints(src, dst []g3d.Point) error {
+	return applyToPoints(transf.values, g3d.Zero, src, dst, 1)
+}
+
+// ApplyToPointsParallel is like ApplyToPoints, but splits the work among the given number of
+// goroutines. If workers isn't positive, GOMAXPROCS goroutines are used.
+func (transf *Linear) ApplyToPointsParallel(src, dst []g3d.Point, workers int) error {
+	return applyToPoints(transf.values, g3d.Zero, src, dst, workers)
+}
+
+// ApplyToCoords applies the linear transformation to a flat list of xyz triples in src, storing
+// the results in the same positions of dst. The destination may be the source itself.
+//
+// An ErrNotTriples error is returned if the length of src isn't a multiple of three, and an
+// ErrBufferSize error if dst and src have different lengths.
+func (transf *Linear) ApplyToCoords(src, dst []float64) error {
+	return applyToCoords(transf.values, g3d.Zero, src, dst, 1)
+}
+
+// ApplyToCoordsParallel is like ApplyToCoords, but splits the work among the given number of
+// goroutines. If workers isn't positive, GOMAXPROCS goroutines are used.
+func (transf *Linear) ApplyToCoordsParallel(src, dst []float64, workers int) error {
+	return applyToCoords(transf.values, g3d.Zero, src, dst, workers)
+}
+
+// ApplyToPoints applies the affine transformation to every point in src, storing the results
+// in the same positions of dst. The destination may be the source itself.
+//
+// An ErrBufferSize error is returned if dst and src have different lengths.
+func (transf *Affine) ApplyToPoints(src, dst []g3d.Point) error {
+	return applyToPoints(transf.linear.values, transf.translation, src, dst, 1)
+}
+
+// ApplyToPointsParallel is like ApplyToPoints, but splits the work among the given number of
+// goroutines. If workers isn't positive, GOMAXPROCS goroutines are used.
+func (transf *Affine) ApplyToPointsParallel(src, dst []g3d.Point, workers int) error {
+	return applyToPoints(transf.linear.values, transf.translation, src, dst, workers)
+}
+
+// ApplyToCoords applies the affine transformation to a flat list of xyz triples in src, storing
+// the results in the same positions of dst. The destination may be the source itself.
+//
+// An ErrNotTriples error is returned if the length of src isn't a multiple of three, and an
+// ErrBufferSize error if dst and src have different lengths.
+func (transf *Affine) ApplyToCoords(src, dst []float64) error {
+	return applyToCoords(transf.linear.values, transf.translation, src, dst, 1)
+}
+
+// ApplyToCoordsParallel is like ApplyToCoords, but splits the work among the given number of
+// goroutines. If workers isn't positive, GOMAXPROCS goroutines are used.
+func (transf *Affine) ApplyToCoordsParallel(src, dst []float64, workers int) error {
+	return applyToCoords(transf.linear.values, transf.translation, src, dst, workers)
+}
+
+func applyToPoints(m *g3d.Matrix3x3, translation *g3d.Vector, src, dst []g3d.Point, workers int) error {
+	if len(src) != len(dst) {
+		return ErrBufferSize
+	}
+
+	var (
+		tx, ty, tz = translation.X(), translation.Y(), translation.Z()
+		transform  = func(start, end int) {
+			for i := start; i < end; i++ {
+				x, y, z := m.TimesCoords(src[i].X(), src[i].Y(), src[i].Z())
+				dst[i] = g3d.MakePointVal(x+tx, y+ty, z+tz)
+			}
+		}
+	)
+
+	inChunks(len(src), workers, transform)
+	return nil
+}
+
+func applyToCoords(m *g3d.Matrix3x3, translation *g3d.Vector, src, dst []float64, workers int) error {
+	if len(src)%3 != 0 {
+		return ErrNotTriples
+	}
+	if len(src) != len(dst) {
+		return ErrBufferSize
+	}
+
+	var (
+		tx, ty, tz = translation.X(), translation.Y(), translation.Z()
+		transform  = func(start, end int) {
+			for i := 3 * start; i < 3*end; i += 3 {
+				x, y, z := m.TimesCoords(src[i], src[i+1], src[i+2])
+				dst[i], dst[i+1], dst[i+2] = x+tx, y+ty, z+tz
+			}
+		}
+	)
+
+	inChunks(len(src)/3, workers, transform)
+	return nil
+}
+
+// inChunks calls the function with consecutive ranges of the n points, each in its own
+// goroutine, and waits for all of them to finish.
+func inChunks(n, workers int, f func(start, end int)) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if maxWorkers := n / minPointsPerWorker; workers > maxWorkers {
+		workers = maxWorkers
+	}
+	if workers <= 1 {
+		f(0, n)
+		return
+	}
+
+	var (
+		wg        sync.WaitGroup
+		chunkSize = (n + workers - 1) / workers
+	)
+
+	for start := 0; start < n; start += chunkSize {
+		end := start + chunkSize
+		if end > n {
+			end = n
+		}
+
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			f(start, end)
+		}(start, end)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,148 @@
+package transf
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestPoints(n int) []g3d.Point {
+	points := make([]g3d.Point, n)
+	for i := range points {
+		f := float64(i)
+		points[i] = g3d.MakePointVal(f, 2*f-1, math.Sin(f))
+	}
+
+	return points
+}
+
+func makeTestCoords(n int) []float64 {
+	coords := make([]float64, 0, 3*n)
+	for _, p := range makeTestPoints(n) {
+		coords = append(coords, p.X(), p.Y(), p.Z())
+	}
+
+	return coords
+}
+
+func TestBatchTransformation(t *testing.T) {
+	var (
+		rotation = MakeRotationAround(math.Pi/3, g3d.KVersor, g3d.MakePoint(1, 2, 3))
+		scaling  = MakeScaling(2, 3, 4)
+	)
+
+	t.Run("affine points match single application", func(t *testing.T) {
+		var (
+			src = makeTestPoints(10)
+			dst = make([]g3d.Point, len(src))
+		)
+
+		assert.Nil(t, rotation.ApplyToPoints(src, dst))
+		for i := range src {
+			want := rotation.Apply(&src[i]).ToPoint()
+			assert.True(t, dst[i].Equals(want), "point %d: want %v, got %v", i, want, dst[i])
+		}
+	})
+
+	t.Run("linear coordinates match single application", func(t *testing.T) {
+		var (
+			src = makeTestCoords(10)
+			dst = make([]float64, len(src))
+		)
+
+		assert.Nil(t, scaling.ApplyToCoords(src, dst))
+		for i := 0; i < len(src); i += 3 {
+			want := scaling.Apply(g3d.MakeVector(src[i], src[i+1], src[i+2]))
+			got := g3d.MakeVector(dst[i], dst[i+1], dst[i+2])
+			assert.True(t, got.Equals(want), "triple %d: want %v, got %v", i/3, want, got)
+		}
+	})
+
+	t.Run("in place", func(t *testing.T) {
+		coords := []float64{1, 2, 3}
+
+		assert.Nil(t, MakeTranslation(1, 1, 1).ApplyToCoords(coords, coords))
+		assert.Equal(t, []float64{2, 3, 4}, coords)
+	})
+
+	t.Run("parallel results match sequential", func(t *testing.T) {
+		var (
+			n          = 5 * minPointsPerWorker
+			src        = makeTestCoords(n)
+			sequential = make([]float64, len(src))
+			parallel   = make([]float64, len(src))
+		)
+
+		assert.Nil(t, rotation.ApplyToCoords(src, sequential))
+		assert.Nil(t, rotation.ApplyToCoordsParallel(src, parallel, 4))
+		assert.Equal(t, sequential, parallel)
+
+		var (
+			points         = makeTestPoints(n)
+			pointsParallel = make([]g3d.Point, n)
+			pointsSeq      = make([]g3d.Point, n)
+		)
+
+		assert.Nil(t, scaling.ApplyToPoints(points, pointsSeq))
+		assert.Nil(t, scaling.ApplyToPointsParallel(points, pointsParallel, 0))
+		assert.Equal(t, pointsSeq, pointsParallel)
+	})
+
+	t.Run("buffer size mismatch", func(t *testing.T) {
+		assert.Equal(t, ErrBufferSize, rotation.ApplyToPoints(makeTestPoints(3), make([]g3d.Point, 2)))
+		assert.Equal(t, ErrBufferSize, scaling.ApplyToCoords(makeTestCoords(3), make([]float64, 6)))
+	})
+
+	t.Run("coordinates not in triples", func(t *testing.T) {
+		assert.Equal(t, ErrNotTriples, rotation.ApplyToCoords(make([]float64, 4), make([]float64, 4)))
+	})
+}
+
+var benchVector *g3d.Vector
+
+func BenchmarkAffineTransformation(b *testing.B) {
+	var (
+		rotation = MakeRotationAround(math.Pi/3, g3d.KVersor, g3d.MakePoint(1, 2, 3))
+		n        = 100_000
+		points   = makeTestPoints(n)
+		coords   = makeTestCoords(n)
+	)
+
+	b.Run("single application", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j := range points {
+				benchVector = rotation.Apply(&points[j])
+			}
+		}
+	})
+
+	b.Run("points", func(b *testing.B) {
+		dst := make([]g3d.Point, n)
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = rotation.ApplyToPoints(points, dst)
+		}
+	})
+
+	b.Run("coordinates", func(b *testing.B) {
+		dst := make([]float64, len(coords))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = rotation.ApplyToCoords(coords, dst)
+		}
+	})
+
+	b.Run("coordinates in parallel", func(b *testing.B) {
+		dst := make([]float64, len(coords))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = rotation.ApplyToCoordsParallel(coords, dst, 0)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package g3d
+
+import "github.com/angelsolaorbaiceta/inkgeom/nums"
+
+// The operations on points and vectors return newly allocated results, which is convenient but
+// slow when processing large numbers of them. The functions in this file work with values and
+// caller-owned destinations instead, so that hot loops don't allocate:
+//
+//	var (
+//		p     = MakePointVal(1, 2, 3)
+//		dir   = MakeVectorVal(0, 0, 1)
+//		moved Point
+//	)
+//
+//	p.DisplacedInto(&dir, 2.5, &moved)
+//
+// The destination of the "Into" operations may be the receiver or one of the operands. It's the
+// only value these operations change, so it should never be a shared instance, like the versors
+// defined in this package.
+
+// MakePointVal creates a new point value given its X, Y and Z coordinates.
+func MakePointVal(x, y, z float64) Point {
+	return Point{x, y, z}
+}
+
+// MakeVectorVal creates a new vector value given its X, Y and Z projections.
+func MakeVectorVal(x, y, z float64) Vector {
+	return Vector{x, y, z}
+}
+
+// VectorToInto stores the vector from this point to the other in dst, and returns it.
+func (from *Point) VectorToInto(to *Point, dst *Vector) *Vector {
+	dst.x, dst.y, dst.z = to.x-from.x, to.y-from.y, to.z-from.z
+	return dst
+}
+
+// DisplacedInto stores the point resulting from displacing this one by the vector scaled the
+// given number of times in dst, and returns it.
+func (p *Point) DisplacedInto(vector *Vector, times float64, dst *Point) *Point {
+	dst.x, dst.y, dst.z = p.x+vector.x*times, p.y+vector.y*times, p.z+vector.z*times
+	return dst
+}
+
+// PlusInto stores the sum of this and the other vector in dst, and returns it.
+func (v *Vector) PlusInto(other, dst *Vector) *Vector {
+	dst.x, dst.y, dst.z = v.x+other.x, v.y+other.y, v.z+other.z
+	return dst
+}
+
+// MinusInto stores the difference between this and the other vector in dst, and returns it.
+func (v *Vector) MinusInto(other, dst *Vector) *Vector {
+	dst.x, dst.y, dst.z = v.x-other.x, v.y-other.y, v.z-other.z
+	return dst
+}
+
+// ScaledInto stores the vector with the projections scaled by the given factor in dst, and
+// returns it.
+func (v *Vector) ScaledInto(factor float64, dst *Vector) *Vector {
+	dst.x, dst.y, dst.z = v.x*factor, v.y*factor, v.z*factor
+	return dst
+}
+
+// CrossTimesInto stores the cross product of this vector with the other in dst, and returns it.
+func (v *Vector) CrossTimesInto(other, dst *Vector) *Vector {
+	dst.x, dst.y, dst.z =
+		v.y*other.z-v.z*other.y,
+		v.z*other.x-v.x*other.z,
+		v.x*other.y-v.y*other.x
+	return dst
+}
+
+// ToVersorInto stores the versor with the same direction as this vector in dst, and returns it.
+// Returns an ErrZeroVersor error if all three projections are zero, leaving dst unchanged.
+func (v *Vector) ToVersorInto(dst *Vector) (*Vector, error) {
+	return v.ToVersorIntoTol(dst, nums.DefaultTolerance)
+}
+
+// ToVersorIntoTol stores the versor with the same direction as this vector in dst, and returns
+// it. Returns an ErrZeroVersor error if the length of the vector is zero within the given
+// tolerance, leaving dst unchanged.
+func (v *Vector) ToVersorIntoTol(dst *Vector, tol nums.Tolerance) (*Vector, error) {
+	length := computeLength(v.x, v.y, v.z)
+	if tol.IsZero(length) {
+		return dst, ErrZeroVersor
+	}
+
+	dst.x, dst.y, dst.z = v.x/length, v.y/length, v.z/length
+	return dst, nil
+}
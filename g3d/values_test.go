@@ -0,0 +1,123 @@
+package g3d
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestValueOperations(t *testing.T) {
+	var (
+		p = MakePointVal(1, 2, 3)
+		q = MakePointVal(2, 4, 6)
+		v Vector
+	)
+
+	t.Run("vector to and displaced", func(t *testing.T) {
+		var moved Point
+
+		p.VectorToInto(&q, &v)
+		if !v.Equals(MakeVector(1, 2, 3)) {
+			t.Errorf("Want {1, 2, 3}, got %v", v)
+		}
+		if got := p.DisplacedInto(&v, 2, &moved); !got.Equals(MakePoint(3, 6, 9)) {
+			t.Errorf("Want (3, 6, 9), got %v", got)
+		}
+	})
+
+	t.Run("cross product aliasing an operand", func(t *testing.T) {
+		var (
+			i = MakeVectorVal(1, 0, 0)
+			j = MakeVectorVal(0, 1, 0)
+		)
+
+		i.CrossTimesInto(&j, &i)
+		if !i.Equals(KVersor) {
+			t.Errorf("Want %v, got %v", KVersor, i)
+		}
+	})
+
+	t.Run("versor", func(t *testing.T) {
+		var (
+			u   = MakeVectorVal(0, 3, 4)
+			dst Vector
+		)
+
+		if got, err := u.ToVersorInto(&dst); err != nil || !got.Equals(MakeVector(0, 0.6, 0.8)) {
+			t.Errorf("Want {0, 0.6, 0.8}, got %v (%v)", got, err)
+		}
+
+		zero := MakeVectorVal(0, 0, 0)
+		if _, err := zero.ToVersorInto(&dst); err != ErrZeroVersor {
+			t.Errorf("Want ErrZeroVersor, got %v", err)
+		}
+
+		short := MakeVectorVal(0, 3e-12, 4e-12)
+		if _, err := short.ToVersorIntoTol(&dst, nums.MakeAbsoluteTolerance(1e-15)); err != nil {
+			t.Errorf("Want a versor with a smaller tolerance, got %v", err)
+		}
+	})
+
+	t.Run("no allocations", func(t *testing.T) {
+		var (
+			a, b = MakeVectorVal(1, 2, 3), MakeVectorVal(4, 5, 6)
+			dst  Vector
+		)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			a.PlusInto(&b, &dst).CrossTimesInto(&a, &dst).ScaledInto(2, &dst).MinusInto(&b, &dst)
+		})
+		if allocs != 0 {
+			t.Errorf("Want no allocations, got %f", allocs)
+		}
+	})
+}
+
+var (
+	benchVector *Vector
+	benchPoint  *Point
+)
+
+func BenchmarkVectorOperations(b *testing.B) {
+	var (
+		u = MakeVector(1, 2, 3)
+		v = MakeVector(4, 5, 6)
+	)
+
+	b.Run("pointers", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchVector = u.Plus(v).CrossTimes(u).Scaled(2)
+		}
+	})
+
+	b.Run("into destination", func(b *testing.B) {
+		b.ReportAllocs()
+		var dst Vector
+		for i := 0; i < b.N; i++ {
+			benchVector = u.PlusInto(v, &dst).CrossTimesInto(u, &dst).ScaledInto(2, &dst)
+		}
+	})
+}
+
+func BenchmarkPointDisplacement(b *testing.B) {
+	var (
+		p = MakePoint(1, 2, 3)
+		v = MakeVector(0, 0, 1)
+	)
+
+	b.Run("pointers", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchPoint = p.Displaced(v, 2)
+		}
+	})
+
+	b.Run("into destination", func(b *testing.B) {
+		b.ReportAllocs()
+		var dst Point
+		for i := 0; i < b.N; i++ {
+			benchPoint = p.DisplacedInto(v, 2, &dst)
+		}
+	})
+}
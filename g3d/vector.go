@@ -48,9 +48,15 @@ func MakeNonZeroVector(x, y, z float64) (*Vector, error) {
 // MakeVersor creates a versor (a vector of unit length) given the vector components X, Y and Z.
 // Returns an error if all three components are zero, as the zero vector can't be normalized.
 func MakeVersor(x, y, z float64) (*Vector, error) {
+	return MakeVersorTol(x, y, z, nums.DefaultTolerance)
+}
+
+// MakeVersorTol creates a versor given the vector components X, Y and Z.
+// Returns an error if the length of the vector is zero within the given tolerance.
+func MakeVersorTol(x, y, z float64, tol nums.Tolerance) (*Vector, error) {
 	length := computeLength(x, y, z)
 
-	if nums.IsCloseToZero(length) {
+	if tol.IsZero(length) {
 		return nil, ErrZeroVersor
 	}
 
@@ -79,22 +85,40 @@ func (v *Vector) Length() float64 {
 
 // IsVersor evaluates to true if the vector has a length of 1.
 func (v *Vector) IsVersor() bool {
-	return nums.IsCloseToOne(v.Length())
+	return v.IsVersorTol(nums.DefaultTolerance)
+}
+
+// IsVersorTol evaluates to true if the vector has a length of 1 within the given tolerance.
+func (v *Vector) IsVersorTol(tol nums.Tolerance) bool {
+	return tol.IsOne(v.Length())
 }
 
 // IsZero returns true if all X, Y and Z componets of this vector are zero.
 func (v *Vector) IsZero() bool {
-	return nums.IsCloseToZero(v.x) && nums.IsCloseToZero(v.y) && nums.IsCloseToZero(v.z)
+	return v.IsZeroTol(nums.DefaultTolerance)
+}
+
+// IsZeroTol returns true if all X, Y and Z components of this vector are zero within the given
+// tolerance.
+func (v *Vector) IsZeroTol(tol nums.Tolerance) bool {
+	return tol.IsZero(v.x) && tol.IsZero(v.y) && tol.IsZero(v.z)
 }
 
 // ToVersor returns a versor with the same direction as this vector.
 // Returns an error if all three components are zero, as the zero vector can't be normalized.
 func (v *Vector) ToVersor() (*Vector, error) {
-	if v.IsVersor() {
+	return v.ToVersorTol(nums.DefaultTolerance)
+}
+
+// ToVersorTol returns a versor with the same direction as this vector, or this vector if its
+// length is one within the given tolerance.
+// Returns an error if the length of the vector is zero within the given tolerance.
+func (v *Vector) ToVersorTol(tol nums.Tolerance) (*Vector, error) {
+	if v.IsVersorTol(tol) {
 		return v, nil
 	}
 
-	return MakeVersor(v.x, v.y, v.z)
+	return MakeVersorTol(v.x, v.y, v.z, tol)
 }
 
 // ToPoint returns a point with the same X, Y and Z projections as this vector.
@@ -127,19 +151,54 @@ func (v *Vector) Squared() *Matrix3x3 {
 	)
 }
 
-// IsParalleTo checks whether this and other vectors have the same direction (are parallel).
+// IsParallelTo checks whether this and other vectors have the same direction (are parallel).
 func (v *Vector) IsParallelTo(other *Vector) bool {
-	return v.CrossTimes(other).IsZero()
+	return v.IsParallelToTol(other, nums.DefaultTolerance)
+}
+
+// IsParallelToTol checks whether this and other vectors have the same direction (are parallel),
+// that is, their cross product is zero within the given tolerance.
+func (v *Vector) IsParallelToTol(other *Vector, tol nums.Tolerance) bool {
+	return v.CrossTimes(other).IsZeroTol(tol)
+}
+
+// IsParallelToExact checks whether this and other vectors have the same direction (are
+// parallel), without rounding errors.
+//
+// The vectors are parallel only if all the projections of their cross product are exactly zero.
+// Vectors which are parallel but have been computed with rounding errors (for example,
+// normalized) may not be considered parallel.
+func (v *Vector) IsParallelToExact(other *Vector) bool {
+	var (
+		origin = [2]float64{0, 0}
+		crossX = nums.Orient2D([2]float64{v.y, v.z}, [2]float64{other.y, other.z}, origin)
+		crossY = nums.Orient2D([2]float64{v.z, v.x}, [2]float64{other.z, other.x}, origin)
+		crossZ = nums.Orient2D([2]float64{v.x, v.y}, [2]float64{other.x, other.y}, origin)
+	)
+
+	return crossX == 0 && crossY == 0 && crossZ == 0
 }
 
 // IsPerpendicularTo checks whether this and other vectors have perpendicular directions.
 func (v *Vector) IsPerpendicularTo(other *Vector) bool {
-	return nums.IsCloseToZero(v.DotTimes(other))
+	return v.IsPerpendicularToTol(other, nums.DefaultTolerance)
+}
+
+// IsPerpendicularToTol checks whether this and other vectors have perpendicular directions,
+// that is, their dot product is zero within the given tolerance.
+func (v *Vector) IsPerpendicularToTol(other *Vector, tol nums.Tolerance) bool {
+	return tol.IsZero(v.DotTimes(other))
 }
 
 // Equals checks whether this and other vector have equal X, Y and Z projections.
 func (v *Vector) Equals(other *Vector) bool {
-	return projectablesEqual(v, other)
+	return v.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol checks whether this and other vector have equal X, Y and Z projections within the
+// given tolerance.
+func (v *Vector) EqualsTol(other *Vector, tol nums.Tolerance) bool {
+	return projectablesEqual(v, other, tol)
 }
 
 func computeLength(x, y, z float64) float64 {
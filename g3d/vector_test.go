@@ -82,6 +82,17 @@ func TestVersor(t *testing.T) {
 		assert.Nil(v)
 		assert.NotNil(err)
 	})
+
+	t.Run("Can be created from a short vector with a smaller tolerance", func(t *testing.T) {
+		short := MakeVector(1e-12, 0, 0)
+
+		_, err := short.ToVersor()
+		assert.Equal(ErrZeroVersor, err)
+
+		versor, err := short.ToVersorTol(nums.MakeAbsoluteTolerance(1e-15))
+		assert.Nil(err)
+		assert.True(versor.Equals(IVersor))
+	})
 }
 
 func TestVectorOpposite(t *testing.T) {
@@ -123,6 +134,43 @@ func TestVectorParallelism(t *testing.T) {
 
 		assert.False(u.IsParallelTo(v))
 	})
+
+	t.Run("parallel to its versor", func(t *testing.T) {
+		var (
+			u    = MakeVector(1, 3, 7)
+			v, _ = u.ToVersor()
+		)
+
+		assert.True(v.IsParallelTo(u))
+	})
+
+	t.Run("nearly parallel within a tolerance", func(t *testing.T) {
+		var (
+			u   = MakeVector(1, 2, 3)
+			v   = MakeVector(1, 2, 3.001)
+			tol = nums.MakeAbsoluteTolerance(0.01)
+		)
+
+		assert.False(u.IsParallelTo(v))
+		assert.True(u.IsParallelToTol(v, tol))
+	})
+}
+
+func TestVectorExactParallelism(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("parallel vectors", func(t *testing.T) {
+		assert.True(MakeVector(1, 2, 3).IsParallelToExact(MakeVector(2, 4, 6)))
+	})
+
+	t.Run("nearly parallel tiny vectors", func(t *testing.T) {
+		var (
+			u = MakeVector(1e-9, 2e-9, 3e-9)
+			v = MakeVector(1e-9, 2e-9, 3.1e-9)
+		)
+
+		assert.False(u.IsParallelToExact(v))
+	})
 }
 
 func TestVectorPerpendicularity(t *testing.T) {
@@ -0,0 +1,23 @@
+// Package generic has versions of the 2D and 3D points and vectors, and of the 3x3 matrix,
+// parameterized on the floating-point type of their coordinates: float32 or float64.
+//
+// Unlike the g2d and g3d primitives, these are small value types, so they can be stored
+// contiguously in slices and flattened into the buffers expected by GPUs and web viewers.
+// Functions to convert from and to the g2d and g3d primitives are provided, as well as between
+// precisions.
+package generic
+
+import (
+	"errors"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// ErrZeroVector results from an operation that requires a vector with a non-zero length.
+var ErrZeroVector = errors.New("can't use a vector with zero length")
+
+// sqrt computes the square root in float64 precision, and converts it back to T.
+func sqrt[T nums.Float](value T) T {
+	return T(math.Sqrt(float64(value)))
+}
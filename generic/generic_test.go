@@ -0,0 +1,12 @@
+package generic
+
+import "testing"
+
+func TestSqrt(t *testing.T) {
+	if got := sqrt[float32](2.25); got != 1.5 {
+		t.Errorf("Want 1.5, got %f", got)
+	}
+	if got := sqrt(16.0); got != 4 {
+		t.Errorf("Want 4, got %f", got)
+	}
+}
@@ -0,0 +1,141 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Matrix3x3 is a square matrix of size three with values of type T, stored in row-major order.
+type Matrix3x3[T nums.Float] struct {
+	values [9]T
+}
+
+// MakeMatrix3x3 creates a new matrix with the given values, in row-major order:
+//
+//	⌈ a  b  c ⌉
+//	| d  e  f |
+//	⌊ g  h  i ⌋
+func MakeMatrix3x3[T nums.Float](a, b, c, d, e, f, g, h, i T) Matrix3x3[T] {
+	return Matrix3x3[T]{[9]T{a, b, c, d, e, f, g, h, i}}
+}
+
+// MakeIdentity3x3 returns the identity matrix.
+func MakeIdentity3x3[T nums.Float]() Matrix3x3[T] {
+	return MakeMatrix3x3[T](1, 0, 0, 0, 1, 0, 0, 0, 1)
+}
+
+// Matrix3x3FromG3D creates a matrix with the values of the g3d matrix, converted to T.
+func Matrix3x3FromG3D[T nums.Float](m *g3d.Matrix3x3) Matrix3x3[T] {
+	var result Matrix3x3[T]
+
+	// Each column of the matrix is the result of multiplying it by a unit vector.
+	for col := 0; col < 3; col++ {
+		var unit [3]float64
+		unit[col] = 1
+
+		x, y, z := m.TimesCoords(unit[0], unit[1], unit[2])
+		result.values[col], result.values[3+col], result.values[6+col] = T(x), T(y), T(z)
+	}
+
+	return result
+}
+
+// ConvertMatrix3x3 converts the values of the matrix to the type U.
+func ConvertMatrix3x3[U, T nums.Float](m Matrix3x3[T]) Matrix3x3[U] {
+	var result Matrix3x3[U]
+	for i, value := range m.values {
+		result.values[i] = U(value)
+	}
+
+	return result
+}
+
+// At returns the value at the given row and column, both in [0, 3).
+func (m Matrix3x3[T]) At(row, col int) T {
+	return m.values[3*row+col]
+}
+
+// Values returns the values of the matrix in row-major order.
+func (m Matrix3x3[T]) Values() [9]T {
+	return m.values
+}
+
+// ToG3D returns the g3d matrix with the same values.
+func (m Matrix3x3[T]) ToG3D() *g3d.Matrix3x3 {
+	v := m.values
+	return g3d.Make3x3Matrix(
+		float64(v[0]), float64(v[1]), float64(v[2]),
+		float64(v[3]), float64(v[4]), float64(v[5]),
+		float64(v[6]), float64(v[7]), float64(v[8]),
+	)
+}
+
+// Transposed returns the matrix with its rows and columns swapped.
+func (m Matrix3x3[T]) Transposed() Matrix3x3[T] {
+	v := m.values
+	return MakeMatrix3x3(v[0], v[3], v[6], v[1], v[4], v[7], v[2], v[5], v[8])
+}
+
+// Times returns the product of this matrix by the other.
+func (m Matrix3x3[T]) Times(other Matrix3x3[T]) Matrix3x3[T] {
+	var result Matrix3x3[T]
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			var sum T
+			for k := 0; k < 3; k++ {
+				sum += m.values[3*row+k] * other.values[3*k+col]
+			}
+			result.values[3*row+col] = sum
+		}
+	}
+
+	return result
+}
+
+// TimesVector returns the product of the matrix by the vector.
+func (m Matrix3x3[T]) TimesVector(v Vector3[T]) Vector3[T] {
+	a := m.values
+	return Vector3[T]{
+		a[0]*v.x + a[1]*v.y + a[2]*v.z,
+		a[3]*v.x + a[4]*v.y + a[5]*v.z,
+		a[6]*v.x + a[7]*v.y + a[8]*v.z,
+	}
+}
+
+// Det computes the determinant of the matrix.
+func (m Matrix3x3[T]) Det() T {
+	a := m.values
+	return a[0]*(a[4]*a[8]-a[5]*a[7]) -
+		a[1]*(a[3]*a[8]-a[5]*a[6]) +
+		a[2]*(a[3]*a[7]-a[4]*a[6])
+}
+
+// Equals returns true if the values of both matrices are equal within the default epsilon of
+// type T.
+func (m Matrix3x3[T]) Equals(other Matrix3x3[T]) bool {
+	for i, value := range m.values {
+		if !nums.Equal(value, other.values[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualsTol returns true if the values of both matrices are equal within the tolerance.
+func (m Matrix3x3[T]) EqualsTol(other Matrix3x3[T], tol nums.Tolerance) bool {
+	for i, value := range m.values {
+		if !nums.EqualTol(value, other.values[i], tol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m Matrix3x3[T]) String() string {
+	v := m.values
+	return fmt.Sprintf("[%f %f %f; %f %f %f; %f %f %f]", v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7], v[8])
+}
@@ -0,0 +1,62 @@
+package generic
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/g3d/transf"
+)
+
+func TestMatrix3x3(t *testing.T) {
+	m := MakeMatrix3x3[float32](
+		2, 0, 1,
+		1, 3, 0,
+		0, 1, 4,
+	)
+
+	t.Run("access", func(t *testing.T) {
+		if got := m.At(1, 0); got != 1 {
+			t.Errorf("Want 1, got %f", got)
+		}
+		if got := m.Transposed().At(0, 1); got != 1 {
+			t.Errorf("Want 1, got %f", got)
+		}
+	})
+
+	t.Run("products", func(t *testing.T) {
+		if got := m.Times(MakeIdentity3x3[float32]()); !got.Equals(m) {
+			t.Errorf("Want %v, got %v", m, got)
+		}
+		if got := m.TimesVector(MakeVector3[float32](1, 1, 1)); !got.Equals(MakeVector3[float32](3, 4, 5)) {
+			t.Errorf("Want {3, 4, 5}, got %v", got)
+		}
+	})
+
+	t.Run("determinant", func(t *testing.T) {
+		if got := m.Det(); got != 25 {
+			t.Errorf("Want 25, got %f", got)
+		}
+	})
+
+	t.Run("g3d conversions", func(t *testing.T) {
+		var (
+			rotation = transf.MakeRotation(math.Pi/2, g3d.KVersor)
+			original = rotation.Apply(g3d.IVersor)
+			matrix   = Matrix3x3FromG3D[float64](g3d.Make3x3Matrix(0, -1, 0, 1, 0, 0, 0, 0, 1))
+		)
+
+		if got := matrix.TimesVector(MakeVector3(1.0, 0.0, 0.0)); !got.ToG3D().Equals(original) {
+			t.Errorf("Want %v, got %v", original, got)
+		}
+		if got := Matrix3x3FromG3D[float32](m.ToG3D()); !got.Equals(m) {
+			t.Errorf("Want %v, got %v", m, got)
+		}
+	})
+
+	t.Run("precision conversion", func(t *testing.T) {
+		if got := ConvertMatrix3x3[float32](ConvertMatrix3x3[float64](m)); !got.EqualsTol(m, tol32) {
+			t.Errorf("Want %v, got %v", m, got)
+		}
+	})
+}
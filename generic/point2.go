@@ -0,0 +1,84 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Point2 is a position in the plane with coordinates of type T.
+type Point2[T nums.Float] struct {
+	x, y T
+}
+
+// MakePoint2 creates a new point with the given coordinates.
+func MakePoint2[T nums.Float](x, y T) Point2[T] {
+	return Point2[T]{x, y}
+}
+
+// Point2FromG2D creates a point with the coordinates of the g2d point, converted to T.
+func Point2FromG2D[T nums.Float](p *g2d.Point) Point2[T] {
+	return Point2[T]{T(p.X()), T(p.Y())}
+}
+
+// ConvertPoint2 converts the coordinates of the point to the type U.
+func ConvertPoint2[U, T nums.Float](p Point2[T]) Point2[U] {
+	return Point2[U]{U(p.x), U(p.y)}
+}
+
+// FlattenPoints2 appends the coordinates of the points to the buffer, as consecutive xy pairs,
+// and returns the extended buffer.
+func FlattenPoints2[T nums.Float](buffer []T, points []Point2[T]) []T {
+	for _, p := range points {
+		buffer = append(buffer, p.x, p.y)
+	}
+
+	return buffer
+}
+
+// X is the point's X coordinate.
+func (p Point2[T]) X() T {
+	return p.x
+}
+
+// Y is the point's Y coordinate.
+func (p Point2[T]) Y() T {
+	return p.y
+}
+
+// ToG2D returns the g2d point with the same coordinates.
+func (p Point2[T]) ToG2D() *g2d.Point {
+	return g2d.MakePoint(float64(p.x), float64(p.y))
+}
+
+// DistanceTo computes the distance between this and the other point.
+func (p Point2[T]) DistanceTo(other Point2[T]) T {
+	return p.VectorTo(other).Length()
+}
+
+// VectorTo returns the vector from this point to the other.
+func (from Point2[T]) VectorTo(to Point2[T]) Vector2[T] {
+	return Vector2[T]{to.x - from.x, to.y - from.y}
+}
+
+// Displaced returns the result of displacing this point by the vector the given number of
+// times.
+func (p Point2[T]) Displaced(vector Vector2[T], times T) Point2[T] {
+	return Point2[T]{p.x + vector.x*times, p.y + vector.y*times}
+}
+
+// Equals returns true if the coordinates of both points are equal within the default epsilon
+// of type T.
+func (p Point2[T]) Equals(other Point2[T]) bool {
+	return nums.Equal(p.x, other.x) && nums.Equal(p.y, other.y)
+}
+
+// EqualsTol returns true if the coordinates of both points are equal within the tolerance.
+func (p Point2[T]) EqualsTol(other Point2[T], tol nums.Tolerance) bool {
+	return nums.EqualTol(p.x, other.x, tol) && nums.EqualTol(p.y, other.y, tol)
+}
+
+func (p Point2[T]) String() string {
+	return fmt.Sprintf("(%f, %f)", p.x, p.y)
+}
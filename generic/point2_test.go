@@ -0,0 +1,46 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+var tol32 = nums.MakeAbsoluteTolerance(1e-6)
+
+func TestPoint2(t *testing.T) {
+	var (
+		p = MakePoint2[float32](1, 2)
+		q = MakePoint2[float32](4, 6)
+	)
+
+	t.Run("distance and vector", func(t *testing.T) {
+		if got := p.DistanceTo(q); got != 5 {
+			t.Errorf("Want 5, got %f", got)
+		}
+		if got := p.Displaced(p.VectorTo(q), 2); !got.Equals(MakePoint2[float32](7, 10)) {
+			t.Errorf("Want (7, 10), got %v", got)
+		}
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		g := g2d.MakePoint(1.5, -2.25)
+		if got := Point2FromG2D[float32](g).ToG2D(); !got.Equals(g) {
+			t.Errorf("Want %v, got %v", g, got)
+		}
+		if got := ConvertPoint2[float32](MakePoint2(1.5, -2.25)); !got.EqualsTol(MakePoint2[float32](1.5, -2.25), tol32) {
+			t.Errorf("Want (1.5, -2.25), got %v", got)
+		}
+	})
+
+	t.Run("flatten", func(t *testing.T) {
+		got := FlattenPoints2(nil, []Point2[float32]{p, q})
+		want := []float32{1, 2, 4, 6}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Want %v, got %v", want, got)
+			}
+		}
+	})
+}
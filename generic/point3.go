@@ -0,0 +1,91 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Point3 is a position in space with coordinates of type T.
+type Point3[T nums.Float] struct {
+	x, y, z T
+}
+
+// MakePoint3 creates a new point with the given coordinates.
+func MakePoint3[T nums.Float](x, y, z T) Point3[T] {
+	return Point3[T]{x, y, z}
+}
+
+// Point3FromG3D creates a point with the coordinates of the g3d point, converted to T.
+func Point3FromG3D[T nums.Float](p *g3d.Point) Point3[T] {
+	return Point3[T]{T(p.X()), T(p.Y()), T(p.Z())}
+}
+
+// ConvertPoint3 converts the coordinates of the point to the type U.
+func ConvertPoint3[U, T nums.Float](p Point3[T]) Point3[U] {
+	return Point3[U]{U(p.x), U(p.y), U(p.z)}
+}
+
+// FlattenPoints3 appends the coordinates of the points to the buffer, as consecutive xyz
+// triples, and returns the extended buffer.
+func FlattenPoints3[T nums.Float](buffer []T, points []Point3[T]) []T {
+	for _, p := range points {
+		buffer = append(buffer, p.x, p.y, p.z)
+	}
+
+	return buffer
+}
+
+// X is the point's X coordinate.
+func (p Point3[T]) X() T {
+	return p.x
+}
+
+// Y is the point's Y coordinate.
+func (p Point3[T]) Y() T {
+	return p.y
+}
+
+// Z is the point's Z coordinate.
+func (p Point3[T]) Z() T {
+	return p.z
+}
+
+// ToG3D returns the g3d point with the same coordinates.
+func (p Point3[T]) ToG3D() *g3d.Point {
+	return g3d.MakePoint(float64(p.x), float64(p.y), float64(p.z))
+}
+
+// DistanceTo computes the distance between this and the other point.
+func (p Point3[T]) DistanceTo(other Point3[T]) T {
+	return p.VectorTo(other).Length()
+}
+
+// VectorTo returns the vector from this point to the other.
+func (from Point3[T]) VectorTo(to Point3[T]) Vector3[T] {
+	return Vector3[T]{to.x - from.x, to.y - from.y, to.z - from.z}
+}
+
+// Displaced returns the result of displacing this point by the vector the given number of
+// times.
+func (p Point3[T]) Displaced(vector Vector3[T], times T) Point3[T] {
+	return Point3[T]{p.x + vector.x*times, p.y + vector.y*times, p.z + vector.z*times}
+}
+
+// Equals returns true if the coordinates of both points are equal within the default epsilon
+// of type T.
+func (p Point3[T]) Equals(other Point3[T]) bool {
+	return nums.Equal(p.x, other.x) && nums.Equal(p.y, other.y) && nums.Equal(p.z, other.z)
+}
+
+// EqualsTol returns true if the coordinates of both points are equal within the tolerance.
+func (p Point3[T]) EqualsTol(other Point3[T], tol nums.Tolerance) bool {
+	return nums.EqualTol(p.x, other.x, tol) &&
+		nums.EqualTol(p.y, other.y, tol) &&
+		nums.EqualTol(p.z, other.z, tol)
+}
+
+func (p Point3[T]) String() string {
+	return fmt.Sprintf("(%f, %f, %f)", p.x, p.y, p.z)
+}
@@ -0,0 +1,50 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestPoint3(t *testing.T) {
+	var (
+		p = MakePoint3[float32](1, 2, 3)
+		q = MakePoint3[float32](3, 5, 9)
+	)
+
+	t.Run("distance and vector", func(t *testing.T) {
+		if got := p.DistanceTo(q); got != 7 {
+			t.Errorf("Want 7, got %f", got)
+		}
+		if got := p.Displaced(p.VectorTo(q), 1); !got.Equals(q) {
+			t.Errorf("Want %v, got %v", q, got)
+		}
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		g := g3d.MakePoint(1, 2, 3)
+		if got := Point3FromG3D[float32](g); !got.Equals(p) {
+			t.Errorf("Want %v, got %v", p, got)
+		}
+		if got := ConvertPoint3[float64](p).ToG3D(); !got.Equals(g) {
+			t.Errorf("Want %v, got %v", g, got)
+		}
+	})
+
+	t.Run("flatten", func(t *testing.T) {
+		var (
+			buffer = make([]float32, 0, 6)
+			got    = FlattenPoints3(buffer, []Point3[float32]{p, q})
+			want   = []float32{1, 2, 3, 3, 5, 9}
+		)
+
+		if len(got) != len(want) {
+			t.Fatalf("Want %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("Want %v, got %v", want, got)
+			}
+		}
+	})
+}
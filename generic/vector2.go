@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Vector2 is a direction in the plane with projections of type T in the X and Y axes.
+type Vector2[T nums.Float] struct {
+	x, y T
+}
+
+// MakeVector2 creates a new vector with the given projections.
+func MakeVector2[T nums.Float](x, y T) Vector2[T] {
+	return Vector2[T]{x, y}
+}
+
+// Vector2FromG2D creates a vector with the projections of the g2d vector, converted to T.
+func Vector2FromG2D[T nums.Float](v *g2d.Vector) Vector2[T] {
+	return Vector2[T]{T(v.X()), T(v.Y())}
+}
+
+// ConvertVector2 converts the projections of the vector to the type U.
+func ConvertVector2[U, T nums.Float](v Vector2[T]) Vector2[U] {
+	return Vector2[U]{U(v.x), U(v.y)}
+}
+
+// X is the vector's projection in the X axis.
+func (v Vector2[T]) X() T {
+	return v.x
+}
+
+// Y is the vector's projection in the Y axis.
+func (v Vector2[T]) Y() T {
+	return v.y
+}
+
+// ToG2D returns the g2d vector with the same projections.
+func (v Vector2[T]) ToG2D() *g2d.Vector {
+	return g2d.MakeVector(float64(v.x), float64(v.y))
+}
+
+// Length computes the length of the vector.
+func (v Vector2[T]) Length() T {
+	return sqrt(v.x*v.x + v.y*v.y)
+}
+
+// ToVersor returns a vector with unit length and the same direction as this one.
+// Returns an ErrZeroVector error if the vector has a zero length.
+func (v Vector2[T]) ToVersor() (Vector2[T], error) {
+	length := v.Length()
+	if nums.IsZero(length) {
+		return v, ErrZeroVector
+	}
+
+	return Vector2[T]{v.x / length, v.y / length}, nil
+}
+
+// Perpendicular returns the vector result of rotating this one π/2 radians.
+func (v Vector2[T]) Perpendicular() Vector2[T] {
+	return Vector2[T]{-v.y, v.x}
+}
+
+// Scaled returns the vector with the projections scaled by the given factor.
+func (v Vector2[T]) Scaled(factor T) Vector2[T] {
+	return Vector2[T]{v.x * factor, v.y * factor}
+}
+
+// Plus returns the sum of this and the other vector.
+func (v Vector2[T]) Plus(other Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.x + other.x, v.y + other.y}
+}
+
+// Minus returns the difference between this and the other vector.
+func (v Vector2[T]) Minus(other Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.x - other.x, v.y - other.y}
+}
+
+// DotTimes computes the dot product of this vector with the other.
+func (v Vector2[T]) DotTimes(other Vector2[T]) T {
+	return v.x*other.x + v.y*other.y
+}
+
+// CrossTimes computes the Z projection of the cross product of this vector with the other.
+func (v Vector2[T]) CrossTimes(other Vector2[T]) T {
+	return v.x*other.y - v.y*other.x
+}
+
+// Equals returns true if the projections of both vectors are equal within the default epsilon
+// of type T.
+func (v Vector2[T]) Equals(other Vector2[T]) bool {
+	return nums.Equal(v.x, other.x) && nums.Equal(v.y, other.y)
+}
+
+// EqualsTol returns true if the projections of both vectors are equal within the tolerance.
+func (v Vector2[T]) EqualsTol(other Vector2[T], tol nums.Tolerance) bool {
+	return nums.EqualTol(v.x, other.x, tol) && nums.EqualTol(v.y, other.y, tol)
+}
+
+func (v Vector2[T]) String() string {
+	return fmt.Sprintf("{%f, %f}", v.x, v.y)
+}
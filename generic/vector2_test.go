@@ -0,0 +1,62 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g2d"
+)
+
+func TestVector2(t *testing.T) {
+	var (
+		u = MakeVector2[float32](3, 4)
+		v = MakeVector2[float32](-4, 3)
+	)
+
+	t.Run("length and versor", func(t *testing.T) {
+		if got := u.Length(); got != 5 {
+			t.Errorf("Want 5, got %f", got)
+		}
+
+		versor, err := u.ToVersor()
+		if err != nil || !versor.Equals(MakeVector2[float32](0.6, 0.8)) {
+			t.Errorf("Want {0.6, 0.8}, got %v", versor)
+		}
+
+		if _, err := MakeVector2[float32](0, 0).ToVersor(); err != ErrZeroVector {
+			t.Errorf("Want ErrZeroVector, got %v", err)
+		}
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		if got := u.Perpendicular(); !got.Equals(v) {
+			t.Errorf("Want %v, got %v", v, got)
+		}
+		if got := u.DotTimes(v); got != 0 {
+			t.Errorf("Want 0, got %f", got)
+		}
+		if got := u.CrossTimes(v); got != 25 {
+			t.Errorf("Want 25, got %f", got)
+		}
+		if got := u.Plus(v).Minus(v).Scaled(2); !got.Equals(MakeVector2[float32](6, 8)) {
+			t.Errorf("Want {6, 8}, got %v", got)
+		}
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		var (
+			g   = g2d.MakeVector(0.1, 0.2)
+			v32 = Vector2FromG2D[float32](g)
+			v64 = ConvertVector2[float64](v32)
+		)
+
+		if v32.X() != float32(0.1) {
+			t.Errorf("Want %f, got %f", float32(0.1), v32.X())
+		}
+		if v64.Equals(Vector2FromG2D[float64](g)) {
+			t.Error("Expected the float32 rounding to be kept after the conversion")
+		}
+		if !v32.ToG2D().EqualsTol(g, tol32) {
+			t.Errorf("Want %v, got %v", g, v32.ToG2D())
+		}
+	})
+}
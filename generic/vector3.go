@@ -0,0 +1,115 @@
+package generic
+
+import (
+	"fmt"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Vector3 is a direction in space with projections of type T in the X, Y and Z axes.
+type Vector3[T nums.Float] struct {
+	x, y, z T
+}
+
+// MakeVector3 creates a new vector with the given projections.
+func MakeVector3[T nums.Float](x, y, z T) Vector3[T] {
+	return Vector3[T]{x, y, z}
+}
+
+// Vector3FromG3D creates a vector with the projections of the g3d vector, converted to T.
+func Vector3FromG3D[T nums.Float](v *g3d.Vector) Vector3[T] {
+	return Vector3[T]{T(v.X()), T(v.Y()), T(v.Z())}
+}
+
+// ConvertVector3 converts the projections of the vector to the type U.
+func ConvertVector3[U, T nums.Float](v Vector3[T]) Vector3[U] {
+	return Vector3[U]{U(v.x), U(v.y), U(v.z)}
+}
+
+// X is the vector's projection in the X axis.
+func (v Vector3[T]) X() T {
+	return v.x
+}
+
+// Y is the vector's projection in the Y axis.
+func (v Vector3[T]) Y() T {
+	return v.y
+}
+
+// Z is the vector's projection in the Z axis.
+func (v Vector3[T]) Z() T {
+	return v.z
+}
+
+// ToG3D returns the g3d vector with the same projections.
+func (v Vector3[T]) ToG3D() *g3d.Vector {
+	return g3d.MakeVector(float64(v.x), float64(v.y), float64(v.z))
+}
+
+// Length computes the length of the vector.
+func (v Vector3[T]) Length() T {
+	return sqrt(v.x*v.x + v.y*v.y + v.z*v.z)
+}
+
+// ToVersor returns a vector with unit length and the same direction as this one.
+// Returns an ErrZeroVector error if the vector has a zero length.
+func (v Vector3[T]) ToVersor() (Vector3[T], error) {
+	length := v.Length()
+	if nums.IsZero(length) {
+		return v, ErrZeroVector
+	}
+
+	return Vector3[T]{v.x / length, v.y / length, v.z / length}, nil
+}
+
+// Opposite returns the vector with the opposite direction.
+func (v Vector3[T]) Opposite() Vector3[T] {
+	return Vector3[T]{-v.x, -v.y, -v.z}
+}
+
+// Scaled returns the vector with the projections scaled by the given factor.
+func (v Vector3[T]) Scaled(factor T) Vector3[T] {
+	return Vector3[T]{v.x * factor, v.y * factor, v.z * factor}
+}
+
+// Plus returns the sum of this and the other vector.
+func (v Vector3[T]) Plus(other Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.x + other.x, v.y + other.y, v.z + other.z}
+}
+
+// Minus returns the difference between this and the other vector.
+func (v Vector3[T]) Minus(other Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.x - other.x, v.y - other.y, v.z - other.z}
+}
+
+// DotTimes computes the dot product of this vector with the other.
+func (v Vector3[T]) DotTimes(other Vector3[T]) T {
+	return v.x*other.x + v.y*other.y + v.z*other.z
+}
+
+// CrossTimes computes the cross product of this vector with the other.
+func (v Vector3[T]) CrossTimes(other Vector3[T]) Vector3[T] {
+	return Vector3[T]{
+		v.y*other.z - v.z*other.y,
+		v.z*other.x - v.x*other.z,
+		v.x*other.y - v.y*other.x,
+	}
+}
+
+// Equals returns true if the projections of both vectors are equal within the default epsilon
+// of type T.
+func (v Vector3[T]) Equals(other Vector3[T]) bool {
+	return nums.Equal(v.x, other.x) && nums.Equal(v.y, other.y) && nums.Equal(v.z, other.z)
+}
+
+// EqualsTol returns true if the projections of both vectors are equal within the tolerance.
+func (v Vector3[T]) EqualsTol(other Vector3[T], tol nums.Tolerance) bool {
+	return nums.EqualTol(v.x, other.x, tol) &&
+		nums.EqualTol(v.y, other.y, tol) &&
+		nums.EqualTol(v.z, other.z, tol)
+}
+
+func (v Vector3[T]) String() string {
+	return fmt.Sprintf("{%f, %f, %f}", v.x, v.y, v.z)
+}
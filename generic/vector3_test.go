@@ -0,0 +1,48 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/g3d"
+)
+
+func TestVector3(t *testing.T) {
+	var (
+		i = MakeVector3[float32](1, 0, 0)
+		j = MakeVector3[float32](0, 1, 0)
+		k = MakeVector3[float32](0, 0, 1)
+	)
+
+	t.Run("cross product", func(t *testing.T) {
+		if got := i.CrossTimes(j); !got.Equals(k) {
+			t.Errorf("Want %v, got %v", k, got)
+		}
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		v := i.Plus(j.Scaled(2)).Minus(k)
+		if got := v.DotTimes(MakeVector3[float32](1, 1, 1)); got != 2 {
+			t.Errorf("Want 2, got %f", got)
+		}
+		if got := v.Opposite().Plus(v); !got.Equals(MakeVector3[float32](0, 0, 0)) {
+			t.Errorf("Want the zero vector, got %v", got)
+		}
+	})
+
+	t.Run("versor", func(t *testing.T) {
+		versor, err := MakeVector3[float32](0, 3, 4).ToVersor()
+		if err != nil || !versor.EqualsTol(MakeVector3[float32](0, 0.6, 0.8), tol32) {
+			t.Errorf("Want {0, 0.6, 0.8}, got %v", versor)
+		}
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		g := g3d.MakeVector(1, 2, 3)
+		if got := Vector3FromG3D[float32](g).ToG3D(); !got.Equals(g) {
+			t.Errorf("Want %v, got %v", g, got)
+		}
+		if got := ConvertVector3[float64](k); !got.Equals(MakeVector3(0.0, 0.0, 1.0)) {
+			t.Errorf("Want %v, got %v", k, got)
+		}
+	})
+}
@@ -0,0 +1,487 @@
+// Package bspline implements the B-spline and NURBS curve algorithms shared by the g2d and
+// g3d packages.
+//
+// Control points are given in homogeneous coordinates: a point of dimension d with weight w is
+// represented by d + 1 values, the coordinates multiplied by w followed by w. The algorithms
+// follow those in "The NURBS Book", by Les Piegl and Wayne Tiller.
+package bspline
+
+import (
+	"errors"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+var (
+	// ErrInvalidCurve happens when the number of knots doesn't match the number of control
+	// points and the degree.
+	ErrInvalidCurve = errors.New("the number of knots must be the number of control points plus the degree plus one")
+	// ErrNotClamped happens when an algorithm requires the first and last knots to be repeated
+	// degree + 1 times.
+	ErrNotClamped = errors.New("the knot vector must be clamped")
+)
+
+// Validate checks that a curve with the given degree, knots and number of control points is
+// well defined: its domain can't be empty, and no knot can be repeated more than degree + 1
+// times, as the basis function of some control point would be zero everywhere.
+func Validate(degree int, knots nums.KnotVector, numControlPoints int) error {
+	if degree < 1 {
+		return errors.New("the degree must be at least one")
+	}
+	if numControlPoints < degree+1 {
+		return errors.New("at least degree + 1 control points are required")
+	}
+	if knots.Len() != numControlPoints+degree+1 {
+		return ErrInvalidCurve
+	}
+	if knots.At(degree) == knots.At(numControlPoints) {
+		return errors.New("the knots must define a non-empty domain")
+	}
+	for i := 0; i < knots.Len(); i++ {
+		if knots.Multiplicity(knots.At(i)) > degree+1 {
+			return errors.New("the knots can't be repeated more than degree + 1 times")
+		}
+	}
+
+	return nil
+}
+
+// ToHomogeneous returns the homogeneous coordinates of the given points and weights.
+func ToHomogeneous(points [][]float64, weights []float64) [][]float64 {
+	homogeneous := make([][]float64, len(points))
+
+	for i, point := range points {
+		homogeneous[i] = make([]float64, len(point)+1)
+		for j, coord := range point {
+			homogeneous[i][j] = coord * weights[i]
+		}
+		homogeneous[i][len(point)] = weights[i]
+	}
+
+	return homogeneous
+}
+
+// FromHomogeneous returns the points and weights of the given homogeneous coordinates.
+func FromHomogeneous(homogeneous [][]float64) ([][]float64, []float64) {
+	var (
+		points  = make([][]float64, len(homogeneous))
+		weights = make([]float64, len(homogeneous))
+	)
+
+	for i, hPoint := range homogeneous {
+		var (
+			dim    = len(hPoint) - 1
+			weight = hPoint[dim]
+		)
+
+		points[i] = make([]float64, dim)
+		for j := 0; j < dim; j++ {
+			points[i][j] = hPoint[j] / weight
+		}
+		weights[i] = weight
+	}
+
+	return points, weights
+}
+
+// Derivatives computes the point (derivative of order zero) and derivatives up to the given
+// order of the non-rational curve defined by the control points at the knot value u.
+func Derivatives(degree int, knots nums.KnotVector, ctrl [][]float64, u float64, order int) [][]float64 {
+	var (
+		dim   = len(ctrl[0])
+		span  = knots.SpanIndex(degree, u)
+		basis = knots.BasisFunctionDerivatives(degree, span, u, order)
+		ders  = make([][]float64, order+1)
+	)
+
+	for k := 0; k <= order; k++ {
+		ders[k] = make([]float64, dim)
+		if k > degree {
+			continue
+		}
+
+		for j := 0; j <= degree; j++ {
+			for c := 0; c < dim; c++ {
+				ders[k][c] += basis[k][j] * ctrl[span-degree+j][c]
+			}
+		}
+	}
+
+	return ders
+}
+
+// RationalDerivatives computes the point and derivatives of the rational curve defined by the
+// homogeneous control points at the knot value u.
+func RationalDerivatives(degree int, knots nums.KnotVector, ctrl [][]float64, u float64, order int) [][]float64 {
+	var (
+		hDers = Derivatives(degree, knots, ctrl, u, order)
+		dim   = len(ctrl[0]) - 1
+		ders  = make([][]float64, order+1)
+	)
+
+	for k := 0; k <= order; k++ {
+		ders[k] = make([]float64, dim)
+		copy(ders[k], hDers[k][:dim])
+
+		for i := 1; i <= k; i++ {
+			factor := binomial(k, i) * hDers[i][dim]
+			for c := 0; c < dim; c++ {
+				ders[k][c] -= factor * ders[k-i][c]
+			}
+		}
+
+		for c := 0; c < dim; c++ {
+			ders[k][c] /= hDers[0][dim]
+		}
+	}
+
+	return ders
+}
+
+// InsertKnot inserts the knot value u the given number of times, returning the new knot vector
+// and control points. The curve's shape doesn't change.
+func InsertKnot(
+	degree int,
+	knots nums.KnotVector,
+	ctrl [][]float64,
+	u float64,
+	times int,
+) (nums.KnotVector, [][]float64, error) {
+	var (
+		p          = degree
+		start, end = knots.Domain(degree)
+		s          = knots.Multiplicity(u)
+	)
+
+	if u < start || u > end {
+		return nums.KnotVector{}, nil, errors.New("can't insert a knot outside of the curve's domain")
+	}
+	if times < 1 || times+s > p {
+		return nums.KnotVector{}, nil, errors.New("a knot's multiplicity can't exceed the degree")
+	}
+
+	var (
+		k     = knots.SpanIndex(degree, u)
+		np    = len(ctrl) - 1
+		mp    = np + p + 1
+		r     = times
+		dim   = len(ctrl[0])
+		old   = knots.Knots()
+		newUs = make([]float64, mp+r+1)
+		newQ  = make([][]float64, np+r+1)
+		temp  = make([][]float64, p+1)
+	)
+
+	for i := 0; i <= k; i++ {
+		newUs[i] = old[i]
+	}
+	for i := 1; i <= r; i++ {
+		newUs[k+i] = u
+	}
+	for i := k + 1; i <= mp; i++ {
+		newUs[i+r] = old[i]
+	}
+
+	for i := 0; i <= k-p; i++ {
+		newQ[i] = append([]float64(nil), ctrl[i]...)
+	}
+	for i := k - s; i <= np; i++ {
+		newQ[i+r] = append([]float64(nil), ctrl[i]...)
+	}
+	for i := 0; i <= p-s; i++ {
+		temp[i] = append([]float64(nil), ctrl[k-p+i]...)
+	}
+
+	var l int
+	for j := 1; j <= r; j++ {
+		l = k - p + j
+		for i := 0; i <= p-j-s; i++ {
+			alpha := (u - old[l+i]) / (old[i+k+1] - old[l+i])
+			for c := 0; c < dim; c++ {
+				temp[i][c] = alpha*temp[i+1][c] + (1-alpha)*temp[i][c]
+			}
+		}
+
+		newQ[l] = append([]float64(nil), temp[0]...)
+		newQ[k+r-j-s] = append([]float64(nil), temp[p-j-s]...)
+	}
+
+	for i := l + 1; i < k-s; i++ {
+		newQ[i] = append([]float64(nil), temp[i-l]...)
+	}
+
+	newKnots, err := nums.MakeKnotVector(newUs...)
+	return newKnots, newQ, err
+}
+
+// ElevateDegree raises the degree of the curve the given number of times, returning the new
+// knot vector and control points. The curve's shape doesn't change.
+//
+// The curve is decomposed into Bézier segments, whose degree is raised individually. The
+// interior knots of the resulting curve have a multiplicity equal to its degree.
+func ElevateDegree(
+	degree int,
+	knots nums.KnotVector,
+	ctrl [][]float64,
+	times int,
+) (nums.KnotVector, [][]float64, error) {
+	if times < 0 {
+		return nums.KnotVector{}, nil, errors.New("the degree can't be elevated a negative number of times")
+	}
+	if !knots.IsClamped(degree) {
+		return nums.KnotVector{}, nil, ErrNotClamped
+	}
+	if times == 0 {
+		return knots, ctrl, nil
+	}
+
+	knots, ctrl, err := decomposeBezier(degree, knots, ctrl)
+	if err != nil {
+		return nums.KnotVector{}, nil, err
+	}
+
+	var (
+		p        = degree
+		newP     = degree + times
+		segments = (len(ctrl) - 1) / p
+		newCtrl  = make([][]float64, 0, segments*newP+1)
+		newUs    = make([]float64, 0, 2*(newP+1)+(segments-1)*newP)
+	)
+
+	for seg := 0; seg < segments; seg++ {
+		elevated := elevateBezier(ctrl[seg*p:seg*p+p+1], times)
+		if seg > 0 {
+			// The first point is the last of the previous segment.
+			elevated = elevated[1:]
+		}
+		newCtrl = append(newCtrl, elevated...)
+	}
+
+	for i := 0; i < knots.Len(); i++ {
+		u := knots.At(i)
+		if i > 0 && u == knots.At(i-1) {
+			continue
+		}
+
+		multiplicity := newP
+		if i == 0 || i == knots.Len()-p-1 {
+			multiplicity = newP + 1
+		}
+		for j := 0; j < multiplicity; j++ {
+			newUs = append(newUs, u)
+		}
+	}
+
+	newKnots, err := nums.MakeKnotVector(newUs...)
+	return newKnots, newCtrl, err
+}
+
+// decomposeBezier inserts the interior knots until they have a multiplicity equal to the degree,
+// so that each knot span is a Bézier curve.
+func decomposeBezier(degree int, knots nums.KnotVector, ctrl [][]float64) (nums.KnotVector, [][]float64, error) {
+	var (
+		start, end = knots.Domain(degree)
+		interior   = []float64{}
+	)
+
+	for i := 0; i < knots.Len(); i++ {
+		u := knots.At(i)
+		if u > start && u < end && (len(interior) == 0 || interior[len(interior)-1] != u) {
+			interior = append(interior, u)
+		}
+	}
+
+	for _, u := range interior {
+		if knots.Multiplicity(u) > degree {
+			return nums.KnotVector{}, nil, errors.New("the curve can't have discontinuities")
+		}
+		if missing := degree - knots.Multiplicity(u); missing > 0 {
+			var err error
+			if knots, ctrl, err = InsertKnot(degree, knots, ctrl, u, missing); err != nil {
+				return nums.KnotVector{}, nil, err
+			}
+		}
+	}
+
+	return knots, ctrl, nil
+}
+
+// elevateBezier raises the degree of a Bézier curve the given number of times.
+func elevateBezier(ctrl [][]float64, times int) [][]float64 {
+	var (
+		p       = len(ctrl) - 1
+		newP    = p + times
+		dim     = len(ctrl[0])
+		newCtrl = make([][]float64, newP+1)
+	)
+
+	for i := 0; i <= newP; i++ {
+		newCtrl[i] = make([]float64, dim)
+
+		for j := maxInt(0, i-times); j <= minInt(p, i); j++ {
+			factor := binomial(p, j) * binomial(times, i-j) / binomial(newP, i)
+			for c := 0; c < dim; c++ {
+				newCtrl[i][c] += factor * ctrl[j][c]
+			}
+		}
+	}
+
+	return newCtrl
+}
+
+// Interpolate computes the knot vector and control points of the non-rational curve of the given
+// degree which passes through all the given points. The points are parametrized by the chord
+// length between them, and the knots are computed by averaging these parameters.
+func Interpolate(degree int, points [][]float64) (nums.KnotVector, [][]float64, error) {
+	if len(points) < degree+1 {
+		return nums.KnotVector{}, nil, errors.New("at least degree + 1 points are required")
+	}
+
+	var (
+		n      = len(points) - 1
+		dim    = len(points[0])
+		params = make([]float64, n+1)
+		total  = 0.0
+	)
+
+	for i := 1; i <= n; i++ {
+		params[i] = params[i-1] + distance(points[i-1], points[i])
+	}
+	total = params[n]
+	if total == 0 {
+		return nums.KnotVector{}, nil, errors.New("can't interpolate coincident points")
+	}
+	for i := 1; i <= n; i++ {
+		params[i] /= total
+	}
+	params[n] = 1
+
+	us := make([]float64, n+degree+2)
+	for i := n + 1; i < len(us); i++ {
+		us[i] = 1
+	}
+	for j := 1; j <= n-degree; j++ {
+		sum := 0.0
+		for i := j; i < j+degree; i++ {
+			sum += params[i]
+		}
+		us[j+degree] = sum / float64(degree)
+	}
+
+	knots, err := nums.MakeKnotVector(us...)
+	if err != nil {
+		return nums.KnotVector{}, nil, err
+	}
+
+	matrix := make([][]float64, n+1)
+	for i, u := range params {
+		var (
+			span  = knots.SpanIndex(degree, u)
+			basis = knots.BasisFunctions(degree, span, u)
+		)
+
+		matrix[i] = make([]float64, n+1)
+		for j, value := range basis {
+			matrix[i][span-degree+j] = value
+		}
+	}
+
+	ctrl := make([][]float64, n+1)
+	for i := range ctrl {
+		ctrl[i] = make([]float64, dim)
+	}
+
+	for c := 0; c < dim; c++ {
+		rhs := make([]float64, n+1)
+		for i := range points {
+			rhs[i] = points[i][c]
+		}
+
+		solution, err := solveLinearSystem(matrix, rhs)
+		if err != nil {
+			return nums.KnotVector{}, nil, err
+		}
+		for i := range ctrl {
+			ctrl[i][c] = solution[i]
+		}
+	}
+
+	return knots, ctrl, nil
+}
+
+// solveLinearSystem solves the system A·x = b using Gaussian elimination with partial pivoting.
+// The inputs aren't modified.
+func solveLinearSystem(a [][]float64, b []float64) ([]float64, error) {
+	var (
+		n = len(b)
+		m = make([][]float64, n)
+		x = make([]float64, n)
+	)
+
+	for i := range a {
+		m[i] = append(append(make([]float64, 0, n+1), a[i]...), b[i])
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if nums.IsCloseToZero(m[pivot][col]) {
+			return nil, errors.New("the system of equations is singular")
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := m[row][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[row][c] -= factor * m[col][c]
+			}
+		}
+	}
+
+	for row := n - 1; row >= 0; row-- {
+		sum := m[row][n]
+		for c := row + 1; c < n; c++ {
+			sum -= m[row][c] * x[c]
+		}
+		x[row] = sum / m[row][row]
+	}
+
+	return x, nil
+}
+
+func distance(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += (b[i] - a[i]) * (b[i] - a[i])
+	}
+
+	return math.Sqrt(sum)
+}
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+
+	return result
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,122 @@
+package bspline
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		name          string
+		degree        int
+		knots         []float64
+		controlPoints int
+		wantErr       bool
+	}{
+		{"clamped knots", 2, []float64{0, 0, 0, 0.5, 1, 1, 1}, 4, false},
+		{"unclamped knots", 2, []float64{0, 1, 2, 3, 4, 5, 6}, 4, false},
+		{"interior knot repeated degree + 1 times", 1, []float64{0, 0, 0.5, 0.5, 1, 1}, 4, false},
+		{"wrong number of knots", 2, []float64{0, 0, 0, 1, 1, 1}, 4, true},
+		{"knot repeated more than degree + 1 times", 2, []float64{0, 0, 0, 0, 1, 1, 1}, 4, true},
+		{"all knots equal", 1, []float64{0, 0, 0, 0}, 2, true},
+		{"empty domain", 1, []float64{0, 1, 1, 2}, 2, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			knots, err := nums.MakeKnotVector(test.knots...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = Validate(test.degree, knots, test.controlPoints)
+			assert.Equal(t, test.wantErr, err != nil, "error: %v", err)
+		})
+	}
+}
+
+func TestHomogeneousCoordinates(t *testing.T) {
+	var (
+		points      = [][]float64{{1, 2}, {3, 4}}
+		weights     = []float64{1, 2}
+		homogeneous = ToHomogeneous(points, weights)
+	)
+
+	assert.Equal(t, [][]float64{{1, 2, 1}, {6, 8, 2}}, homogeneous)
+
+	gotPoints, gotWeights := FromHomogeneous(homogeneous)
+	assert.Equal(t, points, gotPoints)
+	assert.Equal(t, weights, gotWeights)
+}
+
+func TestDerivatives(t *testing.T) {
+	var (
+		// A quadratic Bézier curve: (0, 0), (1, 2), (2, 0)
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 1, 1, 1)
+		ctrl     = [][]float64{{0, 0}, {1, 2}, {2, 0}}
+		ders     = Derivatives(2, knots, ctrl, 0.5, 3)
+	)
+
+	assert.InDeltaSlice(t, []float64{1, 1}, ders[0], 1e-10)
+	assert.InDeltaSlice(t, []float64{2, 0}, ders[1], 1e-10)
+	assert.InDeltaSlice(t, []float64{0, -8}, ders[2], 1e-10)
+	assert.InDeltaSlice(t, []float64{0, 0}, ders[3], 1e-10)
+}
+
+func TestRationalDerivatives(t *testing.T) {
+	var (
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 1, 1, 1)
+		ctrl     = ToHomogeneous([][]float64{{1, 0}, {1, 1}, {0, 1}}, []float64{1, math.Sqrt2 / 2, 1})
+		ders     = RationalDerivatives(2, knots, ctrl, 0, 1)
+	)
+
+	assert.InDeltaSlice(t, []float64{1, 0}, ders[0], 1e-10)
+	assert.InDelta(t, 0, ders[1][0], 1e-10)
+	assert.InDelta(t, math.Sqrt2, ders[1][1], 1e-10)
+}
+
+func TestInterpolate(t *testing.T) {
+	points := [][]float64{{0, 0, 0}, {1, 2, 1}, {3, 2, 2}, {4, 0, 1}, {5, 1, 0}, {7, 3, 1}}
+
+	knots, ctrl, err := Interpolate(3, points)
+	assert.Nil(t, err)
+
+	// The curve passes through each point at its chord length parameter.
+	var (
+		total  = 0.0
+		params = []float64{0}
+	)
+	for i := 1; i < len(points); i++ {
+		total += distance(points[i-1], points[i])
+		params = append(params, total)
+	}
+
+	for i, point := range points {
+		got := Derivatives(3, knots, ctrl, params[i]/total, 0)[0]
+		assert.InDeltaSlice(t, point, got, 1e-9)
+	}
+}
+
+func TestInsertKnotErrors(t *testing.T) {
+	var (
+		knots, _ = nums.MakeKnotVector(0, 0, 0, 1, 1, 1)
+		ctrl     = [][]float64{{0, 0, 1}, {1, 2, 1}, {2, 0, 1}}
+	)
+
+	_, _, err := InsertKnot(2, knots, ctrl, 2, 1)
+	assert.NotNil(t, err)
+
+	_, _, err = InsertKnot(2, knots, ctrl, 0.5, 3)
+	assert.NotNil(t, err)
+}
+
+func TestElevateDegreeRequiresClampedKnots(t *testing.T) {
+	var (
+		knots, _ = nums.MakeKnotVector(0, 1, 2, 3, 4, 5)
+		ctrl     = [][]float64{{0, 0, 1}, {1, 2, 1}, {2, 0, 1}}
+	)
+
+	_, _, err := ElevateDegree(2, knots, ctrl, 1)
+	assert.Equal(t, ErrNotClamped, err)
+}
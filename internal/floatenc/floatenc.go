@@ -0,0 +1,86 @@
+// Package floatenc implements the text and binary encodings of lists of floats shared by the
+// marshaling methods of the geometric primitives.
+//
+// The text encoding is the list of numbers in their shortest exact representation, separated by
+// spaces. The binary encoding is the list of numbers as IEEE 754 values in little-endian order.
+// Decoding fails if the number of values isn't the expected one or any of them isn't finite.
+package floatenc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+const bytesPerFloat = 8
+
+// FormatText returns the text encoding of the values.
+func FormatText(values ...float64) []byte {
+	var text []byte
+	for i, value := range values {
+		if i > 0 {
+			text = append(text, ' ')
+		}
+		text = strconv.AppendFloat(text, value, 'g', -1, 64)
+	}
+
+	return text
+}
+
+// ParseText decodes exactly n values from their text encoding.
+func ParseText(text []byte, n int) ([]float64, error) {
+	fields := strings.Fields(string(text))
+	if len(fields) != n {
+		return nil, fmt.Errorf("expected %d numbers, got %d", n, len(fields))
+	}
+
+	values := make([]float64, n)
+	for i, field := range fields {
+		value, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return values, CheckFinite(values...)
+}
+
+// EncodeBinary returns the binary encoding of the values.
+func EncodeBinary(values ...float64) []byte {
+	data := make([]byte, bytesPerFloat*len(values))
+	for i, value := range values {
+		binary.LittleEndian.PutUint64(data[bytesPerFloat*i:], math.Float64bits(value))
+	}
+
+	return data
+}
+
+// DecodeBinary decodes exactly n values from their binary encoding.
+func DecodeBinary(data []byte, n int) ([]float64, error) {
+	if len(data) != bytesPerFloat*n {
+		return nil, fmt.Errorf("expected %d bytes, got %d", bytesPerFloat*n, len(data))
+	}
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[bytesPerFloat*i:]))
+	}
+
+	return values, CheckFinite(values...)
+}
+
+// CheckFinite returns a nums.ErrNotFinite error if any of the values is NaN or infinite.
+func CheckFinite(values ...float64) error {
+	for _, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nums.ErrNotFinite
+		}
+	}
+
+	return nil
+}
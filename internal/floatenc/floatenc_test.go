@@ -0,0 +1,72 @@
+package floatenc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestText(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		values := []float64{0.1, -2, 1e-300, 12345.678}
+
+		text := FormatText(values...)
+		if string(text) != "0.1 -2 1e-300 12345.678" {
+			t.Errorf("Unexpected encoding %q", text)
+		}
+
+		got, err := ParseText(text, len(values))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range values {
+			if got[i] != values[i] {
+				t.Errorf("Want %v, got %v", values, got)
+			}
+		}
+	})
+
+	t.Run("wrong number of values", func(t *testing.T) {
+		if _, err := ParseText([]byte("1 2 3"), 2); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("invalid numbers", func(t *testing.T) {
+		if _, err := ParseText([]byte("1 x"), 2); err == nil {
+			t.Error("Expected an error")
+		}
+		if _, err := ParseText([]byte("1 NaN"), 2); err != nums.ErrNotFinite {
+			t.Errorf("Want ErrNotFinite, got %v", err)
+		}
+	})
+}
+
+func TestBinary(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		values := []float64{0.1, -2, math.SmallestNonzeroFloat64}
+
+		got, err := DecodeBinary(EncodeBinary(values...), len(values))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range values {
+			if got[i] != values[i] {
+				t.Errorf("Want %v, got %v", values, got)
+			}
+		}
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+		if _, err := DecodeBinary(make([]byte, 12), 2); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("non-finite values", func(t *testing.T) {
+		if _, err := DecodeBinary(EncodeBinary(math.Inf(1)), 1); err != nums.ErrNotFinite {
+			t.Errorf("Want ErrNotFinite, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package linalg
+
+import "math"
+
+// A Cholesky is the decomposition A = L·Lᵀ of a symmetric positive definite matrix A, where L is
+// lower triangular with a positive diagonal.
+//
+// Stiffness matrices of structures with enough supports are symmetric positive definite, and the
+// Cholesky decomposition solves them about twice as fast as the LU decomposition.
+type Cholesky struct {
+	l *Matrix
+}
+
+// Cholesky computes the decomposition of this symmetric positive definite matrix.
+// Returns an ErrNotSquare error if the matrix isn't square, and an ErrNotPositiveDefinite error
+// if it isn't symmetric positive definite.
+func (m *Matrix) Cholesky() (*Cholesky, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+	if !m.IsSymmetric() {
+		return nil, ErrNotPositiveDefinite
+	}
+
+	var (
+		n = m.rows
+		l = MakeSquareMatrix(n)
+	)
+
+	for j := 0; j < n; j++ {
+		diag := m.At(j, j)
+		for k := 0; k < j; k++ {
+			diag -= l.At(j, k) * l.At(j, k)
+		}
+
+		if diag <= 0 {
+			return nil, ErrNotPositiveDefinite
+		}
+
+		ljj := math.Sqrt(diag)
+		l.SetAt(j, j, ljj)
+
+		for i := j + 1; i < n; i++ {
+			value := m.At(i, j)
+			for k := 0; k < j; k++ {
+				value -= l.At(i, k) * l.At(j, k)
+			}
+			l.SetAt(i, j, value/ljj)
+		}
+	}
+
+	return &Cholesky{l}, nil
+}
+
+// L returns the lower triangular factor.
+func (d *Cholesky) L() *Matrix {
+	return d.l.Copy()
+}
+
+// Det computes the determinant of the decomposed matrix.
+func (d *Cholesky) Det() float64 {
+	det := 1.0
+	for i := 0; i < d.l.rows; i++ {
+		det *= d.l.At(i, i)
+	}
+
+	return det * det
+}
+
+// Solve computes the solution x of the system A·x = b.
+func (d *Cholesky) Solve(b Vector) (Vector, error) {
+	if len(b) != d.l.rows {
+		return nil, ErrDimensionMismatch
+	}
+
+	var (
+		n = d.l.rows
+		x = b.Copy()
+	)
+
+	// Forward substitution with L.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= d.l.At(i, j) * x[j]
+		}
+		x[i] /= d.l.At(i, i)
+	}
+
+	// Backward substitution with Lᵀ.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.l.At(j, i) * x[j]
+		}
+		x[i] /= d.l.At(i, i)
+	}
+
+	return x, nil
+}
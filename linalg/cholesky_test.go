@@ -0,0 +1,69 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestCholesky(t *testing.T) {
+	m := mustMatrix(t,
+		[]float64{4, 12, -16},
+		[]float64{12, 37, -43},
+		[]float64{-16, -43, 98},
+	)
+
+	t.Run("L·Lᵀ = A", func(t *testing.T) {
+		var (
+			chol, _ = m.Cholesky()
+			l       = chol.L()
+			want    = mustMatrix(t, []float64{2, 0, 0}, []float64{6, 1, 0}, []float64{-8, 5, 3})
+		)
+
+		if !l.Equals(want) {
+			t.Errorf("Want %v, got %v", want, l)
+		}
+		if got, _ := l.Times(l.Transposed()); !got.Equals(m) {
+			t.Errorf("Want %v, got %v", m, got)
+		}
+	})
+
+	t.Run("solve and determinant", func(t *testing.T) {
+		var (
+			chol, _ = m.Cholesky()
+			want    = Vector{1, -1, 2}
+			b, _    = m.TimesVector(want)
+		)
+
+		if got, _ := chol.Solve(b); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+		if got := chol.Det(); !nums.FloatsEqual(got, 36) {
+			t.Errorf("Want 36, got %f", got)
+		}
+	})
+
+	t.Run("not positive definite", func(t *testing.T) {
+		indefinite := mustMatrix(t, []float64{1, 2}, []float64{2, 1})
+		if _, err := indefinite.Cholesky(); err != ErrNotPositiveDefinite {
+			t.Errorf("Want ErrNotPositiveDefinite, got %v", err)
+		}
+	})
+
+	t.Run("large entries with rounding errors", func(t *testing.T) {
+		stiffness := mustMatrix(t,
+			[]float64{2e8, -1e8 + 1e-6},
+			[]float64{-1e8, 2e8},
+		)
+		if _, err := stiffness.Cholesky(); err != nil {
+			t.Errorf("Want no error, got %v", err)
+		}
+	})
+
+	t.Run("not symmetric", func(t *testing.T) {
+		nonSymmetric := mustMatrix(t, []float64{2, 1}, []float64{0, 2})
+		if _, err := nonSymmetric.Cholesky(); err != ErrNotPositiveDefinite {
+			t.Errorf("Want ErrNotPositiveDefinite, got %v", err)
+		}
+	})
+}
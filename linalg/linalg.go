@@ -0,0 +1,23 @@
+// Package linalg implements dense matrices and vectors, and the decompositions used to solve
+// small systems of linear equations: LU with partial pivoting, Cholesky and QR.
+//
+// The package is meant for the small systems that appear in geometry and element computations,
+// like the 6x6 or 12x12 stiffness matrix of a bar. Large, sparse systems need a sparse storage
+// and iterative solvers instead.
+package linalg
+
+import "errors"
+
+var (
+	// ErrDimensionMismatch is returned when the dimensions of the operands aren't compatible.
+	ErrDimensionMismatch = errors.New("the dimensions of the operands don't match")
+	// ErrNotSquare is returned when an operation requires a square matrix.
+	ErrNotSquare = errors.New("the matrix must be square")
+	// ErrSingular is returned when a matrix is singular, or too close to singular.
+	ErrSingular = errors.New("the matrix is singular")
+	// ErrNotPositiveDefinite is returned when the Cholesky decomposition of a matrix which isn't
+	// symmetric positive definite is attempted.
+	ErrNotPositiveDefinite = errors.New("the matrix isn't symmetric positive definite")
+	// ErrRankDeficient is returned when the columns of a matrix aren't linearly independent.
+	ErrRankDeficient = errors.New("the matrix is rank deficient")
+)
@@ -0,0 +1,291 @@
+package linalg
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// An LU is the decomposition P·A = L·U of a square matrix A, where P is a permutation matrix,
+// L is lower triangular with a unit diagonal and U is upper triangular.
+//
+// Both L and U are stored in a single matrix, and the permutation as the list of the original
+// row indices of each row.
+type LU struct {
+	lu       *Matrix
+	pivots   []int
+	sign     float64
+	norm1    float64
+	singular bool
+}
+
+// LU computes the decomposition of this square matrix using Gaussian elimination with partial
+// pivoting. Returns an ErrNotSquare error if the matrix isn't square.
+//
+// The decomposition of a singular matrix can be computed, but it can't be used to solve systems
+// or invert the matrix.
+func (m *Matrix) LU() (*LU, error) {
+	if !m.IsSquare() {
+		return nil, ErrNotSquare
+	}
+
+	var (
+		n         = m.rows
+		lu        = m.Copy()
+		pivots    = make([]int, n)
+		sign      = 1.0
+		singular  = false
+		threshold = float64(n) * nums.MachineEpsilon * maxAbs(m.data)
+	)
+
+	for i := range pivots {
+		pivots[i] = i
+	}
+
+	for k := 0; k < n; k++ {
+		pivotRow := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu.At(i, k)) > math.Abs(lu.At(pivotRow, k)) {
+				pivotRow = i
+			}
+		}
+
+		if pivotRow != k {
+			lu.swapRows(k, pivotRow)
+			pivots[k], pivots[pivotRow] = pivots[pivotRow], pivots[k]
+			sign = -sign
+		}
+
+		pivot := lu.At(k, k)
+		if math.Abs(pivot) <= threshold {
+			singular = true
+			continue
+		}
+
+		for i := k + 1; i < n; i++ {
+			factor := lu.At(i, k) / pivot
+			lu.SetAt(i, k, factor)
+
+			for j := k + 1; j < n; j++ {
+				lu.AddToAt(i, j, -factor*lu.At(k, j))
+			}
+		}
+	}
+
+	return &LU{lu, pivots, sign, m.Norm1(), singular}, nil
+}
+
+// IsSingular returns true if the decomposed matrix is singular, or too close to singular.
+func (d *LU) IsSingular() bool {
+	return d.singular
+}
+
+// L returns the lower triangular factor, with a unit diagonal.
+func (d *LU) L() *Matrix {
+	n := d.lu.rows
+	l := MakeIdentityMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			l.SetAt(i, j, d.lu.At(i, j))
+		}
+	}
+
+	return l
+}
+
+// U returns the upper triangular factor.
+func (d *LU) U() *Matrix {
+	n := d.lu.rows
+	u := MakeSquareMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			u.SetAt(i, j, d.lu.At(i, j))
+		}
+	}
+
+	return u
+}
+
+// Pivots returns the original index of each of the rows of P·A.
+func (d *LU) Pivots() []int {
+	return append([]int(nil), d.pivots...)
+}
+
+// Det computes the determinant of the decomposed matrix.
+func (d *LU) Det() float64 {
+	det := d.sign
+	for i := 0; i < d.lu.rows; i++ {
+		det *= d.lu.At(i, i)
+	}
+
+	return det
+}
+
+// Solve computes the solution x of the system A·x = b.
+// Returns an ErrSingular error if the matrix is singular.
+func (d *LU) Solve(b Vector) (Vector, error) {
+	if len(b) != d.lu.rows {
+		return nil, ErrDimensionMismatch
+	}
+	if d.singular {
+		return nil, ErrSingular
+	}
+
+	n := d.lu.rows
+	x := make(Vector, n)
+	for i, pivot := range d.pivots {
+		x[i] = b[pivot]
+	}
+
+	// Forward substitution with L.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			x[i] -= d.lu.At(i, j) * x[j]
+		}
+	}
+
+	// Backward substitution with U.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			x[i] -= d.lu.At(i, j) * x[j]
+		}
+		x[i] /= d.lu.At(i, i)
+	}
+
+	return x, nil
+}
+
+// Inverse computes the inverse of the decomposed matrix.
+// Returns an ErrSingular error if the matrix is singular.
+func (d *LU) Inverse() (*Matrix, error) {
+	var (
+		n       = d.lu.rows
+		inverse = MakeSquareMatrix(n)
+		unit    = make(Vector, n)
+	)
+
+	for j := 0; j < n; j++ {
+		unit[j] = 1
+		col, err := d.Solve(unit)
+		if err != nil {
+			return nil, err
+		}
+		unit[j] = 0
+
+		for i, value := range col {
+			inverse.SetAt(i, j, value)
+		}
+	}
+
+	return inverse, nil
+}
+
+// ConditionEstimate estimates the condition number of the decomposed matrix in the 1-norm,
+// ‖A‖₁·‖A⁻¹‖₁, using Hager's algorithm to estimate the norm of the inverse without computing it.
+// Returns +Inf if the matrix is singular.
+func (d *LU) ConditionEstimate() float64 {
+	const maxIterations = 5
+
+	if d.singular {
+		return math.Inf(1)
+	}
+
+	var (
+		n          = d.lu.rows
+		x          = make(Vector, n)
+		invNorm    = 0.0
+		lastMaxIdx = -1
+	)
+
+	if n == 0 {
+		return 0
+	}
+
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+
+	for iter := 0; iter < maxIterations; iter++ {
+		y, _ := d.Solve(x)
+		invNorm = math.Max(invNorm, y.Norm1())
+
+		xi := make(Vector, n)
+		for i, value := range y {
+			xi[i] = math.Copysign(1, value)
+		}
+
+		var (
+			z      = d.solveTransposed(xi)
+			zx, _  = z.Dot(x)
+			maxIdx = 0
+		)
+
+		for i, value := range z {
+			if math.Abs(value) > math.Abs(z[maxIdx]) {
+				maxIdx = i
+			}
+		}
+
+		if math.Abs(z[maxIdx]) <= zx || maxIdx == lastMaxIdx {
+			break
+		}
+
+		for i := range x {
+			x[i] = 0
+		}
+		x[maxIdx] = 1
+		lastMaxIdx = maxIdx
+	}
+
+	return d.norm1 * invNorm
+}
+
+// solveTransposed computes the solution x of the system Aᵀ·x = b.
+func (d *LU) solveTransposed(b Vector) Vector {
+	var (
+		n = d.lu.rows
+		w = b.Copy()
+	)
+
+	// Forward substitution with Uᵀ.
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			w[i] -= d.lu.At(j, i) * w[j]
+		}
+		w[i] /= d.lu.At(i, i)
+	}
+
+	// Backward substitution with Lᵀ.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			w[i] -= d.lu.At(j, i) * w[j]
+		}
+	}
+
+	x := make(Vector, n)
+	for i, pivot := range d.pivots {
+		x[pivot] = w[i]
+	}
+
+	return x
+}
+
+func (m *Matrix) swapRows(i, j int) {
+	var (
+		rowI = m.data[i*m.cols : (i+1)*m.cols]
+		rowJ = m.data[j*m.cols : (j+1)*m.cols]
+	)
+
+	for k := range rowI {
+		rowI[k], rowJ[k] = rowJ[k], rowI[k]
+	}
+}
+
+func maxAbs(values []float64) float64 {
+	result := 0.0
+	for _, value := range values {
+		result = math.Max(result, math.Abs(value))
+	}
+
+	return result
+}
@@ -0,0 +1,85 @@
+package linalg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLU(t *testing.T) {
+	m := mustMatrix(t,
+		[]float64{1, 2, 3},
+		[]float64{4, 5, 6},
+		[]float64{7, 8, 10},
+	)
+	lu, _ := m.LU()
+
+	t.Run("P·A = L·U", func(t *testing.T) {
+		var (
+			pivots = lu.Pivots()
+			pa     = MakeSquareMatrix(3)
+		)
+
+		for i, pivot := range pivots {
+			for j := 0; j < 3; j++ {
+				pa.SetAt(i, j, m.At(pivot, j))
+			}
+		}
+
+		if got, _ := lu.L().Times(lu.U()); !got.Equals(pa) {
+			t.Errorf("Want %v, got %v", pa, got)
+		}
+	})
+
+	t.Run("partial pivoting picks the largest pivot", func(t *testing.T) {
+		if got := lu.Pivots()[0]; got != 2 {
+			t.Errorf("Want the third row as first pivot, got %d", got)
+		}
+	})
+
+	t.Run("singular matrix", func(t *testing.T) {
+		singular := mustMatrix(t, []float64{1, 2}, []float64{2, 4})
+		lu, _ := singular.LU()
+
+		if !lu.IsSingular() {
+			t.Error("Expected the matrix to be singular")
+		}
+		if _, err := lu.Solve(Vector{1, 2}); err != ErrSingular {
+			t.Errorf("Want ErrSingular, got %v", err)
+		}
+		if got := lu.ConditionEstimate(); !math.IsInf(got, 1) {
+			t.Errorf("Want +Inf, got %f", got)
+		}
+	})
+}
+
+func TestConditionEstimate(t *testing.T) {
+	t.Run("identity is perfectly conditioned", func(t *testing.T) {
+		if got, _ := MakeIdentityMatrix(4).ConditionEstimate(); math.Abs(got-1) > 1e-12 {
+			t.Errorf("Want 1, got %f", got)
+		}
+	})
+
+	t.Run("matches the exact condition number", func(t *testing.T) {
+		var (
+			m          = mustMatrix(t, []float64{1, 2}, []float64{3, 4})
+			inverse, _ = m.Inverse()
+			want       = m.Norm1() * inverse.Norm1()
+			got, _     = m.ConditionEstimate()
+		)
+
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("Want %f, got %f", want, got)
+		}
+	})
+
+	t.Run("ill conditioned matrix", func(t *testing.T) {
+		var (
+			m      = mustMatrix(t, []float64{1, 1}, []float64{1, 1 + 1e-10})
+			got, _ = m.ConditionEstimate()
+		)
+
+		if got < 1e9 {
+			t.Errorf("Want a large condition number, got %g", got)
+		}
+	})
+}
@@ -0,0 +1,320 @@
+package linalg
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Matrix is a dense matrix of real numbers, stored in row-major order.
+type Matrix struct {
+	rows, cols int
+	data       []float64
+}
+
+// MakeMatrix creates a new matrix with the given number of rows and columns, with all its
+// elements set to zero.
+func MakeMatrix(rows, cols int) *Matrix {
+	return &Matrix{rows, cols, make([]float64, rows*cols)}
+}
+
+// MakeSquareMatrix creates a new n x n matrix, with all its elements set to zero.
+func MakeSquareMatrix(n int) *Matrix {
+	return MakeMatrix(n, n)
+}
+
+// MakeIdentityMatrix creates a new n x n identity matrix.
+func MakeIdentityMatrix(n int) *Matrix {
+	m := MakeSquareMatrix(n)
+	for i := 0; i < n; i++ {
+		m.data[i*n+i] = 1
+	}
+
+	return m
+}
+
+// MakeMatrixFromRows creates a new matrix with the given rows.
+// Returns an ErrDimensionMismatch error if the rows don't have the same number of elements.
+func MakeMatrixFromRows(rows ...[]float64) (*Matrix, error) {
+	if len(rows) == 0 {
+		return MakeMatrix(0, 0), nil
+	}
+
+	m := MakeMatrix(len(rows), len(rows[0]))
+	for i, row := range rows {
+		if len(row) != m.cols {
+			return nil, ErrDimensionMismatch
+		}
+		copy(m.data[i*m.cols:], row)
+	}
+
+	return m, nil
+}
+
+// Rows is the number of rows of the matrix.
+func (m *Matrix) Rows() int {
+	return m.rows
+}
+
+// Cols is the number of columns of the matrix.
+func (m *Matrix) Cols() int {
+	return m.cols
+}
+
+// IsSquare returns true if the matrix has the same number of rows and columns.
+func (m *Matrix) IsSquare() bool {
+	return m.rows == m.cols
+}
+
+// At returns the value at the given row and column.
+func (m *Matrix) At(row, col int) float64 {
+	return m.data[row*m.cols+col]
+}
+
+// SetAt sets the value at the given row and column.
+func (m *Matrix) SetAt(row, col int, value float64) {
+	m.data[row*m.cols+col] = value
+}
+
+// AddToAt adds the value to the one at the given row and column.
+func (m *Matrix) AddToAt(row, col int, value float64) {
+	m.data[row*m.cols+col] += value
+}
+
+// Row returns a copy of the row at the given index.
+func (m *Matrix) Row(row int) Vector {
+	return append(Vector(nil), m.data[row*m.cols:(row+1)*m.cols]...)
+}
+
+// Col returns a copy of the column at the given index.
+func (m *Matrix) Col(col int) Vector {
+	result := make(Vector, m.rows)
+	for i := range result {
+		result[i] = m.data[i*m.cols+col]
+	}
+
+	return result
+}
+
+// Copy creates a new matrix with the same elements.
+func (m *Matrix) Copy() *Matrix {
+	return &Matrix{m.rows, m.cols, append([]float64(nil), m.data...)}
+}
+
+// Transposed creates a new matrix whose rows are the columns of this one.
+func (m *Matrix) Transposed() *Matrix {
+	result := MakeMatrix(m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			result.data[j*m.rows+i] = m.data[i*m.cols+j]
+		}
+	}
+
+	return result
+}
+
+// symmetryTolerance compares mirrored entries relative to their magnitude, so that matrices with
+// large entries, like stiffness matrices, aren't rejected because of rounding errors.
+var symmetryTolerance = nums.MakeRelativeTolerance(1e-10).WithAbsoluteFloor(1e-10)
+
+// IsSymmetric returns true if the matrix is square and equal to its transpose, comparing the
+// mirrored entries relative to their magnitude.
+func (m *Matrix) IsSymmetric() bool {
+	return m.IsSymmetricTol(symmetryTolerance)
+}
+
+// IsSymmetricTol returns true if the matrix is square and equal to its transpose within the given
+// tolerance.
+func (m *Matrix) IsSymmetricTol(tol nums.Tolerance) bool {
+	if !m.IsSquare() {
+		return false
+	}
+
+	for i := 0; i < m.rows; i++ {
+		for j := i + 1; j < m.cols; j++ {
+			if !tol.Equal(m.At(i, j), m.At(j, i)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Plus creates a new matrix adding this and other.
+func (m *Matrix) Plus(other *Matrix) (*Matrix, error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := MakeMatrix(m.rows, m.cols)
+	for i, value := range m.data {
+		result.data[i] = value + other.data[i]
+	}
+
+	return result, nil
+}
+
+// Minus creates a new matrix subtracting other from this.
+func (m *Matrix) Minus(other *Matrix) (*Matrix, error) {
+	if m.rows != other.rows || m.cols != other.cols {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := MakeMatrix(m.rows, m.cols)
+	for i, value := range m.data {
+		result.data[i] = value - other.data[i]
+	}
+
+	return result, nil
+}
+
+// Scaled creates a new matrix with the elements scaled the given factor.
+func (m *Matrix) Scaled(factor float64) *Matrix {
+	result := MakeMatrix(m.rows, m.cols)
+	for i, value := range m.data {
+		result.data[i] = value * factor
+	}
+
+	return result
+}
+
+// Times computes the product of this matrix with other.
+func (m *Matrix) Times(other *Matrix) (*Matrix, error) {
+	if m.cols != other.rows {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := MakeMatrix(m.rows, other.cols)
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < m.cols; k++ {
+			value := m.data[i*m.cols+k]
+			if value == 0 {
+				continue
+			}
+
+			for j := 0; j < other.cols; j++ {
+				result.data[i*other.cols+j] += value * other.data[k*other.cols+j]
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// TimesVector computes the product of this matrix with the vector.
+func (m *Matrix) TimesVector(v Vector) (Vector, error) {
+	if m.cols != len(v) {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := make(Vector, m.rows)
+	for i := range result {
+		sum := 0.0
+		for j, value := range v {
+			sum += m.data[i*m.cols+j] * value
+		}
+		result[i] = sum
+	}
+
+	return result, nil
+}
+
+// Norm1 computes the largest sum of the absolute values of the elements in a column.
+func (m *Matrix) Norm1() float64 {
+	norm := 0.0
+	for j := 0; j < m.cols; j++ {
+		sum := 0.0
+		for i := 0; i < m.rows; i++ {
+			sum += math.Abs(m.data[i*m.cols+j])
+		}
+		norm = math.Max(norm, sum)
+	}
+
+	return norm
+}
+
+// NormInf computes the largest sum of the absolute values of the elements in a row.
+func (m *Matrix) NormInf() float64 {
+	return m.Transposed().Norm1()
+}
+
+// Solve computes the solution x of the system A·x = b, where A is this square matrix, using its
+// LU decomposition.
+func (m *Matrix) Solve(b Vector) (Vector, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Solve(b)
+}
+
+// Det computes the determinant of this square matrix.
+func (m *Matrix) Det() (float64, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return 0, err
+	}
+
+	return lu.Det(), nil
+}
+
+// Inverse computes the inverse of this square matrix.
+func (m *Matrix) Inverse() (*Matrix, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Inverse()
+}
+
+// ConditionEstimate estimates the condition number of this square matrix in the 1-norm.
+// Returns +Inf if the matrix is singular.
+func (m *Matrix) ConditionEstimate() (float64, error) {
+	lu, err := m.LU()
+	if err != nil {
+		return 0, err
+	}
+
+	return lu.ConditionEstimate(), nil
+}
+
+// Equals returns true if both matrices have the same dimensions and equal elements.
+func (m *Matrix) Equals(other *Matrix) bool {
+	return m.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol returns true if both matrices have the same dimensions and equal elements within the
+// given tolerance.
+func (m *Matrix) EqualsTol(other *Matrix, tol nums.Tolerance) bool {
+	if m.rows != other.rows || m.cols != other.cols {
+		return false
+	}
+
+	for i, value := range m.data {
+		if !tol.Equal(value, other.data[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *Matrix) String() string {
+	var builder strings.Builder
+
+	builder.WriteString("Matrix{")
+	for i := 0; i < m.rows; i++ {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		fmt.Fprintf(&builder, "%v", m.data[i*m.cols:(i+1)*m.cols])
+	}
+	builder.WriteString("}")
+
+	return builder.String()
+}
@@ -0,0 +1,134 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func mustMatrix(t *testing.T, rows ...[]float64) *Matrix {
+	t.Helper()
+
+	m, err := MakeMatrixFromRows(rows...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func TestMakeMatrix(t *testing.T) {
+	t.Run("from rows", func(t *testing.T) {
+		m := mustMatrix(t, []float64{1, 2, 3}, []float64{4, 5, 6})
+
+		if m.Rows() != 2 || m.Cols() != 3 {
+			t.Errorf("Want a 2x3 matrix, got %dx%d", m.Rows(), m.Cols())
+		}
+		if got := m.At(1, 2); got != 6 {
+			t.Errorf("Want 6, got %f", got)
+		}
+	})
+
+	t.Run("rows of different sizes", func(t *testing.T) {
+		if _, err := MakeMatrixFromRows([]float64{1, 2}, []float64{3}); err != ErrDimensionMismatch {
+			t.Errorf("Want ErrDimensionMismatch, got %v", err)
+		}
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		want := mustMatrix(t, []float64{1, 0}, []float64{0, 1})
+		if got := MakeIdentityMatrix(2); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+}
+
+func TestMatrixOperations(t *testing.T) {
+	var (
+		a = mustMatrix(t, []float64{1, 2, 3}, []float64{4, 5, 6})
+		b = mustMatrix(t, []float64{7, 8}, []float64{9, 10}, []float64{11, 12})
+	)
+
+	t.Run("transposed", func(t *testing.T) {
+		want := mustMatrix(t, []float64{1, 4}, []float64{2, 5}, []float64{3, 6})
+		if got := a.Transposed(); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("product", func(t *testing.T) {
+		want := mustMatrix(t, []float64{58, 64}, []float64{139, 154})
+		if got, _ := a.Times(b); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("product with a vector", func(t *testing.T) {
+		want := Vector{14, 32}
+		if got, _ := a.TimesVector(Vector{1, 2, 3}); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("product dimension mismatch", func(t *testing.T) {
+		if _, err := a.Times(a); err != ErrDimensionMismatch {
+			t.Errorf("Want ErrDimensionMismatch, got %v", err)
+		}
+	})
+
+	t.Run("norms", func(t *testing.T) {
+		if got := a.Norm1(); !nums.FloatsEqual(got, 9) {
+			t.Errorf("Want 9, got %f", got)
+		}
+		if got := a.NormInf(); !nums.FloatsEqual(got, 15) {
+			t.Errorf("Want 15, got %f", got)
+		}
+	})
+
+	t.Run("symmetry", func(t *testing.T) {
+		large := mustMatrix(t, []float64{3e8, 1e8 + 1e-6}, []float64{1e8, 3e8})
+		if !large.IsSymmetric() {
+			t.Error("Expected a matrix with large entries to be symmetric")
+		}
+		if large.IsSymmetricTol(nums.MakeAbsoluteTolerance(1e-10)) {
+			t.Error("Expected the matrix not to be symmetric with an absolute tolerance")
+		}
+		if a.IsSymmetric() {
+			t.Error("Expected a non square matrix not to be symmetric")
+		}
+	})
+}
+
+func TestMatrixSolveDetInverse(t *testing.T) {
+	m := mustMatrix(t,
+		[]float64{2, 1, 1},
+		[]float64{4, -6, 0},
+		[]float64{-2, 7, 2},
+	)
+
+	t.Run("solve", func(t *testing.T) {
+		want := Vector{1, 1, 2}
+		if got, _ := m.Solve(Vector{5, -2, 9}); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("determinant", func(t *testing.T) {
+		if got, _ := m.Det(); !nums.FloatsEqual(got, -16) {
+			t.Errorf("Want -16, got %f", got)
+		}
+	})
+
+	t.Run("inverse", func(t *testing.T) {
+		inverse, _ := m.Inverse()
+		if got, _ := m.Times(inverse); !got.Equals(MakeIdentityMatrix(3)) {
+			t.Errorf("Want the identity, got %v", got)
+		}
+	})
+
+	t.Run("not square", func(t *testing.T) {
+		if _, err := MakeMatrix(2, 3).Det(); err != ErrNotSquare {
+			t.Errorf("Want ErrNotSquare, got %v", err)
+		}
+	})
+}
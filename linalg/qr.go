@@ -0,0 +1,165 @@
+package linalg
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A QR is the decomposition A = Q·R of an m x n matrix A, with m >= n, where Q is an m x n matrix
+// with orthonormal columns and R is an n x n upper triangular matrix.
+//
+// The decomposition is computed using Householder reflections, which are stored below the
+// diagonal of a single matrix, with the diagonal of R stored apart.
+type QR struct {
+	qr    *Matrix
+	rDiag []float64
+}
+
+// QR computes the decomposition of this matrix, which must have at least as many rows as
+// columns. Returns an ErrDimensionMismatch error otherwise.
+func (m *Matrix) QR() (*QR, error) {
+	if m.rows < m.cols {
+		return nil, ErrDimensionMismatch
+	}
+
+	var (
+		qr    = m.Copy()
+		rDiag = make([]float64, m.cols)
+	)
+
+	for k := 0; k < m.cols; k++ {
+		column := make(Vector, m.rows-k)
+		for i := k; i < m.rows; i++ {
+			column[i-k] = qr.At(i, k)
+		}
+
+		norm := column.Norm()
+		if norm == 0 {
+			continue
+		}
+
+		if qr.At(k, k) < 0 {
+			norm = -norm
+		}
+		for i := k; i < m.rows; i++ {
+			qr.SetAt(i, k, qr.At(i, k)/norm)
+		}
+		qr.AddToAt(k, k, 1)
+
+		// Apply the reflection to the remaining columns.
+		for j := k + 1; j < m.cols; j++ {
+			s := 0.0
+			for i := k; i < m.rows; i++ {
+				s += qr.At(i, k) * qr.At(i, j)
+			}
+			s = -s / qr.At(k, k)
+
+			for i := k; i < m.rows; i++ {
+				qr.AddToAt(i, j, s*qr.At(i, k))
+			}
+		}
+
+		rDiag[k] = -norm
+	}
+
+	return &QR{qr, rDiag}, nil
+}
+
+// IsFullRank returns true if the columns of the decomposed matrix are linearly independent.
+func (d *QR) IsFullRank() bool {
+	threshold := float64(d.qr.rows) * nums.MachineEpsilon * maxAbs(d.rDiag)
+	for _, value := range d.rDiag {
+		if math.Abs(value) <= threshold {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Q returns the m x n factor with orthonormal columns.
+func (d *QR) Q() *Matrix {
+	var (
+		rows, cols = d.qr.rows, d.qr.cols
+		q          = MakeMatrix(rows, cols)
+	)
+
+	for k := cols - 1; k >= 0; k-- {
+		q.SetAt(k, k, 1)
+
+		for j := k; j < cols; j++ {
+			if d.qr.At(k, k) == 0 {
+				continue
+			}
+
+			s := 0.0
+			for i := k; i < rows; i++ {
+				s += d.qr.At(i, k) * q.At(i, j)
+			}
+			s = -s / d.qr.At(k, k)
+
+			for i := k; i < rows; i++ {
+				q.AddToAt(i, j, s*d.qr.At(i, k))
+			}
+		}
+	}
+
+	return q
+}
+
+// R returns the n x n upper triangular factor.
+func (d *QR) R() *Matrix {
+	n := d.qr.cols
+	r := MakeSquareMatrix(n)
+	for i := 0; i < n; i++ {
+		r.SetAt(i, i, d.rDiag[i])
+		for j := i + 1; j < n; j++ {
+			r.SetAt(i, j, d.qr.At(i, j))
+		}
+	}
+
+	return r
+}
+
+// Solve computes the least squares solution x of the system A·x = b, which minimizes the norm of
+// A·x - b. When A is square, it's the exact solution.
+// Returns an ErrRankDeficient error if the columns of A aren't linearly independent.
+func (d *QR) Solve(b Vector) (Vector, error) {
+	if len(b) != d.qr.rows {
+		return nil, ErrDimensionMismatch
+	}
+	if !d.IsFullRank() {
+		return nil, ErrRankDeficient
+	}
+
+	var (
+		rows, cols = d.qr.rows, d.qr.cols
+		y          = b.Copy()
+	)
+
+	// Compute Qᵀ·b applying the reflections.
+	for k := 0; k < cols; k++ {
+		s := 0.0
+		for i := k; i < rows; i++ {
+			s += d.qr.At(i, k) * y[i]
+		}
+		s = -s / d.qr.At(k, k)
+
+		for i := k; i < rows; i++ {
+			y[i] += s * d.qr.At(i, k)
+		}
+	}
+
+	// Backward substitution with R.
+	x := make(Vector, cols)
+	for i := cols - 1; i >= 0; i-- {
+		x[i] = y[i]
+		for j := i + 1; j < cols; j++ {
+			x[i] -= d.qr.At(i, j) * x[j]
+		}
+		x[i] /= d.rDiag[i]
+	}
+
+	return x, nil
+}
@@ -0,0 +1,67 @@
+package linalg
+
+import "testing"
+
+func TestQR(t *testing.T) {
+	m := mustMatrix(t,
+		[]float64{12, -51, 4},
+		[]float64{6, 167, -68},
+		[]float64{-4, 24, -41},
+	)
+	qr, _ := m.QR()
+
+	t.Run("Q·R = A", func(t *testing.T) {
+		if got, _ := qr.Q().Times(qr.R()); !got.Equals(m) {
+			t.Errorf("Want %v, got %v", m, got)
+		}
+	})
+
+	t.Run("Q has orthonormal columns", func(t *testing.T) {
+		q := qr.Q()
+		if got, _ := q.Transposed().Times(q); !got.Equals(MakeIdentityMatrix(3)) {
+			t.Errorf("Want the identity, got %v", got)
+		}
+	})
+
+	t.Run("solves a square system", func(t *testing.T) {
+		var (
+			want = Vector{1, 2, 3}
+			b, _ = m.TimesVector(want)
+		)
+
+		if got, _ := qr.Solve(b); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+}
+
+func TestQRLeastSquares(t *testing.T) {
+	// Fit the line y = a + b·x to the points (0, 1), (1, 3), (2, 5) and (3, 7.5).
+	var (
+		m     = mustMatrix(t, []float64{1, 0}, []float64{1, 1}, []float64{1, 2}, []float64{1, 3})
+		b     = Vector{1, 3, 5, 7.5}
+		qr, _ = m.QR()
+		want  = Vector{0.9, 2.15}
+	)
+
+	if got, _ := qr.Solve(b); !got.Equals(want) {
+		t.Errorf("Want %v, got %v", want, got)
+	}
+
+	t.Run("more columns than rows", func(t *testing.T) {
+		if _, err := m.Transposed().QR(); err != ErrDimensionMismatch {
+			t.Errorf("Want ErrDimensionMismatch, got %v", err)
+		}
+	})
+
+	t.Run("rank deficient", func(t *testing.T) {
+		var (
+			deficient = mustMatrix(t, []float64{1, 2}, []float64{2, 4}, []float64{3, 6})
+			qr, _     = deficient.QR()
+		)
+
+		if _, err := qr.Solve(Vector{1, 2, 3}); err != ErrRankDeficient {
+			t.Errorf("Want ErrRankDeficient, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package sparse
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+)
+
+// A Preconditioner approximates the inverse of a matrix, to speed up the convergence of the
+// conjugate gradient method.
+type Preconditioner interface {
+	// Precondition computes the approximation of A⁻¹·r, writing the result in dst.
+	Precondition(dst, r linalg.Vector)
+}
+
+// A JacobiPreconditioner approximates a matrix by its diagonal.
+type JacobiPreconditioner struct {
+	invDiagonal linalg.Vector
+}
+
+// MakeJacobiPreconditioner creates a new Jacobi preconditioner for the matrix.
+// Returns an ErrZeroDiagonal error if any of the elements in the diagonal is zero.
+func MakeJacobiPreconditioner(m *CSR) (*JacobiPreconditioner, error) {
+	diag := m.Diagonal()
+	for i, value := range diag {
+		if value == 0 {
+			return nil, ErrZeroDiagonal
+		}
+		diag[i] = 1 / value
+	}
+
+	return &JacobiPreconditioner{diag}, nil
+}
+
+// Precondition divides each element of r by the corresponding diagonal element.
+func (p *JacobiPreconditioner) Precondition(dst, r linalg.Vector) {
+	for i, value := range r {
+		dst[i] = value * p.invDiagonal[i]
+	}
+}
+
+// ConjugateGradient solves the system A·x = b, where A is a symmetric positive definite matrix,
+// using the preconditioned conjugate gradient method. The preconditioner can be nil.
+//
+// The iterations stop when the norm of the residual b - A·x is smaller than the tolerance times
+// the norm of b. Returns the solution and the number of iterations, or an ErrNoConvergence error
+// if the tolerance isn't reached in the maximum number of iterations.
+func ConjugateGradient(
+	a *CSR,
+	b linalg.Vector,
+	precond Preconditioner,
+	tolerance float64,
+	maxIterations int,
+) (linalg.Vector, int, error) {
+	if a.rows != a.cols {
+		return nil, 0, linalg.ErrNotSquare
+	}
+	if len(b) != a.rows {
+		return nil, 0, linalg.ErrDimensionMismatch
+	}
+
+	var (
+		n         = a.rows
+		x         = make(linalg.Vector, n)
+		r         = b.Copy()
+		z         = make(linalg.Vector, n)
+		ap        = make(linalg.Vector, n)
+		threshold = tolerance * b.Norm()
+	)
+
+	if r.Norm() <= threshold {
+		return x, 0, nil
+	}
+
+	applyPrecond(precond, z, r)
+	var (
+		p     = z.Copy()
+		rz, _ = r.Dot(z)
+	)
+
+	for iter := 1; iter <= maxIterations; iter++ {
+		a.mulVecTo(ap, p)
+
+		pap, _ := p.Dot(ap)
+		if pap <= 0 || math.IsNaN(pap) {
+			return x, iter, ErrNoConvergence
+		}
+
+		alpha := rz / pap
+		for i := range x {
+			x[i] += alpha * p[i]
+			r[i] -= alpha * ap[i]
+		}
+
+		if r.Norm() <= threshold {
+			return x, iter, nil
+		}
+
+		applyPrecond(precond, z, r)
+		rzNext, _ := r.Dot(z)
+		beta := rzNext / rz
+		rz = rzNext
+
+		for i := range p {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+
+	return x, maxIterations, ErrNoConvergence
+}
+
+func applyPrecond(precond Preconditioner, dst, r linalg.Vector) {
+	if precond == nil {
+		copy(dst, r)
+		return
+	}
+
+	precond.Precondition(dst, r)
+}
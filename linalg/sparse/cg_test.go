@@ -0,0 +1,63 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestConjugateGradient(t *testing.T) {
+	const n = 200
+
+	var (
+		m    = makeBarStiffness(n)
+		want = make(linalg.Vector, n)
+	)
+
+	for i := range want {
+		want[i] = float64(i%7) - 3
+	}
+	b, _ := m.MulVec(want)
+
+	t.Run("without preconditioner", func(t *testing.T) {
+		got, iterations, err := ConjugateGradient(m, b, nil, 1e-12, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.EqualsTol(want, nums.MakeAbsoluteTolerance(1e-6)) {
+			t.Errorf("Unexpected solution after %d iterations", iterations)
+		}
+	})
+
+	t.Run("with a Jacobi preconditioner", func(t *testing.T) {
+		precond, err := MakeJacobiPreconditioner(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, _, err := ConjugateGradient(m, b, precond, 1e-12, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.EqualsTol(want, nums.MakeAbsoluteTolerance(1e-6)) {
+			t.Error("Unexpected solution")
+		}
+	})
+
+	t.Run("fails to converge in too few iterations", func(t *testing.T) {
+		if _, _, err := ConjugateGradient(m, b, nil, 1e-12, 5); err != ErrNoConvergence {
+			t.Errorf("Want ErrNoConvergence, got %v", err)
+		}
+	})
+
+	t.Run("zero diagonal", func(t *testing.T) {
+		coo := MakeCOO(2, 2)
+		coo.Add(0, 1, 1)
+		coo.Add(1, 0, 1)
+
+		if _, err := MakeJacobiPreconditioner(coo.ToCSR()); err != ErrZeroDiagonal {
+			t.Errorf("Want ErrZeroDiagonal, got %v", err)
+		}
+	})
+}
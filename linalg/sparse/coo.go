@@ -0,0 +1,103 @@
+package sparse
+
+import "sort"
+
+// A COO is a sparse matrix in coordinate format: a list of (row, column, value) entries.
+// It's meant for assembly: entries can be added in any order, and repeated entries are added up
+// when the matrix is converted to CSR or CSC.
+type COO struct {
+	rows, cols int
+	rowIdx     []int
+	colIdx     []int
+	values     []float64
+}
+
+// MakeCOO creates a new, empty sparse matrix with the given dimensions.
+func MakeCOO(rows, cols int) *COO {
+	return &COO{rows: rows, cols: cols}
+}
+
+// Rows is the number of rows of the matrix.
+func (m *COO) Rows() int {
+	return m.rows
+}
+
+// Cols is the number of columns of the matrix.
+func (m *COO) Cols() int {
+	return m.cols
+}
+
+// Len is the number of entries added to the matrix, including the repeated ones.
+func (m *COO) Len() int {
+	return len(m.values)
+}
+
+// Add adds the value to the one at the given row and column.
+// Returns an ErrIndexOutOfRange error if the position is outside of the matrix.
+func (m *COO) Add(row, col int, value float64) error {
+	if row < 0 || row >= m.rows || col < 0 || col >= m.cols {
+		return ErrIndexOutOfRange
+	}
+
+	m.rowIdx = append(m.rowIdx, row)
+	m.colIdx = append(m.colIdx, col)
+	m.values = append(m.values, value)
+
+	return nil
+}
+
+// ToCSR converts the matrix to the compressed sparse row format, adding up repeated entries.
+func (m *COO) ToCSR() *CSR {
+	rowPtr, colIdx, values := compress(m.rows, m.rowIdx, m.colIdx, m.values)
+	return &CSR{m.rows, m.cols, rowPtr, colIdx, values}
+}
+
+// ToCSC converts the matrix to the compressed sparse column format, adding up repeated entries.
+func (m *COO) ToCSC() *CSC {
+	colPtr, rowIdx, values := compress(m.cols, m.colIdx, m.rowIdx, m.values)
+	return &CSC{m.rows, m.cols, colPtr, rowIdx, values}
+}
+
+// compress sorts the entries by their major index, and then by their minor index, adding up the
+// repeated ones. Returns the pointers to the start of each major index and the minor indices and
+// values of the entries.
+func compress(majorLen int, major, minor []int, values []float64) ([]int, []int, []float64) {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.Slice(order, func(a, b int) bool {
+		ia, ib := order[a], order[b]
+		if major[ia] != major[ib] {
+			return major[ia] < major[ib]
+		}
+		return minor[ia] < minor[ib]
+	})
+
+	var (
+		ptr       = make([]int, majorLen+1)
+		outMinor  = make([]int, 0, len(values))
+		outValues = make([]float64, 0, len(values))
+		lastMajor = -1
+		lastMinor = -1
+	)
+
+	for _, i := range order {
+		if major[i] == lastMajor && minor[i] == lastMinor {
+			outValues[len(outValues)-1] += values[i]
+			continue
+		}
+
+		outMinor = append(outMinor, minor[i])
+		outValues = append(outValues, values[i])
+		ptr[major[i]+1]++
+		lastMajor, lastMinor = major[i], minor[i]
+	}
+
+	for i := 0; i < majorLen; i++ {
+		ptr[i+1] += ptr[i]
+	}
+
+	return ptr, outMinor, outValues
+}
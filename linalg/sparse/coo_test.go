@@ -0,0 +1,43 @@
+package sparse
+
+import "testing"
+
+func TestCOO(t *testing.T) {
+	m := MakeCOO(3, 3)
+	m.Add(0, 0, 1)
+	m.Add(2, 1, 5)
+	m.Add(0, 0, 2)
+	m.Add(1, 2, -1)
+
+	t.Run("out of range entries", func(t *testing.T) {
+		if err := m.Add(3, 0, 1); err != ErrIndexOutOfRange {
+			t.Errorf("Want ErrIndexOutOfRange, got %v", err)
+		}
+	})
+
+	t.Run("repeated entries are added up", func(t *testing.T) {
+		csr := m.ToCSR()
+
+		if got := csr.NonZeros(); got != 3 {
+			t.Errorf("Want 3 non zeros, got %d", got)
+		}
+		if got := csr.At(0, 0); got != 3 {
+			t.Errorf("Want 3, got %f", got)
+		}
+	})
+
+	t.Run("CSR and CSC have the same entries", func(t *testing.T) {
+		var (
+			csr = m.ToCSR()
+			csc = m.ToCSC()
+		)
+
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				if csr.At(i, j) != csc.At(i, j) {
+					t.Errorf("Different values at (%d, %d): %f and %f", i, j, csr.At(i, j), csc.At(i, j))
+				}
+			}
+		}
+	})
+}
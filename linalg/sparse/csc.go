@@ -0,0 +1,54 @@
+package sparse
+
+import "github.com/angelsolaorbaiceta/inkgeom/linalg"
+
+// A CSC is a sparse matrix in compressed sparse column format. The row indices and values of the
+// non-zero entries of column j are stored, sorted by row, in the range [colPtr[j], colPtr[j+1]).
+type CSC struct {
+	rows, cols int
+	colPtr     []int
+	rowIdx     []int
+	values     []float64
+}
+
+// Rows is the number of rows of the matrix.
+func (m *CSC) Rows() int {
+	return m.rows
+}
+
+// Cols is the number of columns of the matrix.
+func (m *CSC) Cols() int {
+	return m.cols
+}
+
+// NonZeros is the number of stored entries.
+func (m *CSC) NonZeros() int {
+	return len(m.values)
+}
+
+// At returns the value at the given row and column, which is zero if it isn't stored.
+func (m *CSC) At(row, col int) float64 {
+	return lookup(m.colPtr, m.rowIdx, m.values, col, row)
+}
+
+// MulVec computes the product of this matrix with the vector.
+func (m *CSC) MulVec(x linalg.Vector) (linalg.Vector, error) {
+	if len(x) != m.cols {
+		return nil, linalg.ErrDimensionMismatch
+	}
+
+	result := make(linalg.Vector, m.rows)
+	for j := 0; j < m.cols; j++ {
+		for k := m.colPtr[j]; k < m.colPtr[j+1]; k++ {
+			result[m.rowIdx[k]] += m.values[k] * x[j]
+		}
+	}
+
+	return result, nil
+}
+
+// ToCSR converts the matrix to the compressed sparse row format.
+func (m *CSC) ToCSR() *CSR {
+	rowPtr, colIdx, values := transpose(m.cols, m.rows, m.colPtr, m.rowIdx, m.values)
+	return &CSR{m.rows, m.cols, rowPtr, colIdx, values}
+}
@@ -0,0 +1,32 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+)
+
+func TestCSC(t *testing.T) {
+	coo := MakeCOO(2, 3)
+	coo.Add(0, 1, 2)
+	coo.Add(1, 0, 3)
+	coo.Add(1, 2, 4)
+
+	var (
+		csc = coo.ToCSC()
+		x   = linalg.Vector{1, 2, 3}
+	)
+
+	if csc.Rows() != 2 || csc.Cols() != 3 || csc.NonZeros() != 3 {
+		t.Errorf("Unexpected dimensions %dx%d with %d non zeros", csc.Rows(), csc.Cols(), csc.NonZeros())
+	}
+	if got := csc.At(1, 2); got != 4 {
+		t.Errorf("Want 4, got %f", got)
+	}
+	if got, want := csc.At(0, 0), 0.0; got != want {
+		t.Errorf("Want %f, got %f", want, got)
+	}
+	if got, _ := csc.MulVec(x); !got.Equals(linalg.Vector{4, 15}) {
+		t.Errorf("Want {4, 15}, got %v", got)
+	}
+}
@@ -0,0 +1,137 @@
+package sparse
+
+import (
+	"sort"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+)
+
+// A CSR is a sparse matrix in compressed sparse row format. The column indices and values of the
+// non-zero entries of row i are stored, sorted by column, in the range [rowPtr[i], rowPtr[i+1]).
+type CSR struct {
+	rows, cols int
+	rowPtr     []int
+	colIdx     []int
+	values     []float64
+}
+
+// Rows is the number of rows of the matrix.
+func (m *CSR) Rows() int {
+	return m.rows
+}
+
+// Cols is the number of columns of the matrix.
+func (m *CSR) Cols() int {
+	return m.cols
+}
+
+// NonZeros is the number of stored entries.
+func (m *CSR) NonZeros() int {
+	return len(m.values)
+}
+
+// At returns the value at the given row and column, which is zero if it isn't stored.
+func (m *CSR) At(row, col int) float64 {
+	return lookup(m.rowPtr, m.colIdx, m.values, row, col)
+}
+
+// Diagonal returns the values in the main diagonal of the matrix.
+func (m *CSR) Diagonal() linalg.Vector {
+	diag := make(linalg.Vector, minInt(m.rows, m.cols))
+	for i := range diag {
+		diag[i] = m.At(i, i)
+	}
+
+	return diag
+}
+
+// MulVec computes the product of this matrix with the vector.
+func (m *CSR) MulVec(x linalg.Vector) (linalg.Vector, error) {
+	if len(x) != m.cols {
+		return nil, linalg.ErrDimensionMismatch
+	}
+
+	result := make(linalg.Vector, m.rows)
+	m.mulVecTo(result, x)
+
+	return result, nil
+}
+
+// ToCSC converts the matrix to the compressed sparse column format.
+func (m *CSR) ToCSC() *CSC {
+	colPtr, rowIdx, values := transpose(m.rows, m.cols, m.rowPtr, m.colIdx, m.values)
+	return &CSC{m.rows, m.cols, colPtr, rowIdx, values}
+}
+
+// ToDense converts the matrix to a dense one.
+func (m *CSR) ToDense() *linalg.Matrix {
+	dense := linalg.MakeMatrix(m.rows, m.cols)
+	for i := 0; i < m.rows; i++ {
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			dense.SetAt(i, m.colIdx[k], m.values[k])
+		}
+	}
+
+	return dense
+}
+
+// mulVecTo computes the product of this matrix with x, writing the result in dst.
+func (m *CSR) mulVecTo(dst, x linalg.Vector) {
+	for i := 0; i < m.rows; i++ {
+		sum := 0.0
+		for k := m.rowPtr[i]; k < m.rowPtr[i+1]; k++ {
+			sum += m.values[k] * x[m.colIdx[k]]
+		}
+		dst[i] = sum
+	}
+}
+
+// lookup finds the value at the given major and minor indices in a compressed format.
+func lookup(ptr, minorIdx []int, values []float64, major, minor int) float64 {
+	var (
+		start, end = ptr[major], ptr[major+1]
+		k          = start + sort.SearchInts(minorIdx[start:end], minor)
+	)
+
+	if k < end && minorIdx[k] == minor {
+		return values[k]
+	}
+
+	return 0
+}
+
+// transpose converts a compressed format with the given number of major and minor indices to the
+// compressed format of the transposed matrix, keeping the minor indices sorted.
+func transpose(majorLen, minorLen int, ptr, minorIdx []int, values []float64) ([]int, []int, []float64) {
+	var (
+		outPtr   = make([]int, minorLen+1)
+		outMinor = make([]int, len(values))
+		outVals  = make([]float64, len(values))
+	)
+
+	for _, minor := range minorIdx {
+		outPtr[minor+1]++
+	}
+	for i := 0; i < minorLen; i++ {
+		outPtr[i+1] += outPtr[i]
+	}
+
+	next := append([]int(nil), outPtr[:minorLen]...)
+	for major := 0; major < majorLen; major++ {
+		for k := ptr[major]; k < ptr[major+1]; k++ {
+			pos := next[minorIdx[k]]
+			outMinor[pos] = major
+			outVals[pos] = values[k]
+			next[minorIdx[k]]++
+		}
+	}
+
+	return outPtr, outMinor, outVals
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
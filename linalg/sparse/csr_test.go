@@ -0,0 +1,66 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+)
+
+// makeBarStiffness assembles the stiffness matrix of a bar divided in n elements, fixed at its
+// first node, which is tridiagonal, symmetric and positive definite.
+func makeBarStiffness(n int) *CSR {
+	coo := MakeCOO(n, n)
+	for e := 0; e < n; e++ {
+		// Element between the nodes e and e + 1, where node 0 is fixed and removed.
+		i, j := e-1, e
+		if i >= 0 {
+			coo.Add(i, i, 1)
+			coo.Add(i, j, -1)
+			coo.Add(j, i, -1)
+		}
+		coo.Add(j, j, 1)
+	}
+
+	return coo.ToCSR()
+}
+
+func TestCSR(t *testing.T) {
+	m := makeBarStiffness(4)
+
+	t.Run("values", func(t *testing.T) {
+		want, _ := linalg.MakeMatrixFromRows(
+			[]float64{2, -1, 0, 0},
+			[]float64{-1, 2, -1, 0},
+			[]float64{0, -1, 2, -1},
+			[]float64{0, 0, -1, 1},
+		)
+
+		if got := m.ToDense(); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("diagonal", func(t *testing.T) {
+		if got, want := m.Diagonal(), (linalg.Vector{2, 2, 2, 1}); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("product with a vector", func(t *testing.T) {
+		x := linalg.Vector{1, 2, 3, 4}
+		want, _ := m.ToDense().TimesVector(x)
+
+		if got, _ := m.MulVec(x); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+		if _, err := m.MulVec(linalg.Vector{1}); err != linalg.ErrDimensionMismatch {
+			t.Errorf("Want ErrDimensionMismatch, got %v", err)
+		}
+	})
+
+	t.Run("round trip through CSC", func(t *testing.T) {
+		if got := m.ToCSC().ToCSR().ToDense(); !got.Equals(m.ToDense()) {
+			t.Errorf("Want %v, got %v", m.ToDense(), got)
+		}
+	})
+}
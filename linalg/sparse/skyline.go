@@ -0,0 +1,151 @@
+package sparse
+
+import (
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A SkylineLDL is the decomposition A = L·D·Lᵀ of a symmetric matrix A, where L is lower
+// triangular with a unit diagonal and D is diagonal.
+//
+// L is stored in skyline (or profile) format: for each row, the values from its first non-zero
+// entry up to the diagonal. The decomposition doesn't create non-zeros outside of this profile,
+// so its memory use depends on the bandwidth of the matrix, which can be reduced renumbering the
+// degrees of freedom.
+type SkylineLDL struct {
+	first  []int
+	rowPtr []int
+	values []float64
+	diag   linalg.Vector
+}
+
+// FactorizeSkylineLDL computes the LDLᵀ decomposition of the symmetric matrix. Only the entries
+// below or on the diagonal are read.
+//
+// Returns an ErrNotSquare error if the matrix isn't square, and an ErrSingular error if a zero
+// pivot is found.
+func FactorizeSkylineLDL(a *CSR) (*SkylineLDL, error) {
+	if a.rows != a.cols {
+		return nil, linalg.ErrNotSquare
+	}
+
+	var (
+		n      = a.rows
+		first  = make([]int, n)
+		rowPtr = make([]int, n+1)
+	)
+
+	// The profile of each row starts at its first non-zero entry.
+	for i := 0; i < n; i++ {
+		first[i] = i
+		if start := a.rowPtr[i]; start < a.rowPtr[i+1] && a.colIdx[start] < i {
+			first[i] = a.colIdx[start]
+		}
+		rowPtr[i+1] = rowPtr[i] + i - first[i]
+	}
+
+	var (
+		ldl = &SkylineLDL{first, rowPtr, make([]float64, rowPtr[n]), make(linalg.Vector, n)}
+		row = make([]float64, n)
+	)
+
+	threshold := float64(n) * nums.MachineEpsilon * maxAbsValue(a.values)
+
+	for i := 0; i < n; i++ {
+		// Scatter the lower part of row i of A.
+		diag := 0.0
+		for k := a.rowPtr[i]; k < a.rowPtr[i+1]; k++ {
+			switch col := a.colIdx[k]; {
+			case col < i:
+				row[col] = a.values[k]
+			case col == i:
+				diag = a.values[k]
+			}
+		}
+
+		// g_ij = a_ij - Σ l_ik·d_k·l_jk, where l_ij = g_ij / d_j.
+		for j := first[i]; j < i; j++ {
+			g := row[j]
+			for k := maxInt(first[i], first[j]); k < j; k++ {
+				g -= row[k] * ldl.diag[k] * ldl.at(j, k)
+			}
+			row[j] = g / ldl.diag[j]
+			diag -= row[j] * g
+		}
+
+		if math.Abs(diag) <= threshold {
+			return nil, linalg.ErrSingular
+		}
+
+		ldl.diag[i] = diag
+		copy(ldl.values[rowPtr[i]:rowPtr[i+1]], row[first[i]:i])
+		for j := first[i]; j < i; j++ {
+			row[j] = 0
+		}
+	}
+
+	return ldl, nil
+}
+
+// ProfileSize is the number of values stored for the strictly lower part of L.
+func (d *SkylineLDL) ProfileSize() int {
+	return len(d.values)
+}
+
+// D returns the diagonal values of D.
+func (d *SkylineLDL) D() linalg.Vector {
+	return d.diag.Copy()
+}
+
+// Solve computes the solution x of the system A·x = b.
+func (d *SkylineLDL) Solve(b linalg.Vector) (linalg.Vector, error) {
+	n := len(d.diag)
+	if len(b) != n {
+		return nil, linalg.ErrDimensionMismatch
+	}
+
+	x := b.Copy()
+
+	// Forward substitution with L.
+	for i := 0; i < n; i++ {
+		for j := d.first[i]; j < i; j++ {
+			x[i] -= d.at(i, j) * x[j]
+		}
+	}
+
+	for i := range x {
+		x[i] /= d.diag[i]
+	}
+
+	// Backward substitution with Lᵀ, by columns.
+	for i := n - 1; i >= 0; i-- {
+		for j := d.first[i]; j < i; j++ {
+			x[j] -= d.at(i, j) * x[i]
+		}
+	}
+
+	return x, nil
+}
+
+// at returns the value of L at row i and column j, which must be in the row's profile.
+func (d *SkylineLDL) at(i, j int) float64 {
+	return d.values[d.rowPtr[i]+j-d.first[i]]
+}
+
+func maxAbsValue(values []float64) float64 {
+	result := 0.0
+	for _, value := range values {
+		result = math.Max(result, math.Abs(value))
+	}
+
+	return result
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
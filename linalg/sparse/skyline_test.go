@@ -0,0 +1,73 @@
+package sparse
+
+import (
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/linalg"
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestSkylineLDL(t *testing.T) {
+	t.Run("solves a banded system", func(t *testing.T) {
+		var (
+			m    = makeBarStiffness(50)
+			want = make(linalg.Vector, 50)
+		)
+
+		for i := range want {
+			want[i] = float64(i) / 10
+		}
+		b, _ := m.MulVec(want)
+
+		ldl, err := FactorizeSkylineLDL(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ldl.ProfileSize(); got != 49 {
+			t.Errorf("Want a profile of 49 values, got %d", got)
+		}
+		if got, _ := ldl.Solve(b); !got.EqualsTol(want, nums.MakeAbsoluteTolerance(1e-9)) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("fills in the profile", func(t *testing.T) {
+		dense, _ := linalg.MakeMatrixFromRows(
+			[]float64{4, 1, 0, 2},
+			[]float64{1, 5, 1, 0},
+			[]float64{0, 1, 6, 1},
+			[]float64{2, 0, 1, -3},
+		)
+
+		coo := MakeCOO(4, 4)
+		for i := 0; i < 4; i++ {
+			for j := 0; j < 4; j++ {
+				if value := dense.At(i, j); value != 0 {
+					coo.Add(i, j, value)
+				}
+			}
+		}
+
+		var (
+			want   = linalg.Vector{1, -2, 3, 0.5}
+			b, _   = dense.TimesVector(want)
+			ldl, _ = FactorizeSkylineLDL(coo.ToCSR())
+		)
+
+		if got, _ := ldl.Solve(b); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("singular matrix", func(t *testing.T) {
+		coo := MakeCOO(2, 2)
+		coo.Add(0, 0, 1)
+		coo.Add(0, 1, 1)
+		coo.Add(1, 0, 1)
+		coo.Add(1, 1, 1)
+
+		if _, err := FactorizeSkylineLDL(coo.ToCSR()); err != linalg.ErrSingular {
+			t.Errorf("Want ErrSingular, got %v", err)
+		}
+	})
+}
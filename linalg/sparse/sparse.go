@@ -0,0 +1,20 @@
+// Package sparse implements sparse matrix storage formats, and the solvers for the large, sparse
+// systems of equations that appear when assembling the global stiffness matrix of a structure.
+//
+// Matrices are assembled in the coordinate (COO) format, which accepts the entries in any order
+// and adds up repeated ones, and then converted to the compressed sparse row (CSR) or column
+// (CSC) formats for the computations.
+package sparse
+
+import "errors"
+
+var (
+	// ErrIndexOutOfRange is returned when an entry is added outside of the matrix's dimensions.
+	ErrIndexOutOfRange = errors.New("the index is out of the matrix's range")
+	// ErrNoConvergence is returned when an iterative solver doesn't reach the tolerance in the
+	// maximum number of iterations.
+	ErrNoConvergence = errors.New("the solver didn't converge in the maximum number of iterations")
+	// ErrZeroDiagonal is returned when a matrix has a zero in its diagonal, where a non-zero value
+	// is required.
+	ErrZeroDiagonal = errors.New("the matrix has a zero diagonal element")
+)
@@ -0,0 +1,135 @@
+package linalg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+// A Vector is a list of numbers, used as the right-hand side and solution of linear systems.
+type Vector []float64
+
+// MakeVector creates a new vector of the given size, with all its elements set to zero.
+func MakeVector(size int) Vector {
+	return make(Vector, size)
+}
+
+// Copy creates a new vector with the same elements.
+func (v Vector) Copy() Vector {
+	return append(Vector(nil), v...)
+}
+
+// Dot computes the dot product of this and other vector.
+func (v Vector) Dot(other Vector) (float64, error) {
+	if len(v) != len(other) {
+		return 0, ErrDimensionMismatch
+	}
+
+	sum := 0.0
+	for i, value := range v {
+		sum += value * other[i]
+	}
+
+	return sum, nil
+}
+
+// Plus creates a new vector adding this and other.
+func (v Vector) Plus(other Vector) (Vector, error) {
+	if len(v) != len(other) {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := make(Vector, len(v))
+	for i, value := range v {
+		result[i] = value + other[i]
+	}
+
+	return result, nil
+}
+
+// Minus creates a new vector subtracting other from this.
+func (v Vector) Minus(other Vector) (Vector, error) {
+	if len(v) != len(other) {
+		return nil, ErrDimensionMismatch
+	}
+
+	result := make(Vector, len(v))
+	for i, value := range v {
+		result[i] = value - other[i]
+	}
+
+	return result, nil
+}
+
+// Scaled creates a new vector with the elements scaled the given factor.
+func (v Vector) Scaled(factor float64) Vector {
+	result := make(Vector, len(v))
+	for i, value := range v {
+		result[i] = value * factor
+	}
+
+	return result
+}
+
+// Norm computes the Euclidean norm of the vector.
+func (v Vector) Norm() float64 {
+	// Scaled to avoid overflows and underflows.
+	scale := v.NormInf()
+	if scale == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, value := range v {
+		scaled := value / scale
+		sum += scaled * scaled
+	}
+
+	return scale * math.Sqrt(sum)
+}
+
+// Norm1 computes the sum of the absolute values of the elements.
+func (v Vector) Norm1() float64 {
+	sum := 0.0
+	for _, value := range v {
+		sum += math.Abs(value)
+	}
+
+	return sum
+}
+
+// NormInf computes the largest absolute value of the elements.
+func (v Vector) NormInf() float64 {
+	norm := 0.0
+	for _, value := range v {
+		norm = math.Max(norm, math.Abs(value))
+	}
+
+	return norm
+}
+
+// Equals returns true if both vectors have the same size and equal elements.
+func (v Vector) Equals(other Vector) bool {
+	return v.EqualsTol(other, nums.DefaultTolerance)
+}
+
+// EqualsTol returns true if both vectors have the same size and equal elements within the given
+// tolerance.
+func (v Vector) EqualsTol(other Vector, tol nums.Tolerance) bool {
+	if len(v) != len(other) {
+		return false
+	}
+
+	for i, value := range v {
+		if !tol.Equal(value, other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v Vector) String() string {
+	return fmt.Sprintf("Vector%v", []float64(v))
+}
@@ -0,0 +1,57 @@
+package linalg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/angelsolaorbaiceta/inkgeom/nums"
+)
+
+func TestVectorOperations(t *testing.T) {
+	var (
+		u = Vector{1, 2, 3}
+		v = Vector{4, -5, 6}
+	)
+
+	t.Run("dot product", func(t *testing.T) {
+		if got, _ := u.Dot(v); !nums.FloatsEqual(got, 12) {
+			t.Errorf("Want 12, got %f", got)
+		}
+	})
+
+	t.Run("sum and subtraction", func(t *testing.T) {
+		sum, _ := u.Plus(v)
+		diff, _ := u.Minus(v)
+
+		if want := (Vector{5, -3, 9}); !sum.Equals(want) {
+			t.Errorf("Want %v, got %v", want, sum)
+		}
+		if want := (Vector{-3, 7, -3}); !diff.Equals(want) {
+			t.Errorf("Want %v, got %v", want, diff)
+		}
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		if _, err := u.Plus(Vector{1}); err != ErrDimensionMismatch {
+			t.Errorf("Want ErrDimensionMismatch, got %v", err)
+		}
+	})
+
+	t.Run("norms", func(t *testing.T) {
+		if got := v.Norm(); !nums.FloatsEqual(got, math.Sqrt(77)) {
+			t.Errorf("Want %f, got %f", math.Sqrt(77), got)
+		}
+		if got := v.Norm1(); !nums.FloatsEqual(got, 15) {
+			t.Errorf("Want 15, got %f", got)
+		}
+		if got := v.NormInf(); !nums.FloatsEqual(got, 6) {
+			t.Errorf("Want 6, got %f", got)
+		}
+	})
+
+	t.Run("norm doesn't overflow", func(t *testing.T) {
+		if got := (Vector{3e200, 4e200}).Norm(); !nums.MakeRelativeTolerance(1e-12).Equal(got, 5e200) {
+			t.Errorf("Want 5e200, got %g", got)
+		}
+	})
+}
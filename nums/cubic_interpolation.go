@@ -0,0 +1,233 @@
+package nums
+
+import "math"
+
+/*
+A PiecewiseCubic interpolates a list of (x, y) pairs, sorted by x, with a cubic polynomial
+between each two consecutive points. The cubics are defined by the values and slopes at their
+ends (Hermite form), so the interpolation and its first derivative are continuous.
+
+Values outside of the range of the points are extrapolated using the first and last cubics.
+*/
+type PiecewiseCubic struct {
+	xs, ys, slopes []float64
+}
+
+/* <-- Construction --> */
+
+/*
+MakeNaturalCubicSpline returns the cubic spline interpolating the given points whose second
+derivative is zero at both ends.
+
+A non-nil error is returned if there aren't the same number of x and y values, there are less
+than two values or the x values aren't strictly increasing.
+*/
+func MakeNaturalCubicSpline(xs, ys []float64) (*PiecewiseCubic, error) {
+	if err := validateInterpolationPoints(xs, ys, 2); err != nil {
+		return nil, err
+	}
+
+	return makeCubicSpline(xs, ys, false, 0, 0), nil
+}
+
+/*
+MakeClampedCubicSpline returns the cubic spline interpolating the given points whose first
+derivative at the ends is startSlope and endSlope.
+
+A non-nil error is returned if there aren't the same number of x and y values, there are less
+than two values or the x values aren't strictly increasing.
+*/
+func MakeClampedCubicSpline(xs, ys []float64, startSlope, endSlope float64) (*PiecewiseCubic, error) {
+	if err := validateInterpolationPoints(xs, ys, 2); err != nil {
+		return nil, err
+	}
+
+	return makeCubicSpline(xs, ys, true, startSlope, endSlope), nil
+}
+
+/*
+MakePCHIP returns the piecewise cubic Hermite interpolation of the given points whose slopes
+are chosen using the Fritsch–Carlson method to preserve the monotonicity of the data: the
+interpolation doesn't overshoot, and it's monotone wherever the points are. Material curves
+and load tables are usually better interpolated this way than with splines.
+
+A non-nil error is returned if there aren't the same number of x and y values, there are less
+than two values or the x values aren't strictly increasing.
+*/
+func MakePCHIP(xs, ys []float64) (*PiecewiseCubic, error) {
+	if err := validateInterpolationPoints(xs, ys, 2); err != nil {
+		return nil, err
+	}
+
+	var (
+		n      = len(xs)
+		h      = make([]float64, n-1)
+		deltas = make([]float64, n-1)
+		slopes = make([]float64, n)
+	)
+
+	for i := 0; i < n-1; i++ {
+		h[i] = xs[i+1] - xs[i]
+		deltas[i] = (ys[i+1] - ys[i]) / h[i]
+	}
+
+	if n == 2 {
+		slopes[0], slopes[1] = deltas[0], deltas[0]
+		return &PiecewiseCubic{copyFloats(xs), copyFloats(ys), slopes}, nil
+	}
+
+	// Interior slopes are the weighted harmonic mean of the adjacent secants, or zero at extrema.
+	for i := 1; i < n-1; i++ {
+		if deltas[i-1]*deltas[i] <= 0 {
+			continue
+		}
+
+		var (
+			w1 = 2*h[i] + h[i-1]
+			w2 = h[i] + 2*h[i-1]
+		)
+
+		slopes[i] = (w1 + w2) / (w1/deltas[i-1] + w2/deltas[i])
+	}
+
+	slopes[0] = pchipEndSlope(h[0], h[1], deltas[0], deltas[1])
+	slopes[n-1] = pchipEndSlope(h[n-2], h[n-3], deltas[n-2], deltas[n-3])
+
+	return &PiecewiseCubic{copyFloats(xs), copyFloats(ys), slopes}, nil
+}
+
+/* <-- Evaluation --> */
+
+/*
+ValueAt computes the interpolated value at x.
+*/
+func (c *PiecewiseCubic) ValueAt(x float64) float64 {
+	var (
+		i     = intervalIndex(c.xs, x)
+		h     = c.xs[i+1] - c.xs[i]
+		t     = (x - c.xs[i]) / h
+		t2    = t * t
+		t3    = t2 * t
+		h00   = 2*t3 - 3*t2 + 1
+		h10   = t3 - 2*t2 + t
+		h01   = -2*t3 + 3*t2
+		h11   = t3 - t2
+		start = c.ys[i]
+		end   = c.ys[i+1]
+	)
+
+	return h00*start + h10*h*c.slopes[i] + h01*end + h11*h*c.slopes[i+1]
+}
+
+/*
+DerivativeAt computes the first derivative of the interpolation at x.
+*/
+func (c *PiecewiseCubic) DerivativeAt(x float64) float64 {
+	var (
+		i   = intervalIndex(c.xs, x)
+		h   = c.xs[i+1] - c.xs[i]
+		t   = (x - c.xs[i]) / h
+		t2  = t * t
+		d00 = (6*t2 - 6*t) / h
+		d10 = 3*t2 - 4*t + 1
+		d01 = (-6*t2 + 6*t) / h
+		d11 = 3*t2 - 2*t
+	)
+
+	return d00*c.ys[i] + d10*c.slopes[i] + d01*c.ys[i+1] + d11*c.slopes[i+1]
+}
+
+/*
+Slopes returns a copy of the first derivatives of the interpolation at each of its points.
+*/
+func (c *PiecewiseCubic) Slopes() []float64 {
+	return copyFloats(c.slopes)
+}
+
+// makeCubicSpline solves the tridiagonal system for the second derivatives at the points and
+// converts them to the slopes of the Hermite form.
+func makeCubicSpline(xs, ys []float64, clamped bool, startSlope, endSlope float64) *PiecewiseCubic {
+	var (
+		n      = len(xs)
+		h      = make([]float64, n-1)
+		deltas = make([]float64, n-1)
+		lower  = make([]float64, n)
+		diag   = make([]float64, n)
+		upper  = make([]float64, n)
+		rhs    = make([]float64, n)
+	)
+
+	for i := 0; i < n-1; i++ {
+		h[i] = xs[i+1] - xs[i]
+		deltas[i] = (ys[i+1] - ys[i]) / h[i]
+	}
+
+	for i := 1; i < n-1; i++ {
+		lower[i] = h[i-1]
+		diag[i] = 2 * (h[i-1] + h[i])
+		upper[i] = h[i]
+		rhs[i] = 6 * (deltas[i] - deltas[i-1])
+	}
+
+	if clamped {
+		diag[0], upper[0], rhs[0] = 2*h[0], h[0], 6*(deltas[0]-startSlope)
+		lower[n-1], diag[n-1], rhs[n-1] = h[n-2], 2*h[n-2], 6*(endSlope-deltas[n-2])
+	} else {
+		diag[0], diag[n-1] = 1, 1
+	}
+
+	second := solveTridiagonal(lower, diag, upper, rhs)
+
+	slopes := make([]float64, n)
+	for i := 0; i < n-1; i++ {
+		slopes[i] = deltas[i] - h[i]*(2*second[i]+second[i+1])/6
+	}
+	slopes[n-1] = deltas[n-2] + h[n-2]*(second[n-2]+2*second[n-1])/6
+
+	return &PiecewiseCubic{copyFloats(xs), copyFloats(ys), slopes}
+}
+
+// solveTridiagonal solves a diagonally dominant tridiagonal system using the Thomas algorithm.
+// The lower and upper diagonals are indexed by row: lower[0] and upper[n-1] are ignored.
+func solveTridiagonal(lower, diag, upper, rhs []float64) []float64 {
+	var (
+		n     = len(diag)
+		c     = make([]float64, n)
+		d     = make([]float64, n)
+		x     = make([]float64, n)
+		denom = diag[0]
+	)
+
+	c[0], d[0] = upper[0]/denom, rhs[0]/denom
+	for i := 1; i < n; i++ {
+		denom = diag[i] - lower[i]*c[i-1]
+		c[i] = upper[i] / denom
+		d[i] = (rhs[i] - lower[i]*d[i-1]) / denom
+	}
+
+	x[n-1] = d[n-1]
+	for i := n - 2; i >= 0; i-- {
+		x[i] = d[i] - c[i]*x[i+1]
+	}
+
+	return x
+}
+
+// pchipEndSlope computes the slope at an end point using a non-centered, shape-preserving
+// three-point formula, where h0 and delta0 belong to the interval at the end.
+func pchipEndSlope(h0, h1, delta0, delta1 float64) float64 {
+	slope := ((2*h0+h1)*delta0 - h0*delta1) / (h0 + h1)
+
+	switch {
+	case math.Signbit(slope) != math.Signbit(delta0) || delta0 == 0:
+		return 0
+	case math.Signbit(delta0) != math.Signbit(delta1) && math.Abs(slope) > 3*math.Abs(delta0):
+		return 3 * delta0
+	default:
+		return slope
+	}
+}
+
+func copyFloats(values []float64) []float64 {
+	return append([]float64(nil), values...)
+}
@@ -0,0 +1,125 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNaturalCubicSpline(t *testing.T) {
+	var (
+		xs = []float64{0, 1, 2, 3}
+		ys = []float64{0, 1, 0, 1}
+	)
+
+	spline, err := MakeNaturalCubicSpline(xs, ys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("interpolates the points", func(t *testing.T) {
+		for i, x := range xs {
+			if got := spline.ValueAt(x); !FloatsEqual(got, ys[i]) {
+				t.Errorf("Want %f at %f, got %f", ys[i], x, got)
+			}
+		}
+	})
+
+	t.Run("zero second derivative at the ends", func(t *testing.T) {
+		const h = 1e-4
+		for _, x := range []float64{0, 3} {
+			second := (spline.DerivativeAt(x+h) - spline.DerivativeAt(x-h)) / (2 * h)
+			if math.Abs(second) > 1e-6 {
+				t.Errorf("Want zero second derivative at %f, got %f", x, second)
+			}
+		}
+	})
+
+	t.Run("continuous derivative", func(t *testing.T) {
+		const h = 1e-9
+		if left, right := spline.DerivativeAt(1-h), spline.DerivativeAt(1+h); math.Abs(left-right) > 1e-6 {
+			t.Errorf("Want equal derivatives, got %f and %f", left, right)
+		}
+	})
+
+	t.Run("two points", func(t *testing.T) {
+		line, _ := MakeNaturalCubicSpline([]float64{0, 2}, []float64{1, 5})
+		if got := line.ValueAt(0.5); !FloatsEqual(got, 2) {
+			t.Errorf("Want 2, got %f", got)
+		}
+	})
+}
+
+func TestClampedCubicSpline(t *testing.T) {
+	// A clamped spline reproduces a cubic polynomial exactly.
+	var (
+		cubic = func(x float64) float64 { return x*x*x - 2*x + 1 }
+		deriv = func(x float64) float64 { return 3*x*x - 2 }
+		xs    = []float64{-1, 0, 0.5, 2}
+		ys    = make([]float64, len(xs))
+		tol   = MakeAbsoluteTolerance(1e-9)
+	)
+
+	for i, x := range xs {
+		ys[i] = cubic(x)
+	}
+
+	spline, err := MakeClampedCubicSpline(xs, ys, deriv(-1), deriv(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, x := range []float64{-0.7, 0.25, 1.3} {
+		if got, want := spline.ValueAt(x), cubic(x); !tol.Equal(got, want) {
+			t.Errorf("Want %f at %f, got %f", want, x, got)
+		}
+		if got, want := spline.DerivativeAt(x), deriv(x); !tol.Equal(got, want) {
+			t.Errorf("Want derivative %f at %f, got %f", want, x, got)
+		}
+	}
+}
+
+func TestPCHIP(t *testing.T) {
+	var (
+		xs = []float64{0, 1, 2, 3, 4}
+		ys = []float64{0, 0, 1, 1, 3}
+	)
+
+	pchip, err := MakePCHIP(xs, ys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("interpolates the points", func(t *testing.T) {
+		for i, x := range xs {
+			if got := pchip.ValueAt(x); !FloatsEqual(got, ys[i]) {
+				t.Errorf("Want %f at %f, got %f", ys[i], x, got)
+			}
+		}
+	})
+
+	t.Run("preserves monotonicity", func(t *testing.T) {
+		previous := pchip.ValueAt(0)
+		for x := 0.01; x <= 4; x += 0.01 {
+			value := pchip.ValueAt(x)
+			if value < previous-1e-12 {
+				t.Fatalf("Decreasing value at %f: %f < %f", x, value, previous)
+			}
+			previous = value
+		}
+	})
+
+	t.Run("flat segments stay flat", func(t *testing.T) {
+		if got := pchip.ValueAt(0.5); !FloatsEqual(got, 0) {
+			t.Errorf("Want 0, got %f", got)
+		}
+		if got := pchip.ValueAt(2.5); !FloatsEqual(got, 1) {
+			t.Errorf("Want 1, got %f", got)
+		}
+	})
+
+	t.Run("invalid points", func(t *testing.T) {
+		if _, err := MakePCHIP([]float64{0, 0}, []float64{1, 2}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
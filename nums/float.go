@@ -0,0 +1,85 @@
+package nums
+
+import "math"
+
+// Float is the constraint satisfied by the floating-point types, float32 and float64, and the
+// types defined on them.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Epsilon returns the default epsilon used to compare numbers of type T: 1E-10 for float64 and
+// 1E-5 for float32, whose precision is much smaller.
+func Epsilon[T Float]() T {
+	if isFloat32[T]() {
+		return T(defaultEpsilon32)
+	}
+
+	return T(defaultEpsilon)
+}
+
+// EqualEps compares two floating-point values and returns true if the difference between the
+// two is smaller than the given epsilon. It's the generic version of FloatsEqualEps.
+func EqualEps[T Float](a, b, epsilon T) bool {
+	diff := a - b
+	return diff < epsilon && -diff < epsilon
+}
+
+// Equal compares two floating-point values and returns true if the difference between the two
+// is smaller than the default epsilon for their type. It's the generic version of FloatsEqual.
+func Equal[T Float](a, b T) bool {
+	return EqualEps(a, b, Epsilon[T]())
+}
+
+// IsZero returns true if the number is closer to zero than the default epsilon for its type.
+func IsZero[T Float](a T) bool {
+	return Equal(a, 0)
+}
+
+// EqualTol returns true if the two numbers are equal within the tolerance. Unlike converting
+// the numbers to float64 and using Tolerance.Equal, the units in the last place of a ULP
+// tolerance are those of type T.
+func EqualTol[T Float](a, b T, tol Tolerance) bool {
+	if !isFloat32[T]() || tol.ulps == 0 {
+		return tol.Equal(float64(a), float64(b))
+	}
+
+	// The absolute and relative checks, without the ULPs of float64.
+	tol64 := tol
+	tol64.ulps = 0
+	if tol64.Equal(float64(a), float64(b)) {
+		return true
+	}
+	if math.IsNaN(float64(a)) || math.IsNaN(float64(b)) {
+		return false
+	}
+
+	return ulpsBetween32(float32(a), float32(b)) <= tol.ulps
+}
+
+const defaultEpsilon32 = 1e-5
+
+func isFloat32[T Float]() bool {
+	// Converting a value which isn't representable as a float32 reveals the type's precision.
+	var probe T = T(math.SmallestNonzeroFloat64)
+	return probe == 0
+}
+
+// ulpsBetween32 returns the number of representable float32 values between a and b.
+func ulpsBetween32(a, b float32) uint64 {
+	ordA, ordB := orderedBits32(a), orderedBits32(b)
+	if ordA > ordB {
+		return uint64(ordA - ordB)
+	}
+
+	return uint64(ordB - ordA)
+}
+
+func orderedBits32(a float32) int64 {
+	bits := int32(math.Float32bits(a))
+	if bits < 0 {
+		return int64(math.MinInt32) - int64(bits)
+	}
+
+	return int64(bits)
+}
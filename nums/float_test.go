@@ -0,0 +1,67 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+type celsius float32
+
+func TestGenericComparisons(t *testing.T) {
+	t.Run("epsilon per type", func(t *testing.T) {
+		if got := Epsilon[float64](); got != 1e-10 {
+			t.Errorf("Want 1e-10, got %g", got)
+		}
+		if got := Epsilon[float32](); got != 1e-5 {
+			t.Errorf("Want 1e-5, got %g", got)
+		}
+		if got := Epsilon[celsius](); got != 1e-5 {
+			t.Errorf("Want 1e-5 for a defined float32 type, got %g", got)
+		}
+	})
+
+	t.Run("float32 values", func(t *testing.T) {
+		if !Equal[float32](0.1+0.2, 0.3) {
+			t.Error("Expected the values to be equal")
+		}
+		if Equal[float32](1, 1.001) {
+			t.Error("Expected the values not to be equal")
+		}
+		if !IsZero[float32](1e-6) {
+			t.Error("Expected the value to be zero")
+		}
+	})
+
+	t.Run("float64 values", func(t *testing.T) {
+		if Equal(1.0, 1.0+1e-9) {
+			t.Error("Expected the values not to be equal")
+		}
+		if !EqualEps(1.0, 1.0+1e-9, 1e-8) {
+			t.Error("Expected the values to be equal")
+		}
+	})
+
+	t.Run("ULPs of float32", func(t *testing.T) {
+		var (
+			a   = float32(1)
+			b   = math.Nextafter32(math.Nextafter32(a, 2), 2)
+			tol = MakeULPTolerance(2)
+		)
+
+		if !EqualTol(a, b, tol) {
+			t.Error("Expected values two ULPs apart to be equal")
+		}
+		if EqualTol(a, math.Nextafter32(b, 2), tol) {
+			t.Error("Expected values three ULPs apart not to be equal")
+		}
+		if EqualTol(float32(math.NaN()), a, tol) {
+			t.Error("Expected NaN not to be equal")
+		}
+	})
+
+	t.Run("absolute tolerance", func(t *testing.T) {
+		if !EqualTol[float32](1, 1.05, MakeAbsoluteTolerance(0.1)) {
+			t.Error("Expected the values to be equal")
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package nums
+
+import (
+	"errors"
+	"sort"
+)
+
+/*
+A LinearTable interpolates linearly between a list of (x, y) pairs, sorted by x.
+Values outside of the table's range take the value of the closest end.
+*/
+type LinearTable struct {
+	xs, ys []float64
+}
+
+/*
+MakeLinearTable returns a new table with the given x and y values.
+
+A non-nil error is returned if there aren't the same number of x and y values, there are less
+than two values or the x values aren't strictly increasing.
+*/
+func MakeLinearTable(xs, ys []float64) (*LinearTable, error) {
+	if err := validateInterpolationPoints(xs, ys, 2); err != nil {
+		return nil, err
+	}
+
+	return &LinearTable{copyFloats(xs), copyFloats(ys)}, nil
+}
+
+/*
+ValueAt computes the interpolated value at x.
+*/
+func (t *LinearTable) ValueAt(x float64) float64 {
+	last := len(t.xs) - 1
+	switch {
+	case x <= t.xs[0]:
+		return t.ys[0]
+	case x >= t.xs[last]:
+		return t.ys[last]
+	}
+
+	i := intervalIndex(t.xs, x)
+	return LinInterpol(t.xs[i], t.ys[i], t.xs[i+1], t.ys[i+1], x)
+}
+
+/*
+A BilinearTable interpolates in a rectangular grid of values, defined by the x and y coordinates
+of its rows and columns. Points outside of the grid take the value of the closest point on its
+boundary.
+*/
+type BilinearTable struct {
+	xs, ys []float64
+	values [][]float64
+}
+
+/*
+MakeBilinearTable returns a new table with the given grid coordinates and values, where
+values[i][j] is the value at (xs[i], ys[j]).
+
+A non-nil error is returned if there are less than two x or y values, they aren't strictly
+increasing, or the dimensions of the values don't match them.
+*/
+func MakeBilinearTable(xs, ys []float64, values [][]float64) (*BilinearTable, error) {
+	if err := validateInterpolationPoints(xs, xs, 2); err != nil {
+		return nil, err
+	}
+	if err := validateInterpolationPoints(ys, ys, 2); err != nil {
+		return nil, err
+	}
+	if len(values) != len(xs) {
+		return nil, errors.New("there must be a row of values for each x")
+	}
+
+	copied := make([][]float64, len(values))
+	for i, row := range values {
+		if len(row) != len(ys) {
+			return nil, errors.New("there must be a value for each y in every row")
+		}
+		copied[i] = copyFloats(row)
+	}
+
+	return &BilinearTable{copyFloats(xs), copyFloats(ys), copied}, nil
+}
+
+/*
+ValueAt computes the interpolated value at (x, y).
+*/
+func (t *BilinearTable) ValueAt(x, y float64) float64 {
+	var (
+		i, u = gridPosition(t.xs, x)
+		j, v = gridPosition(t.ys, y)
+	)
+
+	return BilinearInterpol(u, v, t.values[i][j], t.values[i+1][j], t.values[i+1][j+1], t.values[i][j+1])
+}
+
+/*
+BilinearInterpol interpolates the values at the four corners of the unit square, given in
+counter-clockwise order starting at (0, 0), at the point (u, v).
+*/
+func BilinearInterpol(u, v, v00, v10, v11, v01 float64) float64 {
+	return (1-u)*(1-v)*v00 + u*(1-v)*v10 + u*v*v11 + (1-u)*v*v01
+}
+
+/*
+BarycentricCoords computes the barycentric coordinates of the point p with respect to the
+triangle with vertices a, b and c: the weights of the vertices whose combination is p. The
+coordinates add up to one, and are all positive if p is inside the triangle.
+
+A non-nil error is returned if the triangle is degenerate.
+*/
+func BarycentricCoords(p, a, b, c [2]float64) ([3]float64, error) {
+	area := Orient2D(a, b, c)
+	if area == 0 {
+		return [3]float64{}, errors.New("the triangle is degenerate")
+	}
+
+	var (
+		wa = Orient2D(p, b, c) / area
+		wb = Orient2D(a, p, c) / area
+	)
+
+	return [3]float64{wa, wb, 1 - wa - wb}, nil
+}
+
+/*
+BarycentricInterpol interpolates linearly the values at the vertices of the triangle a, b and c
+at the point p.
+
+A non-nil error is returned if the triangle is degenerate.
+*/
+func BarycentricInterpol(p, a, b, c [2]float64, va, vb, vc float64) (float64, error) {
+	coords, err := BarycentricCoords(p, a, b, c)
+	if err != nil {
+		return 0, err
+	}
+
+	return coords[0]*va + coords[1]*vb + coords[2]*vc, nil
+}
+
+// intervalIndex returns the index i such that xs[i] <= x < xs[i+1], limited to the first and last
+// intervals.
+func intervalIndex(xs []float64, x float64) int {
+	i := sort.SearchFloat64s(xs, x) - 1
+	switch {
+	case i < 0:
+		return 0
+	case i > len(xs)-2:
+		return len(xs) - 2
+	default:
+		return i
+	}
+}
+
+// gridPosition returns the index of the grid interval containing x, and the relative position of
+// x in it, in [0, 1].
+func gridPosition(xs []float64, x float64) (int, float64) {
+	last := len(xs) - 1
+	switch {
+	case x <= xs[0]:
+		return 0, 0
+	case x >= xs[last]:
+		return last - 1, 1
+	}
+
+	i := intervalIndex(xs, x)
+	return i, (x - xs[i]) / (xs[i+1] - xs[i])
+}
+
+func validateInterpolationPoints(xs, ys []float64, minPoints int) error {
+	if len(xs) != len(ys) {
+		return errors.New("there must be the same number of x and y values")
+	}
+	if len(xs) < minPoints {
+		return errors.New("there aren't enough points to interpolate")
+	}
+
+	for i := 1; i < len(xs); i++ {
+		if xs[i] <= xs[i-1] {
+			return errors.New("the x values must be strictly increasing")
+		}
+	}
+
+	return nil
+}
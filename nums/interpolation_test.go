@@ -0,0 +1,125 @@
+package nums
+
+import "testing"
+
+func TestLinearTable(t *testing.T) {
+	table, err := MakeLinearTable([]float64{0, 1, 3}, []float64{10, 20, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		x, want float64
+	}{
+		{"before the start", -1, 10},
+		{"at a point", 1, 20},
+		{"first interval", 0.5, 15},
+		{"second interval", 2, 10},
+		{"after the end", 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.ValueAt(tt.x); !FloatsEqual(got, tt.want) {
+				t.Errorf("Want %f, got %f", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("invalid points", func(t *testing.T) {
+		if _, err := MakeLinearTable([]float64{0, 1}, []float64{1}); err == nil {
+			t.Error("Expected an error for mismatched lengths")
+		}
+		if _, err := MakeLinearTable([]float64{0}, []float64{1}); err == nil {
+			t.Error("Expected an error for a single point")
+		}
+		if _, err := MakeLinearTable([]float64{0, 2, 1}, []float64{1, 2, 3}); err == nil {
+			t.Error("Expected an error for unsorted x values")
+		}
+	})
+}
+
+func TestBilinearTable(t *testing.T) {
+	table, err := MakeBilinearTable(
+		[]float64{0, 1, 2},
+		[]float64{0, 10},
+		[][]float64{{0, 10}, {1, 11}, {2, 12}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		x, y, want float64
+	}{
+		{"grid point", 1, 10, 11},
+		{"inside", 1.5, 5, 6.5},
+		{"outside", 3, -1, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := table.ValueAt(tt.x, tt.y); !FloatsEqual(got, tt.want) {
+				t.Errorf("Want %f, got %f", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("mismatched values", func(t *testing.T) {
+		if _, err := MakeBilinearTable([]float64{0, 1}, []float64{0, 1}, [][]float64{{0, 1}, {2}}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
+
+func TestBilinearInterpol(t *testing.T) {
+	if got := BilinearInterpol(0.5, 0.5, 1, 2, 3, 4); !FloatsEqual(got, 2.5) {
+		t.Errorf("Want 2.5, got %f", got)
+	}
+	if got := BilinearInterpol(1, 0, 1, 2, 3, 4); !FloatsEqual(got, 2) {
+		t.Errorf("Want 2, got %f", got)
+	}
+}
+
+func TestBarycentric(t *testing.T) {
+	var (
+		a = [2]float64{0, 0}
+		b = [2]float64{2, 0}
+		c = [2]float64{0, 2}
+	)
+
+	t.Run("coordinates", func(t *testing.T) {
+		coords, err := BarycentricCoords([2]float64{0.5, 0.5}, a, b, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := [3]float64{0.5, 0.25, 0.25}
+		for i := range want {
+			if !FloatsEqual(coords[i], want[i]) {
+				t.Errorf("Want %v, got %v", want, coords)
+			}
+		}
+	})
+
+	t.Run("interpolation reproduces linear fields", func(t *testing.T) {
+		field := func(p [2]float64) float64 { return 3 + 2*p[0] - p[1] }
+		p := [2]float64{0.3, 1.1}
+
+		got, err := BarycentricInterpol(p, a, b, c, field(a), field(b), field(c))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := field(p); !FloatsEqual(got, want) {
+			t.Errorf("Want %f, got %f", want, got)
+		}
+	})
+
+	t.Run("degenerate triangle", func(t *testing.T) {
+		if _, err := BarycentricCoords(a, a, b, [2]float64{4, 0}); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
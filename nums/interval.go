@@ -0,0 +1,300 @@
+package nums
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+An Interval is a closed range of real numbers [start, end].
+
+Besides the set operations, intervals support interval arithmetic: the result of an operation
+between two intervals contains the results of the operation between any two numbers in them.
+The ends of the results are rounded outwards, so the bounds are conservative even with rounding
+errors.
+*/
+type Interval struct {
+	start, end float64
+}
+
+/* <-- Construction --> */
+
+/*
+MakeInterval returns the interval [start, end].
+
+A non-nil error is returned if start is greater than end or any of them is NaN.
+*/
+func MakeInterval(start, end float64) (Interval, error) {
+	if math.IsNaN(start) || math.IsNaN(end) {
+		return Interval{}, errors.New("the ends of an interval can't be NaN")
+	}
+	if start > end {
+		return Interval{}, errors.New("the start of an interval can't be greater than its end")
+	}
+
+	return Interval{start, end}, nil
+}
+
+/*
+MakeIntervalContaining returns the smallest interval containing all the given values.
+*/
+func MakeIntervalContaining(value float64, values ...float64) Interval {
+	interval := Interval{value, value}
+	for _, v := range values {
+		interval.start = math.Min(interval.start, v)
+		interval.end = math.Max(interval.end, v)
+	}
+
+	return interval
+}
+
+/*
+MakeTParamInterval returns the interval between the values of the two T parameters, in any
+order. Loaded sub-ranges of a bar can be represented by these intervals.
+*/
+func MakeTParamInterval(a, b TParam) Interval {
+	return MakeIntervalContaining(a.value, b.value)
+}
+
+/* <-- Properties --> */
+
+/*
+Start returns the smallest value in the interval.
+*/
+func (i Interval) Start() float64 {
+	return i.start
+}
+
+/*
+End returns the largest value in the interval.
+*/
+func (i Interval) End() float64 {
+	return i.end
+}
+
+/*
+Length returns the distance between the interval's ends.
+*/
+func (i Interval) Length() float64 {
+	return i.end - i.start
+}
+
+/*
+Middle returns the value in the middle of the interval.
+*/
+func (i Interval) Middle() float64 {
+	return i.start + 0.5*(i.end-i.start)
+}
+
+/*
+IsDegenerate returns true if the interval contains a single value.
+*/
+func (i Interval) IsDegenerate() bool {
+	return i.start == i.end
+}
+
+/* <-- Mapping --> */
+
+/*
+ValueAt maps the T parameter to the value of the interval at the same relative position: the
+start of the interval for t = 0 and its end for t = 1.
+*/
+func (i Interval) ValueAt(t TParam) float64 {
+	return i.start + t.value*(i.end-i.start)
+}
+
+/*
+TParamAt maps a value to the T parameter at the same relative position in the interval.
+Values outside of the interval are mapped to the closest extreme T parameter. For a degenerate
+interval, the result is the minimum T parameter.
+*/
+func (i Interval) TParamAt(value float64) TParam {
+	if i.IsDegenerate() {
+		return MinT
+	}
+
+	return MakeTParam((value - i.start) / (i.end - i.start))
+}
+
+/* <-- Set operations --> */
+
+/*
+Contains returns true if the value is in the interval, ends included.
+*/
+func (i Interval) Contains(value float64) bool {
+	return value >= i.start && value <= i.end
+}
+
+/*
+ContainsInterval returns true if all the values in the other interval are in this one.
+*/
+func (i Interval) ContainsInterval(other Interval) bool {
+	return other.start >= i.start && other.end <= i.end
+}
+
+/*
+Overlaps returns true if the intervals have at least a value in common.
+*/
+func (i Interval) Overlaps(other Interval) bool {
+	return i.start <= other.end && other.start <= i.end
+}
+
+/*
+Intersection returns the interval with the values in both intervals. The second returned value
+is false if the intervals don't overlap.
+*/
+func (i Interval) Intersection(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) {
+		return Interval{}, false
+	}
+
+	return Interval{math.Max(i.start, other.start), math.Min(i.end, other.end)}, true
+}
+
+/*
+Hull returns the smallest interval containing both intervals.
+*/
+func (i Interval) Hull(other Interval) Interval {
+	return Interval{math.Min(i.start, other.start), math.Max(i.end, other.end)}
+}
+
+/*
+Union returns the values in any of the two intervals, as a single interval if they overlap, or
+as two sorted intervals otherwise.
+*/
+func (i Interval) Union(other Interval) []Interval {
+	if i.Overlaps(other) {
+		return []Interval{i.Hull(other)}
+	}
+	if i.start < other.start {
+		return []Interval{i, other}
+	}
+
+	return []Interval{other, i}
+}
+
+/*
+Difference returns the values in this interval which aren't in the other, as zero, one or two
+sorted intervals.
+
+As intervals are closed, the resulting intervals include the ends of the other interval, and
+the parts of this interval which would have a zero length are discarded.
+*/
+func (i Interval) Difference(other Interval) []Interval {
+	intersection, overlaps := i.Intersection(other)
+	if !overlaps {
+		return []Interval{i}
+	}
+
+	result := []Interval{}
+	if i.start < intersection.start {
+		result = append(result, Interval{i.start, intersection.start})
+	}
+	if intersection.end < i.end {
+		result = append(result, Interval{intersection.end, i.end})
+	}
+
+	return result
+}
+
+/*
+MergeIntervals returns the union of the given intervals, as a sorted list of non-overlapping
+intervals.
+*/
+func MergeIntervals(intervals ...Interval) []Interval {
+	if len(intervals) == 0 {
+		return []Interval{}
+	}
+
+	sorted := append([]Interval(nil), intervals...)
+	sort.Slice(sorted, func(a, b int) bool { return sorted[a].start < sorted[b].start })
+
+	merged := []Interval{sorted[0]}
+	for _, interval := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if interval.start <= last.end {
+			last.end = math.Max(last.end, interval.end)
+		} else {
+			merged = append(merged, interval)
+		}
+	}
+
+	return merged
+}
+
+/* <-- Arithmetic --> */
+
+/*
+Plus returns the interval containing the sums of any two values in the intervals.
+*/
+func (i Interval) Plus(other Interval) Interval {
+	return roundedOutwards(i.start+other.start, i.end+other.end)
+}
+
+/*
+Minus returns the interval containing the differences between any two values in the intervals.
+*/
+func (i Interval) Minus(other Interval) Interval {
+	return roundedOutwards(i.start-other.end, i.end-other.start)
+}
+
+/*
+Times returns the interval containing the products of any two values in the intervals.
+*/
+func (i Interval) Times(other Interval) Interval {
+	var (
+		a = i.start * other.start
+		b = i.start * other.end
+		c = i.end * other.start
+		d = i.end * other.end
+	)
+
+	return roundedOutwards(math.Min(math.Min(a, b), math.Min(c, d)), math.Max(math.Max(a, b), math.Max(c, d)))
+}
+
+/*
+DividedBy returns the interval containing the quotients of any two values in the intervals.
+
+A non-nil error is returned if the other interval contains zero.
+*/
+func (i Interval) DividedBy(other Interval) (Interval, error) {
+	if other.Contains(0) {
+		return Interval{}, errors.New("can't divide by an interval containing zero")
+	}
+
+	inverse := roundedOutwards(1/other.end, 1/other.start)
+	return i.Times(inverse), nil
+}
+
+/*
+Scaled returns the interval containing the products of the values in the interval by the factor.
+*/
+func (i Interval) Scaled(factor float64) Interval {
+	return i.Times(Interval{factor, factor})
+}
+
+/* <-- Comparison --> */
+
+/*
+Equals returns true if the ends of both intervals are equal.
+*/
+func (i Interval) Equals(other Interval) bool {
+	return i.EqualsTol(other, DefaultTolerance)
+}
+
+/*
+EqualsTol returns true if the ends of both intervals are equal within the given tolerance.
+*/
+func (i Interval) EqualsTol(other Interval, tol Tolerance) bool {
+	return tol.Equal(i.start, other.start) && tol.Equal(i.end, other.end)
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%g, %g]", i.start, i.end)
+}
+
+func roundedOutwards(start, end float64) Interval {
+	return Interval{math.Nextafter(start, math.Inf(-1)), math.Nextafter(end, math.Inf(1))}
+}
@@ -0,0 +1,163 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+func mustInterval(t *testing.T, start, end float64) Interval {
+	t.Helper()
+
+	interval, err := MakeInterval(start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return interval
+}
+
+func assertIntervals(t *testing.T, want, got []Interval) {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Fatalf("Want %v, got %v", want, got)
+	}
+	for i := range want {
+		if !want[i].Equals(got[i]) {
+			t.Fatalf("Want %v, got %v", want, got)
+		}
+	}
+}
+
+func TestMakeInterval(t *testing.T) {
+	t.Run("reversed ends", func(t *testing.T) {
+		if _, err := MakeInterval(2, 1); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("NaN ends", func(t *testing.T) {
+		if _, err := MakeInterval(math.NaN(), 1); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("from T parameters", func(t *testing.T) {
+		got := MakeTParamInterval(MakeTParam(0.8), MakeTParam(0.2))
+		if want := mustInterval(t, 0.2, 0.8); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+
+	t.Run("containing values", func(t *testing.T) {
+		got := MakeIntervalContaining(3, -1, 7, 2)
+		if want := mustInterval(t, -1, 7); !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+	})
+}
+
+func TestIntervalTParamMapping(t *testing.T) {
+	interval := mustInterval(t, 2, 6)
+
+	if got := interval.ValueAt(MakeTParam(0.25)); !FloatsEqual(got, 3) {
+		t.Errorf("Want 3, got %f", got)
+	}
+	if got := interval.TParamAt(5); !got.Equals(MakeTParam(0.75)) {
+		t.Errorf("Want 0.75, got %f", got.Value())
+	}
+	if got := interval.TParamAt(10); !got.IsMax() {
+		t.Errorf("Want the max T, got %f", got.Value())
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	var (
+		a = mustInterval(t, 0, 4)
+		b = mustInterval(t, 2, 6)
+		c = mustInterval(t, 5, 8)
+	)
+
+	t.Run("containment", func(t *testing.T) {
+		if !a.Contains(4) || a.Contains(4.1) {
+			t.Error("Wrong value containment")
+		}
+		if !a.ContainsInterval(mustInterval(t, 1, 3)) || a.ContainsInterval(b) {
+			t.Error("Wrong interval containment")
+		}
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		got, ok := a.Intersection(b)
+		if want := mustInterval(t, 2, 4); !ok || !got.Equals(want) {
+			t.Errorf("Want %v, got %v", want, got)
+		}
+		if _, ok := a.Intersection(c); ok {
+			t.Error("Expected no intersection")
+		}
+	})
+
+	t.Run("union", func(t *testing.T) {
+		assertIntervals(t, []Interval{mustInterval(t, 0, 6)}, a.Union(b))
+		assertIntervals(t, []Interval{a, c}, c.Union(a))
+	})
+
+	t.Run("difference", func(t *testing.T) {
+		assertIntervals(t, []Interval{mustInterval(t, 0, 2)}, a.Difference(b))
+		assertIntervals(t, []Interval{a}, a.Difference(c))
+		assertIntervals(t, []Interval{}, a.Difference(mustInterval(t, -1, 5)))
+		assertIntervals(
+			t,
+			[]Interval{mustInterval(t, 0, 1), mustInterval(t, 3, 4)},
+			a.Difference(mustInterval(t, 1, 3)),
+		)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		got := MergeIntervals(c, mustInterval(t, 10, 12), b, a, mustInterval(t, 11, 11.5))
+		assertIntervals(t, []Interval{mustInterval(t, 0, 8), mustInterval(t, 10, 12)}, got)
+	})
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	var (
+		a = mustInterval(t, 1, 2)
+		b = mustInterval(t, -3, 4)
+	)
+
+	t.Run("sum", func(t *testing.T) {
+		assertIntervals(t, []Interval{mustInterval(t, -2, 6)}, []Interval{a.Plus(b)})
+	})
+
+	t.Run("subtraction", func(t *testing.T) {
+		assertIntervals(t, []Interval{mustInterval(t, -3, 5)}, []Interval{a.Minus(b)})
+	})
+
+	t.Run("product", func(t *testing.T) {
+		assertIntervals(t, []Interval{mustInterval(t, -6, 8)}, []Interval{a.Times(b)})
+		assertIntervals(t, []Interval{mustInterval(t, -4, -2)}, []Interval{a.Scaled(-2)})
+	})
+
+	t.Run("division", func(t *testing.T) {
+		got, err := b.DividedBy(mustInterval(t, 2, 4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertIntervals(t, []Interval{mustInterval(t, -1.5, 2)}, []Interval{got})
+
+		if _, err := a.DividedBy(b); err == nil {
+			t.Error("Expected an error dividing by an interval containing zero")
+		}
+	})
+
+	t.Run("results are rounded outwards", func(t *testing.T) {
+		var (
+			tenth = mustInterval(t, 0.1, 0.1)
+			sum   = tenth.Plus(tenth).Plus(tenth)
+		)
+
+		if !sum.Contains(0.3) || !sum.Contains(0.1+0.1+0.1) {
+			t.Errorf("Expected %v to contain 0.3", sum)
+		}
+	})
+}
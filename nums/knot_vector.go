@@ -0,0 +1,305 @@
+package nums
+
+import "errors"
+
+/*
+A KnotVector is a non-decreasing sequence of parameter values (the knots) which defines the
+B-spline basis functions of a B-spline or NURBS curve.
+*/
+type KnotVector struct {
+	knots []float64
+}
+
+/* <-- Construction --> */
+
+/*
+MakeKnotVector returns a new knot vector with the given knots.
+
+A non-nil error is returned if there are less than two knots or they aren't in non-decreasing
+order.
+*/
+func MakeKnotVector(knots ...float64) (KnotVector, error) {
+	if len(knots) < 2 {
+		return KnotVector{}, errors.New("a knot vector needs at least two knots")
+	}
+
+	for i := 1; i < len(knots); i++ {
+		if knots[i] < knots[i-1] {
+			return KnotVector{}, errors.New("the knots must be in non-decreasing order")
+		}
+	}
+
+	return KnotVector{append([]float64(nil), knots...)}, nil
+}
+
+/*
+MakeClampedUniformKnotVector returns a knot vector in the range [0, 1] for a curve of the given
+degree and number of control points. The first and last knots are repeated degree + 1 times, so
+that the curve starts and ends at its first and last control points, and the interior knots are
+evenly spaced.
+
+A non-nil error is returned if the degree is smaller than one or there aren't at least
+degree + 1 control points.
+*/
+func MakeClampedUniformKnotVector(degree, numControlPoints int) (KnotVector, error) {
+	if degree < 1 {
+		return KnotVector{}, errors.New("the degree must be at least one")
+	}
+	if numControlPoints < degree+1 {
+		return KnotVector{}, errors.New("at least degree + 1 control points are required")
+	}
+
+	var (
+		numKnots    = numControlPoints + degree + 1
+		numInterior = numControlPoints - degree - 1
+		knots       = make([]float64, numKnots)
+	)
+
+	for i := 0; i < numInterior; i++ {
+		knots[degree+1+i] = float64(i+1) / float64(numInterior+1)
+	}
+	for i := numKnots - degree - 1; i < numKnots; i++ {
+		knots[i] = 1
+	}
+
+	return KnotVector{knots}, nil
+}
+
+/* <-- Properties --> */
+
+/*
+Knots returns a copy of the knot values.
+*/
+func (k KnotVector) Knots() []float64 {
+	return append([]float64(nil), k.knots...)
+}
+
+/*
+Len returns the number of knots.
+*/
+func (k KnotVector) Len() int {
+	return len(k.knots)
+}
+
+/*
+At returns the knot at the given index.
+*/
+func (k KnotVector) At(index int) float64 {
+	return k.knots[index]
+}
+
+/*
+Multiplicity returns the number of times the given value appears in the knot vector.
+*/
+func (k KnotVector) Multiplicity(u float64) int {
+	count := 0
+	for _, knot := range k.knots {
+		if FloatsEqual(knot, u) {
+			count++
+		}
+	}
+
+	return count
+}
+
+/*
+IsClamped returns true if the first and last knots are repeated degree + 1 times.
+*/
+func (k KnotVector) IsClamped(degree int) bool {
+	last := len(k.knots) - 1
+	if last < 2*degree+1 {
+		return false
+	}
+
+	for i := 1; i <= degree; i++ {
+		if !FloatsEqual(k.knots[i], k.knots[0]) || !FloatsEqual(k.knots[last-i], k.knots[last]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Domain returns the range of knot values where a curve of the given degree is defined.
+*/
+func (k KnotVector) Domain(degree int) (float64, float64) {
+	return k.knots[degree], k.knots[len(k.knots)-degree-1]
+}
+
+/*
+ValueAt maps a T parameter to the corresponding knot value in the domain of a curve of the
+given degree.
+*/
+func (k KnotVector) ValueAt(degree int, t TParam) float64 {
+	start, end := k.Domain(degree)
+	return LinInterpol(minTValue, start, maxTValue, end, t.value)
+}
+
+/* <-- Basis functions --> */
+
+/*
+SpanIndex returns the index i of the knot span [u_i, u_i+1) containing the value u, for a curve
+of the given degree. Values outside the curve's domain are assigned to the first or last span.
+*/
+func (k KnotVector) SpanIndex(degree int, u float64) int {
+	var (
+		n          = len(k.knots) - degree - 2
+		start, end = k.Domain(degree)
+	)
+
+	if u >= end {
+		// The last non-empty span includes its end.
+		for n > degree && k.knots[n] >= end {
+			n--
+		}
+		return n
+	}
+	if u <= start {
+		return degree
+	}
+
+	low, high := degree, n+1
+	mid := (low + high) / 2
+	for u < k.knots[mid] || u >= k.knots[mid+1] {
+		if u < k.knots[mid] {
+			high = mid
+		} else {
+			low = mid
+		}
+		mid = (low + high) / 2
+	}
+
+	return mid
+}
+
+/*
+BasisFunctions computes the values at u of the degree + 1 B-spline basis functions which are
+non-zero in the given knot span.
+*/
+func (k KnotVector) BasisFunctions(degree, span int, u float64) []float64 {
+	return k.BasisFunctionDerivatives(degree, span, u, 0)[0]
+}
+
+/*
+BasisFunctionDerivatives computes the values at u of the degree + 1 B-spline basis functions
+which are non-zero in the given knot span, and their derivatives up to the given order.
+The element [k][j] of the result is the k-th derivative of the j-th basis function.
+*/
+func (k KnotVector) BasisFunctionDerivatives(degree, span int, u float64, order int) [][]float64 {
+	var (
+		p     = degree
+		ndu   = make([][]float64, p+1)
+		left  = make([]float64, p+1)
+		right = make([]float64, p+1)
+		ders  = make([][]float64, order+1)
+		a     = [2][]float64{make([]float64, p+1), make([]float64, p+1)}
+	)
+
+	for i := range ndu {
+		ndu[i] = make([]float64, p+1)
+	}
+	for i := range ders {
+		ders[i] = make([]float64, p+1)
+	}
+
+	// Basis functions and knot differences, as in "The NURBS Book", algorithm A2.3.
+	ndu[0][0] = 1
+	for j := 1; j <= p; j++ {
+		left[j] = u - k.knots[span+1-j]
+		right[j] = k.knots[span+j] - u
+		saved := 0.0
+
+		for r := 0; r < j; r++ {
+			ndu[j][r] = right[r+1] + left[j-r]
+			temp := ndu[r][j-1] / ndu[j][r]
+
+			ndu[r][j] = saved + right[r+1]*temp
+			saved = left[j-r] * temp
+		}
+		ndu[j][j] = saved
+	}
+
+	for j := 0; j <= p; j++ {
+		ders[0][j] = ndu[j][p]
+	}
+
+	for r := 0; r <= p; r++ {
+		s1, s2 := 0, 1
+		a[0][0] = 1
+
+		for kk := 1; kk <= order && kk <= p; kk++ {
+			var (
+				d  = 0.0
+				rk = r - kk
+				pk = p - kk
+				j1 int
+				j2 int
+			)
+
+			if r >= kk {
+				a[s2][0] = a[s1][0] / ndu[pk+1][rk]
+				d = a[s2][0] * ndu[rk][pk]
+			}
+
+			if rk >= -1 {
+				j1 = 1
+			} else {
+				j1 = -rk
+			}
+			if r-1 <= pk {
+				j2 = kk - 1
+			} else {
+				j2 = p - r
+			}
+
+			for j := j1; j <= j2; j++ {
+				a[s2][j] = (a[s1][j] - a[s1][j-1]) / ndu[pk+1][rk+j]
+				d += a[s2][j] * ndu[rk+j][pk]
+			}
+
+			if r <= pk {
+				a[s2][kk] = -a[s1][kk-1] / ndu[pk+1][r]
+				d += a[s2][kk] * ndu[r][pk]
+			}
+
+			ders[kk][r] = d
+			s1, s2 = s2, s1
+		}
+	}
+
+	factor := float64(p)
+	for kk := 1; kk <= order && kk <= p; kk++ {
+		for j := 0; j <= p; j++ {
+			ders[kk][j] *= factor
+		}
+		factor *= float64(p - kk)
+	}
+
+	return ders
+}
+
+/*
+Equals returns true if both knot vectors have the same number of knots with equal values.
+*/
+func (k KnotVector) Equals(other KnotVector) bool {
+	return k.EqualsTol(other, DefaultTolerance)
+}
+
+/*
+EqualsTol returns true if both knot vectors have the same number of knots with equal values
+within the given tolerance.
+*/
+func (k KnotVector) EqualsTol(other KnotVector, tol Tolerance) bool {
+	if len(k.knots) != len(other.knots) {
+		return false
+	}
+
+	for i, knot := range k.knots {
+		if !tol.Equal(knot, other.knots[i]) {
+			return false
+		}
+	}
+
+	return true
+}
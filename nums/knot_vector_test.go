@@ -0,0 +1,102 @@
+package nums
+
+import "testing"
+
+func TestMakeKnotVector(t *testing.T) {
+	t.Run("keeps the knots", func(t *testing.T) {
+		knots, err := MakeKnotVector(0, 0, 1, 2, 2)
+
+		if err != nil {
+			t.Fatal("Expected knot vector to be created without error")
+		}
+		if knots.Len() != 5 || knots.At(3) != 2 {
+			t.Errorf("Unexpected knots %v", knots.Knots())
+		}
+	})
+
+	t.Run("requires at least two knots", func(t *testing.T) {
+		if _, err := MakeKnotVector(0); err == nil {
+			t.Error("Want error, got nil")
+		}
+	})
+
+	t.Run("requires non-decreasing knots", func(t *testing.T) {
+		if _, err := MakeKnotVector(0, 1, 0.5); err == nil {
+			t.Error("Want error, got nil")
+		}
+	})
+}
+
+func TestClampedUniformKnotVector(t *testing.T) {
+	var (
+		knots, _ = MakeClampedUniformKnotVector(2, 5)
+		want, _  = MakeKnotVector(0, 0, 0, 1.0/3.0, 2.0/3.0, 1, 1, 1)
+	)
+
+	if !knots.Equals(want) {
+		t.Errorf("Want knots %v, got %v", want.Knots(), knots.Knots())
+	}
+	if !knots.IsClamped(2) {
+		t.Error("Expected knot vector to be clamped")
+	}
+	if knots.Multiplicity(1) != 3 {
+		t.Errorf("Want multiplicity 3, got %d", knots.Multiplicity(1))
+	}
+
+	if _, err := MakeClampedUniformKnotVector(3, 3); err == nil {
+		t.Error("Want error with too few control points, got nil")
+	}
+}
+
+func TestKnotSpanIndex(t *testing.T) {
+	knots, _ := MakeKnotVector(0, 0, 0, 1, 2, 3, 3, 3)
+
+	for _, tc := range []struct {
+		u    float64
+		want int
+	}{
+		{0, 2}, {0.5, 2}, {1, 3}, {2.5, 4}, {3, 4},
+	} {
+		if got := knots.SpanIndex(2, tc.u); got != tc.want {
+			t.Errorf("Want span %d for u = %f, got %d", tc.want, tc.u, got)
+		}
+	}
+}
+
+func TestBasisFunctions(t *testing.T) {
+	var (
+		knots, _ = MakeKnotVector(0, 0, 0, 1, 2, 3, 3, 3)
+		u        = 2.5
+		span     = knots.SpanIndex(2, u)
+		ders     = knots.BasisFunctionDerivatives(2, span, u, 2)
+	)
+
+	t.Run("values", func(t *testing.T) {
+		want := []float64{0.125, 0.625, 0.25}
+		for i, value := range ders[0] {
+			if !FloatsEqual(value, want[i]) {
+				t.Errorf("Want N%d = %f, got %f", i, want[i], value)
+			}
+		}
+	})
+
+	t.Run("form a partition of unity", func(t *testing.T) {
+		sum := 0.0
+		for _, value := range knots.BasisFunctions(2, span, u) {
+			sum += value
+		}
+
+		if !IsCloseToOne(sum) {
+			t.Errorf("Want basis functions to add up to one, got %f", sum)
+		}
+	})
+
+	t.Run("derivatives", func(t *testing.T) {
+		want := []float64{-0.5, -0.5, 1}
+		for i, value := range ders[1] {
+			if !FloatsEqual(value, want[i]) {
+				t.Errorf("Want N%d' = %f, got %f", i, want[i], value)
+			}
+		}
+	})
+}
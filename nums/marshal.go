@@ -0,0 +1,98 @@
+package nums
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+/*
+ErrNotFinite is returned when a number is required to be finite, but it's NaN or infinite, for
+example, when decoding the coordinates of a point.
+*/
+var ErrNotFinite = errors.New("the number must be finite")
+
+var (
+	_ json.Marshaler             = TParam{}
+	_ json.Unmarshaler           = (*TParam)(nil)
+	_ encoding.TextMarshaler     = TParam{}
+	_ encoding.TextUnmarshaler   = (*TParam)(nil)
+	_ encoding.BinaryMarshaler   = TParam{}
+	_ encoding.BinaryUnmarshaler = (*TParam)(nil)
+)
+
+/*
+MarshalJSON encodes the T parameter as a JSON number.
+*/
+func (t TParam) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+
+/*
+UnmarshalJSON decodes the T parameter from a JSON number.
+
+An ErrTParamOutOfRange error is returned if the number is outside of the [0, 1] range.
+*/
+func (t *TParam) UnmarshalJSON(data []byte) error {
+	var value float64
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	return t.setChecked(value)
+}
+
+/*
+MarshalText encodes the T parameter as its value, in the shortest exact representation.
+*/
+func (t TParam) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, t.value, 'g', -1, 64), nil
+}
+
+/*
+UnmarshalText decodes the T parameter from its text representation.
+
+An ErrTParamOutOfRange error is returned if the value is outside of the [0, 1] range.
+*/
+func (t *TParam) UnmarshalText(text []byte) error {
+	value, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+
+	return t.setChecked(value)
+}
+
+/*
+MarshalBinary encodes the T parameter as its IEEE 754 value, in little-endian order.
+*/
+func (t TParam) MarshalBinary() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, math.Float64bits(t.value)), nil
+}
+
+/*
+UnmarshalBinary decodes the T parameter from its binary representation.
+
+An ErrTParamOutOfRange error is returned if the value is outside of the [0, 1] range.
+*/
+func (t *TParam) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("expected 8 bytes, got %d", len(data))
+	}
+
+	return t.setChecked(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+}
+
+func (t *TParam) setChecked(value float64) error {
+	checked, err := MakeTParamChecked(value)
+	if err != nil {
+		return err
+	}
+
+	*t = checked
+	return nil
+}
@@ -0,0 +1,70 @@
+package nums
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTParamMarshaling(t *testing.T) {
+	tParam := MakeTParam(0.25)
+
+	t.Run("JSON", func(t *testing.T) {
+		data, err := json.Marshal(tParam)
+		if err != nil || string(data) != "0.25" {
+			t.Errorf("Want 0.25, got %s (%v)", data, err)
+		}
+
+		var got TParam
+		if err := json.Unmarshal(data, &got); err != nil || !got.Equals(tParam) {
+			t.Errorf("Want %v, got %v (%v)", tParam, got, err)
+		}
+	})
+
+	t.Run("JSON out of range", func(t *testing.T) {
+		var got TParam
+		if err := json.Unmarshal([]byte("1.5"), &got); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+	})
+
+	t.Run("in a struct", func(t *testing.T) {
+		var load struct {
+			Start TParam `json:"start"`
+			End   TParam `json:"end"`
+		}
+
+		if err := json.Unmarshal([]byte(`{"start":0.2,"end":0.8}`), &load); err != nil {
+			t.Fatal(err)
+		}
+		if load.End.Value() != 0.8 {
+			t.Errorf("Want 0.8, got %v", load.End.Value())
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		text, _ := tParam.MarshalText()
+
+		var got TParam
+		if err := got.UnmarshalText(text); err != nil || !got.Equals(tParam) {
+			t.Errorf("Want %v, got %v (%v)", tParam, got, err)
+		}
+		if err := got.UnmarshalText([]byte("-0.1")); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+		if err := got.UnmarshalText([]byte("NaN")); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		data, _ := tParam.MarshalBinary()
+
+		var got TParam
+		if err := got.UnmarshalBinary(data); err != nil || got.Value() != 0.25 {
+			t.Errorf("Want %v, got %v (%v)", tParam, got, err)
+		}
+		if err := got.UnmarshalBinary(data[:4]); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+}
@@ -4,26 +4,30 @@ import "math"
 
 const defaultEpsilon = 1e-10
 
+// MachineEpsilon is the distance between 1 and the next float64: the spacing of the float64
+// values relative to their magnitude.
+const MachineEpsilon = 0x1p-52
+
 // FloatsEqualEps compares two float64 values and returns true if the difference between
 // the two is smaller than a given epsilon.
 func FloatsEqualEps(a, b, epsilon float64) bool {
 	return math.Abs(a-b) < epsilon
 }
 
-// FloatsEqual compares two float64 values and returns true if the difference between the
-// two is smaller than a default epsilon value (1E-10).
+// FloatsEqual compares two float64 values and returns true if they are equal within the
+// DefaultTolerance.
 func FloatsEqual(a, b float64) bool {
-	return FloatsEqualEps(a, b, defaultEpsilon)
+	return DefaultTolerance.Equal(a, b)
 }
 
-// IsCloseToOne returns true if the given number is closer to 1.0 than the default epsilon.
+// IsCloseToOne returns true if the given number is equal to 1.0 within the DefaultTolerance.
 func IsCloseToOne(a float64) bool {
-	return FloatsEqual(a, 1.0)
+	return DefaultTolerance.IsOne(a)
 }
 
-// IsCloseToZero returns true if the given number is closer to 1.0 than the default epsilon.
+// IsCloseToZero returns true if the given number is equal to 0.0 within the DefaultTolerance.
 func IsCloseToZero(a float64) bool {
-	return FloatsEqual(a, 0.0)
+	return DefaultTolerance.IsZero(a)
 }
 
 // LinInterpol computes the linear interpolation for a given position given two points on
@@ -0,0 +1,344 @@
+package nums
+
+import (
+	"math"
+	"sort"
+)
+
+// The polynomial solvers in this file return the distinct real roots of the polynomial, sorted
+// in ascending order. The coefficients are normalized by the largest of them, so scaling the
+// polynomial doesn't change its roots. When the leading term is negligible compared to the
+// others wherever the polynomial of a lower degree has its roots, the polynomial is solved as
+// one of a lower degree.
+
+// Number of Newton iterations used to polish the roots computed with closed-form expressions.
+const polishIterations = 4
+
+var (
+	// negligibleFraction is the fraction of the largest coefficient under which a value is
+	// within its rounding errors, and considered to be zero.
+	negligibleFraction = 64 * MachineEpsilon
+	// repeatedRootFraction is the fraction of the roots' magnitude under which two roots are
+	// considered to be the same, repeated root. The roots of multiplicity two computed in
+	// floating point are only accurate to about the square root of the machine epsilon.
+	repeatedRootFraction = 4 * math.Sqrt(MachineEpsilon)
+)
+
+/*
+SolveLinear returns the root of a·x + b = 0, if any.
+*/
+func SolveLinear(a, b float64) []float64 {
+	if isNegligible(a, b) {
+		return []float64{}
+	}
+
+	return []float64{-b / a}
+}
+
+/*
+SolveQuadratic returns the real roots of a·x² + b·x + c = 0.
+*/
+func SolveQuadratic(a, b, c float64) []float64 {
+	if isNegligibleLeading(a, b, c) {
+		return SolveLinear(b, c)
+	}
+
+	coeffs := normalized(a, b, c)
+	a, b, c = coeffs[0], coeffs[1], coeffs[2]
+
+	disc := b*b - 4*a*c
+	if isNegligible(disc, b*b, 4*a*c) {
+		disc = 0
+	}
+	if disc < 0 {
+		return []float64{}
+	}
+
+	// Avoids the cancellation that happens when b and the square root are similar.
+	q := -0.5 * (b + math.Copysign(math.Sqrt(disc), b))
+	if q == 0 {
+		return []float64{0}
+	}
+
+	return sortedDistinctRoots([]float64{q / a, c / q})
+}
+
+/*
+SolveCubic returns the real roots of a·x³ + b·x² + c·x + d = 0.
+*/
+func SolveCubic(a, b, c, d float64) []float64 {
+	if isNegligibleLeading(a, b, c, d) {
+		return SolveQuadratic(b, c, d)
+	}
+
+	// Depressed cubic t³ + p·t + q = 0, where x = t - b / 3a.
+	var (
+		b1, c1, d1 = b / a, c / a, d / a
+		shift      = b1 / 3
+		p          = c1 - b1*b1/3
+		q          = 2*b1*b1*b1/27 - b1*c1/3 + d1
+		disc       = q*q/4 + p*p*p/27
+		roots      []float64
+
+		// The rounding errors of p and q, which grow with the terms they're computed from.
+		pError = negligibleFraction * (math.Abs(c1) + b1*b1/3)
+		qError = negligibleFraction * (math.Abs(2*b1*b1*b1/27) + math.Abs(b1*c1/3) + math.Abs(d1))
+	)
+
+	// The discriminant of a polynomial with a double root is zero, but the rounding errors of p
+	// and q make it slightly positive or negative.
+	discError := math.Abs(q)/2*qError + p*p/9*pError + negligibleFraction*(q*q/4+math.Abs(p*p*p/27))
+	if math.Abs(disc) <= discError {
+		disc = 0
+	}
+
+	switch {
+	case p == 0 || (disc == 0 && math.Abs(p) <= pError):
+		// A triple root, or a single one.
+		roots = []float64{math.Cbrt(-q)}
+
+	case disc == 0:
+		// A double root and a single one.
+		roots = []float64{3 * q / p, -1.5 * q / p}
+
+	case disc > 0:
+		// One real root, using Cardano's formula.
+		sqrtDisc := math.Sqrt(disc)
+		roots = []float64{math.Cbrt(-q/2+sqrtDisc) + math.Cbrt(-q/2-sqrtDisc)}
+
+	default:
+		// Three real roots, using the trigonometric method.
+		var (
+			radius = 2 * math.Sqrt(-p/3)
+			cosArg = math.Max(-1, math.Min(1, 3*q/(p*radius)))
+			angle  = math.Acos(cosArg) / 3
+		)
+
+		roots = []float64{
+			radius * math.Cos(angle),
+			radius * math.Cos(angle-2*math.Pi/3),
+			radius * math.Cos(angle-4*math.Pi/3),
+		}
+	}
+
+	coeffs := []float64{a, b, c, d}
+	for i := range roots {
+		roots[i] = polishRoot(coeffs, roots[i]-shift)
+	}
+
+	return sortedDistinctRoots(roots)
+}
+
+/*
+SolveQuartic returns the real roots of a·x⁴ + b·x³ + c·x² + d·x + e = 0.
+*/
+func SolveQuartic(a, b, c, d, e float64) []float64 {
+	if isNegligibleLeading(a, b, c, d, e) {
+		return SolveCubic(b, c, d, e)
+	}
+
+	// Depressed quartic y⁴ + p·y² + q·y + r = 0, where x = y - b / 4a.
+	var (
+		b1, c1, d1, e1 = b / a, c / a, d / a, e / a
+		shift          = b1 / 4
+		p              = c1 - 3*b1*b1/8
+		q              = d1 - b1*c1/2 + b1*b1*b1/8
+		r              = e1 - b1*d1/4 + b1*b1*c1/16 - 3*b1*b1*b1*b1/256
+		roots          []float64
+	)
+
+	// The terms of the depressed quartic have the magnitude of y⁴, so q is compared with the
+	// magnitude of y³.
+	if isNegligible(q, math.Pow(math.Abs(p), 1.5), math.Pow(math.Abs(r), 0.75)) {
+		// Biquadratic: solve for y².
+		for _, y2 := range SolveQuadratic(1, p, r) {
+			switch {
+			case isNegligible(y2, p, math.Sqrt(math.Abs(r))):
+				roots = append(roots, 0)
+			case y2 > 0:
+				roots = append(roots, math.Sqrt(y2), -math.Sqrt(y2))
+			}
+		}
+	} else {
+		// Ferrari's method: find m > 0 so that (y² + p/2 + m)² = (√(2m)·y - q / 2√(2m))².
+		var m float64
+		for _, root := range SolveCubic(1, p, p*p/4-r, -q*q/8) {
+			m = math.Max(m, root)
+		}
+
+		if m > 0 {
+			var (
+				sqrt2m = math.Sqrt(2 * m)
+				term   = q / (2 * sqrt2m)
+			)
+
+			roots = append(roots, SolveQuadratic(1, -sqrt2m, p/2+m+term)...)
+			roots = append(roots, SolveQuadratic(1, sqrt2m, p/2+m-term)...)
+		}
+	}
+
+	coeffs := []float64{a, b, c, d, e}
+	for i := range roots {
+		roots[i] = polishRoot(coeffs, roots[i]-shift)
+	}
+
+	// The rounding errors of Ferrari's method can turn a double root into a pair of complex
+	// ones. Double roots are also roots of the derivative, where the polynomial is zero.
+	for _, x := range SolveCubic(4*a, 3*b, 2*c, d) {
+		if isRootWithinRoundingErrors(coeffs, x) {
+			roots = append(roots, x)
+		}
+	}
+
+	return sortedDistinctRoots(roots)
+}
+
+// polishRoot improves the accuracy of a root of the polynomial with the given coefficients,
+// in descending degree order, applying a few iterations of Newton's method.
+func polishRoot(coeffs []float64, root float64) float64 {
+	for i := 0; i < polishIterations; i++ {
+		value, deriv := evaluatePolynomial(coeffs, root)
+		if deriv == 0 {
+			break
+		}
+
+		next := root - value/deriv
+		if next == root {
+			break
+		}
+
+		// Newton's method may move away from the root when it's a multiple one.
+		if nextValue, _ := evaluatePolynomial(coeffs, next); math.Abs(nextValue) >= math.Abs(value) {
+			break
+		}
+
+		root = next
+	}
+
+	return root
+}
+
+// isRootWithinRoundingErrors checks whether the value of the polynomial with the given
+// coefficients, in descending degree order, is zero at x within the rounding errors of its terms.
+func isRootWithinRoundingErrors(coeffs []float64, x float64) bool {
+	var value, magnitude float64
+	for _, coeff := range coeffs {
+		value = value*x + coeff
+		magnitude = magnitude*math.Abs(x) + math.Abs(coeff)
+	}
+
+	return math.Abs(value) <= negligibleFraction*magnitude
+}
+
+// evaluatePolynomial uses Horner's method to evaluate the polynomial with the given coefficients,
+// in descending degree order, and its derivative at x.
+func evaluatePolynomial(coeffs []float64, x float64) (float64, float64) {
+	var value, deriv float64
+	for _, coeff := range coeffs {
+		deriv = deriv*x + value
+		value = value*x + coeff
+	}
+
+	return value, deriv
+}
+
+// sortedDistinctRoots sorts the roots, merging those closer than the repeated root fraction of
+// the largest root's magnitude into their mean.
+func sortedDistinctRoots(roots []float64) []float64 {
+	sort.Float64s(roots)
+
+	var magnitude float64
+	for _, root := range roots {
+		magnitude = math.Max(magnitude, math.Abs(root))
+	}
+
+	var (
+		tolerance = repeatedRootFraction * magnitude
+		distinct  = make([]float64, 0, len(roots))
+		cluster   = 0
+	)
+
+	for _, root := range roots {
+		last := len(distinct) - 1
+		if last >= 0 && root-distinct[last] <= tolerance {
+			cluster++
+			distinct[last] += (root - distinct[last]) / float64(cluster)
+			continue
+		}
+
+		distinct = append(distinct, root)
+		cluster = 1
+	}
+
+	return distinct
+}
+
+// normalized returns the coefficients divided by the largest of them, in absolute value.
+func normalized(coeffs ...float64) []float64 {
+	scale := maxAbs(coeffs...)
+
+	result := make([]float64, len(coeffs))
+	for i, coeff := range coeffs {
+		result[i] = coeff / scale
+	}
+
+	return result
+}
+
+// isNegligible checks whether the value is within the rounding errors of the largest of the
+// others, which is the case when they are all zero.
+func isNegligible(value float64, others ...float64) bool {
+	return math.Abs(value) <= negligibleFraction*maxAbs(others...)
+}
+
+// isNegligibleLeading checks whether the term of the leading coefficient, the first one, is within
+// the rounding errors of the other terms for values of x up to the bound of the roots of the
+// polynomial of a lower degree. Comparing the terms at the roots' bound, instead of the
+// coefficients themselves, makes the check independent of the scale of x.
+func isNegligibleLeading(coeffs ...float64) bool {
+	var (
+		leading = math.Abs(coeffs[0])
+		lower   = coeffs[1:]
+		first   = 0
+	)
+
+	if leading == 0 {
+		return true
+	}
+
+	for first < len(lower) && lower[first] == 0 {
+		first++
+	}
+	if first >= len(lower)-1 {
+		return false
+	}
+
+	// Fujiwara's bound of the magnitude of the roots of the lower degree polynomial.
+	var bound float64
+	for i := first + 1; i < len(lower); i++ {
+		ratio := math.Abs(lower[i] / lower[first])
+		bound = math.Max(bound, math.Pow(ratio, 1/float64(i-first)))
+	}
+	bound *= 2
+
+	if bound == 0 {
+		return false
+	}
+
+	// The terms at x = bound, divided by the power of x in the first term of the lower polynomial.
+	var others float64
+	for i := first; i < len(lower); i++ {
+		others = math.Max(others, math.Abs(lower[i])/math.Pow(bound, float64(i)))
+	}
+
+	return leading*bound <= negligibleFraction*others
+}
+
+func maxAbs(values ...float64) float64 {
+	var result float64
+	for _, value := range values {
+		result = math.Max(result, math.Abs(value))
+	}
+
+	return result
+}
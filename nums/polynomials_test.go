@@ -0,0 +1,244 @@
+package nums
+
+import (
+	"sort"
+	"testing"
+)
+
+func assertRoots(t *testing.T, want, got []float64) {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Fatalf("Want roots %v, got %v", want, got)
+	}
+	for i := range want {
+		if !FloatsEqualEps(want[i], got[i], 1e-9) {
+			t.Fatalf("Want roots %v, got %v", want, got)
+		}
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	t.Run("scaled coefficients", func(t *testing.T) {
+		assertRoots(t, []float64{-1}, SolveLinear(1e-12, 1e-12))
+	})
+
+	t.Run("no roots", func(t *testing.T) {
+		assertRoots(t, []float64{}, SolveLinear(0, 1))
+	})
+}
+
+func TestSolveQuadratic(t *testing.T) {
+	t.Run("two roots", func(t *testing.T) {
+		assertRoots(t, []float64{-3, 2}, SolveQuadratic(1, 1, -6))
+	})
+
+	t.Run("double root", func(t *testing.T) {
+		assertRoots(t, []float64{1}, SolveQuadratic(2, -4, 2))
+	})
+
+	t.Run("no real roots", func(t *testing.T) {
+		assertRoots(t, []float64{}, SolveQuadratic(1, 0, 1))
+	})
+
+	t.Run("degenerate to linear", func(t *testing.T) {
+		assertRoots(t, []float64{-2}, SolveQuadratic(0, 3, 6))
+	})
+
+	t.Run("scaled coefficients", func(t *testing.T) {
+		assertRoots(t, []float64{-1, 1}, SolveQuadratic(1e-12, 0, -1e-12))
+		assertRoots(t, []float64{-3, 2}, SolveQuadratic(1e20, 1e20, -6e20))
+	})
+
+	t.Run("small leading coefficient", func(t *testing.T) {
+		roots := SolveQuadratic(1e-11, 1, -1)
+
+		if len(roots) != 2 {
+			t.Fatalf("Want two roots, got %v", roots)
+		}
+		if !FloatsEqualEps(roots[0]/-1e11, 1, 1e-9) || !FloatsEqualEps(roots[1], 1, 1e-9) {
+			t.Errorf("Want roots close to -1e11 and 1, got %v", roots)
+		}
+	})
+
+	t.Run("all zero coefficients", func(t *testing.T) {
+		assertRoots(t, []float64{}, SolveQuadratic(0, 0, 0))
+	})
+
+	t.Run("no cancellation with a small root", func(t *testing.T) {
+		roots := SolveQuadratic(1, -1e8, 1)
+		if !FloatsEqualEps(roots[0], 1e-8, 1e-20) {
+			t.Errorf("Want 1e-8, got %g", roots[0])
+		}
+	})
+}
+
+func TestSolveCubic(t *testing.T) {
+	t.Run("three roots", func(t *testing.T) {
+		// (x + 2)(x - 1)(x - 3)
+		assertRoots(t, []float64{-2, 1, 3}, SolveCubic(1, -2, -5, 6))
+	})
+
+	t.Run("one real root", func(t *testing.T) {
+		// (x - 2)(x² + 1)
+		assertRoots(t, []float64{2}, SolveCubic(1, -2, 1, -2))
+	})
+
+	t.Run("double and single roots", func(t *testing.T) {
+		// (x - 1)²(x + 2)
+		assertRoots(t, []float64{-2, 1}, SolveCubic(1, 0, -3, 2))
+	})
+
+	t.Run("triple root", func(t *testing.T) {
+		// 2(x - 1)³
+		assertRoots(t, []float64{1}, SolveCubic(2, -6, 6, -2))
+	})
+
+	t.Run("double root merged", func(t *testing.T) {
+		// (x - 1)²(x - 2)
+		assertRoots(t, []float64{1, 2}, SolveCubic(1, -4, 5, -2))
+	})
+
+	t.Run("scaled coefficients", func(t *testing.T) {
+		// 1e-11·(x - 1)(x - 2)(x - 3)
+		assertRoots(t, []float64{1, 2, 3}, SolveCubic(1e-11, -6e-11, 11e-11, -6e-11))
+	})
+
+	t.Run("scaled double root", func(t *testing.T) {
+		// 1e15·(x - 1)²(x - 2)
+		assertRoots(t, []float64{1, 2}, SolveCubic(1e15, -4e15, 5e15, -2e15))
+	})
+
+	t.Run("small roots stay distinct", func(t *testing.T) {
+		// (x - 1e-6)(x - 2e-6)(x - 3e-6)
+		roots := SolveCubic(1, -6e-6, 11e-12, -6e-18)
+
+		if len(roots) != 3 {
+			t.Fatalf("Want three roots, got %v", roots)
+		}
+		for i, want := range []float64{1e-6, 2e-6, 3e-6} {
+			if !FloatsEqualEps(roots[i], want, 1e-12) {
+				t.Errorf("Want %g, got %g", want, roots[i])
+			}
+		}
+	})
+
+	t.Run("degenerate to quadratic", func(t *testing.T) {
+		assertRoots(t, []float64{-3, 2}, SolveCubic(0, 1, 1, -6))
+	})
+}
+
+func TestSolveQuartic(t *testing.T) {
+	t.Run("four roots", func(t *testing.T) {
+		// (x + 1)(x - 1)(x - 2)(x - 4)
+		assertRoots(t, []float64{-1, 1, 2, 4}, SolveQuartic(1, -6, 7, 6, -8))
+	})
+
+	t.Run("biquadratic", func(t *testing.T) {
+		// (x² - 1)(x² - 4)
+		assertRoots(t, []float64{-2, -1, 1, 2}, SolveQuartic(1, 0, -5, 0, 4))
+	})
+
+	t.Run("two real roots", func(t *testing.T) {
+		// (x - 1)(x - 3)(x² + 1)
+		assertRoots(t, []float64{1, 3}, SolveQuartic(1, -4, 4, -4, 3))
+	})
+
+	t.Run("no real roots", func(t *testing.T) {
+		assertRoots(t, []float64{}, SolveQuartic(1, 0, 2, 0, 1))
+	})
+
+	t.Run("double roots", func(t *testing.T) {
+		// (x - 1)²(x + 1)²
+		assertRoots(t, []float64{-1, 1}, SolveQuartic(1, 0, -2, 0, 1))
+		// (x - 1)²(x - 2)(x - 3)
+		assertRoots(t, []float64{1, 2, 3}, SolveQuartic(1, -7, 17, -17, 6))
+	})
+
+	t.Run("scaled coefficients", func(t *testing.T) {
+		// 1e-12·(x + 1)(x - 1)(x - 2)(x - 4)
+		assertRoots(t, []float64{-1, 1, 2, 4}, SolveQuartic(1e-12, -6e-12, 7e-12, 6e-12, -8e-12))
+	})
+
+	t.Run("degenerate to cubic", func(t *testing.T) {
+		assertRoots(t, []float64{-2, 1, 3}, SolveQuartic(0, 1, -2, -5, 6))
+	})
+}
+
+func TestSolveTangentCases(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		roots []float64
+		// The first root is a double one.
+		double float64
+	}{
+		{"cubic with a negative double root", []float64{-8.21}, -8.69},
+		{"cubic with a positive double root", []float64{-3}, 2},
+		{"cubic with a small double root", []float64{0.0245}, 0.0339},
+		{"cubic with a large double root", []float64{602.11}, 483.69},
+		{"cubic with a double root far from the other", []float64{-7e3}, 0.5},
+		{"quartic with a negative double root", []float64{-803.94, -261.78}, -938.64},
+		{"quartic with a positive double root", []float64{-31.14, -49.4}, 30.46},
+		{"quartic with a small double root", []float64{0.0012, -0.0071}, 0.0043},
+		{"quartic with a double root between the others", []float64{-5, 5}, 0.25},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				coeffs = polynomialFromRoots(append([]float64{test.double, test.double}, test.roots...)...)
+				want   = append([]float64{test.double}, test.roots...)
+				got    []float64
+			)
+
+			if len(coeffs) == 4 {
+				got = SolveCubic(coeffs[0], coeffs[1], coeffs[2], coeffs[3])
+			} else {
+				got = SolveQuartic(coeffs[0], coeffs[1], coeffs[2], coeffs[3], coeffs[4])
+			}
+
+			sort.Float64s(want)
+			assertRootsRel(t, want, got)
+		})
+	}
+}
+
+func TestSolveLargeRoots(t *testing.T) {
+	t.Run("cubic", func(t *testing.T) {
+		assertRootsRel(t, []float64{1e8}, SolveCubic(1, 0, 0, -1e24))
+	})
+
+	t.Run("quartic", func(t *testing.T) {
+		roots := []float64{-1245.7, 3291.2, 8810.2, 9504.8}
+		coeffs := polynomialFromRoots(roots...)
+
+		assertRootsRel(t, roots, SolveQuartic(coeffs[0], coeffs[1], coeffs[2], coeffs[3], coeffs[4]))
+	})
+}
+
+// polynomialFromRoots returns the coefficients, in descending degree order, of the monic
+// polynomial with the given roots.
+func polynomialFromRoots(roots ...float64) []float64 {
+	coeffs := []float64{1}
+	for _, root := range roots {
+		next := make([]float64, len(coeffs)+1)
+		for i, coeff := range coeffs {
+			next[i] += coeff
+			next[i+1] -= coeff * root
+		}
+		coeffs = next
+	}
+
+	return coeffs
+}
+
+func assertRootsRel(t *testing.T, want, got []float64) {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Fatalf("Want roots %v, got %v", want, got)
+	}
+	for i := range want {
+		if !MakeRelativeTolerance(1e-6).Equal(want[i], got[i]) {
+			t.Fatalf("Want roots %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,325 @@
+package nums
+
+import (
+	"math"
+	"math/big"
+)
+
+// The geometric predicates in this file return a value whose sign is always correct, no matter
+// how close the input points are to being degenerate.
+//
+// Following Shewchuk's "Adaptive Precision Floating-Point Arithmetic and Fast Robust Geometric
+// Predicates", each predicate is first evaluated with floating-point arithmetic, and the result
+// is returned if its magnitude exceeds a bound for the rounding error. Otherwise, the predicate
+// is evaluated again with exact rational arithmetic.
+//
+// Infinite and NaN coordinates have no exact value, so the predicates return NaN when the
+// floating-point evaluation isn't certain and any of the coordinates isn't finite.
+
+var (
+	// Half of the machine epsilon: the relative rounding error of a float64 operation.
+	predicatesEpsilon = 0.5 * MachineEpsilon
+
+	orient2dErrBound  = (3 + 16*predicatesEpsilon) * predicatesEpsilon
+	orient3dErrBound  = (7 + 56*predicatesEpsilon) * predicatesEpsilon
+	inCircleErrBound  = (10 + 96*predicatesEpsilon) * predicatesEpsilon
+	inSphereErrBound  = (16 + 224*predicatesEpsilon) * predicatesEpsilon
+	smallestMagnitude = math.SmallestNonzeroFloat64
+
+	// Below this magnitude, the products in the predicates may underflow, and the error bounds
+	// aren't valid.
+	underflowBound = math.Ldexp(1, -960)
+)
+
+/*
+Orient2D returns a positive value if the points a, b and c are in counter-clockwise order, a
+negative value if they are in clockwise order, and zero if they are aligned.
+
+The result is an approximation of twice the signed area of the triangle abc, but its sign is
+exact.
+*/
+func Orient2D(a, b, c [2]float64) float64 {
+	var (
+		detLeft  = (a[0] - c[0]) * (b[1] - c[1])
+		detRight = (a[1] - c[1]) * (b[0] - c[0])
+		det      = detLeft - detRight
+		detSum   = math.Abs(detLeft) + math.Abs(detRight)
+	)
+
+	if isCertain(det, orient2dErrBound*detSum, detSum) {
+		return det
+	}
+
+	return orient2DExact(a, b, c)
+}
+
+/*
+Orient3D returns a positive value if the point d lies below the plane passing through a, b and
+c, a negative value if it lies above the plane, and zero if the four points are coplanar.
+"Below" is defined so that a, b and c appear in counter-clockwise order when viewed from above
+the plane.
+
+The result is an approximation of six times the signed volume of the tetrahedron abcd, but its
+sign is exact.
+*/
+func Orient3D(a, b, c, d [3]float64) float64 {
+	var (
+		adx, ady, adz = a[0] - d[0], a[1] - d[1], a[2] - d[2]
+		bdx, bdy, bdz = b[0] - d[0], b[1] - d[1], b[2] - d[2]
+		cdx, cdy, cdz = c[0] - d[0], c[1] - d[1], c[2] - d[2]
+
+		bdxcdy, cdxbdy = bdx * cdy, cdx * bdy
+		cdxady, adxcdy = cdx * ady, adx * cdy
+		adxbdy, bdxady = adx * bdy, bdx * ady
+
+		det = adz*(bdxcdy-cdxbdy) + bdz*(cdxady-adxcdy) + cdz*(adxbdy-bdxady)
+
+		permanent = (math.Abs(bdxcdy)+math.Abs(cdxbdy))*math.Abs(adz) +
+			(math.Abs(cdxady)+math.Abs(adxcdy))*math.Abs(bdz) +
+			(math.Abs(adxbdy)+math.Abs(bdxady))*math.Abs(cdz)
+	)
+
+	if isCertain(det, orient3dErrBound*permanent, permanent) {
+		return det
+	}
+
+	return orient3DExact(a, b, c, d)
+}
+
+/*
+InCircle returns a positive value if the point d lies inside the circle passing through a, b
+and c, a negative value if it lies outside, and zero if the four points are cocircular.
+The points a, b and c must be in counter-clockwise order, or the sign of the result is
+reversed.
+*/
+func InCircle(a, b, c, d [2]float64) float64 {
+	var (
+		adx, ady = a[0] - d[0], a[1] - d[1]
+		bdx, bdy = b[0] - d[0], b[1] - d[1]
+		cdx, cdy = c[0] - d[0], c[1] - d[1]
+
+		bdxcdy, cdxbdy = bdx * cdy, cdx * bdy
+		cdxady, adxcdy = cdx * ady, adx * cdy
+		adxbdy, bdxady = adx * bdy, bdx * ady
+
+		aLift = adx*adx + ady*ady
+		bLift = bdx*bdx + bdy*bdy
+		cLift = cdx*cdx + cdy*cdy
+
+		det = aLift*(bdxcdy-cdxbdy) + bLift*(cdxady-adxcdy) + cLift*(adxbdy-bdxady)
+
+		permanent = (math.Abs(bdxcdy)+math.Abs(cdxbdy))*aLift +
+			(math.Abs(cdxady)+math.Abs(adxcdy))*bLift +
+			(math.Abs(adxbdy)+math.Abs(bdxady))*cLift
+	)
+
+	if isCertain(det, inCircleErrBound*permanent, permanent) {
+		return det
+	}
+
+	return inCircleExact(a, b, c, d)
+}
+
+/*
+InSphere returns a positive value if the point e lies inside the sphere passing through a, b, c
+and d, a negative value if it lies outside, and zero if the five points are cospherical.
+The points a, b, c and d must be ordered so that Orient3D(a, b, c, d) is positive, or the sign
+of the result is reversed.
+*/
+func InSphere(a, b, c, d, e [3]float64) float64 {
+	var (
+		aex, aey, aez = a[0] - e[0], a[1] - e[1], a[2] - e[2]
+		bex, bey, bez = b[0] - e[0], b[1] - e[1], b[2] - e[2]
+		cex, cey, cez = c[0] - e[0], c[1] - e[1], c[2] - e[2]
+		dex, dey, dez = d[0] - e[0], d[1] - e[1], d[2] - e[2]
+
+		aexbey, bexaey = aex * bey, bex * aey
+		bexcey, cexbey = bex * cey, cex * bey
+		cexdey, dexcey = cex * dey, dex * cey
+		dexaey, aexdey = dex * aey, aex * dey
+		aexcey, cexaey = aex * cey, cex * aey
+		bexdey, dexbey = bex * dey, dex * bey
+
+		ab, bc, cd, da = aexbey - bexaey, bexcey - cexbey, cexdey - dexcey, dexaey - aexdey
+		ac, bd         = aexcey - cexaey, bexdey - dexbey
+
+		abc = aez*bc - bez*ac + cez*ab
+		bcd = bez*cd - cez*bd + dez*bc
+		cda = cez*da + dez*ac + aez*cd
+		dab = dez*ab + aez*bd + bez*da
+
+		aLift = aex*aex + aey*aey + aez*aez
+		bLift = bex*bex + bey*bey + bez*bez
+		cLift = cex*cex + cey*cey + cez*cez
+		dLift = dex*dex + dey*dey + dez*dez
+
+		det = (dLift*abc - cLift*dab) + (bLift*cda - aLift*bcd)
+
+		aezAbs, bezAbs, cezAbs, dezAbs = math.Abs(aez), math.Abs(bez), math.Abs(cez), math.Abs(dez)
+
+		abPlus = math.Abs(aexbey) + math.Abs(bexaey)
+		bcPlus = math.Abs(bexcey) + math.Abs(cexbey)
+		cdPlus = math.Abs(cexdey) + math.Abs(dexcey)
+		daPlus = math.Abs(dexaey) + math.Abs(aexdey)
+		acPlus = math.Abs(aexcey) + math.Abs(cexaey)
+		bdPlus = math.Abs(bexdey) + math.Abs(dexbey)
+
+		permanent = (cdPlus*bezAbs+bdPlus*cezAbs+bcPlus*dezAbs)*aLift +
+			(daPlus*cezAbs+acPlus*dezAbs+cdPlus*aezAbs)*bLift +
+			(abPlus*dezAbs+bdPlus*aezAbs+daPlus*bezAbs)*cLift +
+			(bcPlus*aezAbs+acPlus*bezAbs+abPlus*cezAbs)*dLift
+	)
+
+	if isCertain(det, inSphereErrBound*permanent, permanent) {
+		return det
+	}
+
+	return inSphereExact(a, b, c, d, e)
+}
+
+// isCertain returns true if the sign of the floating-point determinant can be trusted, given
+// the bound of its rounding error and the permanent (sum of the magnitudes of its terms).
+func isCertain(det, errBound, permanent float64) bool {
+	return (det > errBound || -det > errBound) && permanent > underflowBound
+}
+
+/* <-- Exact evaluation --> */
+
+func orient2DExact(a, b, c [2]float64) float64 {
+	if !areFinite(a[:], b[:], c[:]) {
+		return math.NaN()
+	}
+
+	var (
+		acx, acy = ratSub(a[0], c[0]), ratSub(a[1], c[1])
+		bcx, bcy = ratSub(b[0], c[0]), ratSub(b[1], c[1])
+	)
+
+	return ratToFloat(ratDet2(acx, acy, bcx, bcy))
+}
+
+func orient3DExact(a, b, c, d [3]float64) float64 {
+	if !areFinite(a[:], b[:], c[:], d[:]) {
+		return math.NaN()
+	}
+
+	var (
+		adx, ady, adz = ratSub(a[0], d[0]), ratSub(a[1], d[1]), ratSub(a[2], d[2])
+		bdx, bdy, bdz = ratSub(b[0], d[0]), ratSub(b[1], d[1]), ratSub(b[2], d[2])
+		cdx, cdy, cdz = ratSub(c[0], d[0]), ratSub(c[1], d[1]), ratSub(c[2], d[2])
+	)
+
+	return ratToFloat(ratDet3(adx, ady, adz, bdx, bdy, bdz, cdx, cdy, cdz))
+}
+
+func inCircleExact(a, b, c, d [2]float64) float64 {
+	if !areFinite(a[:], b[:], c[:], d[:]) {
+		return math.NaN()
+	}
+
+	var (
+		adx, ady = ratSub(a[0], d[0]), ratSub(a[1], d[1])
+		bdx, bdy = ratSub(b[0], d[0]), ratSub(b[1], d[1])
+		cdx, cdy = ratSub(c[0], d[0]), ratSub(c[1], d[1])
+	)
+
+	return ratToFloat(ratDet3(
+		adx, ady, ratLift(adx, ady),
+		bdx, bdy, ratLift(bdx, bdy),
+		cdx, cdy, ratLift(cdx, cdy),
+	))
+}
+
+func inSphereExact(a, b, c, d, e [3]float64) float64 {
+	if !areFinite(a[:], b[:], c[:], d[:], e[:]) {
+		return math.NaN()
+	}
+
+	var (
+		rows = make([][4]*big.Rat, 4)
+		det  = new(big.Rat)
+	)
+
+	for i, p := range [][3]float64{a, b, c, d} {
+		x, y, z := ratSub(p[0], e[0]), ratSub(p[1], e[1]), ratSub(p[2], e[2])
+		rows[i] = [4]*big.Rat{x, y, z, ratLift(x, y, z)}
+	}
+
+	// Cofactor expansion along the fourth column.
+	for i := 0; i < 4; i++ {
+		minor := make([][3]*big.Rat, 0, 3)
+		for j := 0; j < 4; j++ {
+			if j != i {
+				minor = append(minor, [3]*big.Rat{rows[j][0], rows[j][1], rows[j][2]})
+			}
+		}
+
+		term := new(big.Rat).Mul(rows[i][3], ratDet3(
+			minor[0][0], minor[0][1], minor[0][2],
+			minor[1][0], minor[1][1], minor[1][2],
+			minor[2][0], minor[2][1], minor[2][2],
+		))
+
+		if i%2 == 0 {
+			det.Sub(det, term)
+		} else {
+			det.Add(det, term)
+		}
+	}
+
+	return ratToFloat(det)
+}
+
+// areFinite checks whether all the coordinates of the points are finite, so that they can be
+// converted into rational numbers.
+func areFinite(points ...[]float64) bool {
+	for _, point := range points {
+		for _, coord := range point {
+			if math.IsNaN(coord) || math.IsInf(coord, 0) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func ratSub(a, b float64) *big.Rat {
+	ra := new(big.Rat).SetFloat64(a)
+	return ra.Sub(ra, new(big.Rat).SetFloat64(b))
+}
+
+func ratDet2(a, b, c, d *big.Rat) *big.Rat {
+	ad := new(big.Rat).Mul(a, d)
+	return ad.Sub(ad, new(big.Rat).Mul(b, c))
+}
+
+func ratDet3(a, b, c, d, e, f, g, h, i *big.Rat) *big.Rat {
+	var (
+		t1 = new(big.Rat).Mul(a, ratDet2(e, f, h, i))
+		t2 = new(big.Rat).Mul(b, ratDet2(d, f, g, i))
+		t3 = new(big.Rat).Mul(c, ratDet2(d, e, g, h))
+	)
+
+	return t1.Sub(t1, t2).Add(t1, t3)
+}
+
+func ratLift(coords ...*big.Rat) *big.Rat {
+	lift := new(big.Rat)
+	for _, coord := range coords {
+		lift.Add(lift, new(big.Rat).Mul(coord, coord))
+	}
+
+	return lift
+}
+
+// ratToFloat converts the rational to the nearest float64, making sure that the sign of the
+// result is the same as that of the rational, even if its magnitude is too small.
+func ratToFloat(r *big.Rat) float64 {
+	value, _ := r.Float64()
+	if value == 0 && r.Sign() != 0 {
+		return float64(r.Sign()) * smallestMagnitude
+	}
+
+	return value
+}
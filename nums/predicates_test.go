@@ -0,0 +1,178 @@
+package nums
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func sign(value float64) int {
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func TestOrient2D(t *testing.T) {
+	t.Run("counter-clockwise points", func(t *testing.T) {
+		if got := Orient2D([2]float64{0, 0}, [2]float64{1, 0}, [2]float64{0, 1}); !FloatsEqual(got, 1) {
+			t.Errorf("Want 1, got %f", got)
+		}
+	})
+
+	t.Run("clockwise points", func(t *testing.T) {
+		if got := Orient2D([2]float64{0, 0}, [2]float64{0, 1}, [2]float64{1, 0}); got >= 0 {
+			t.Errorf("Want negative value, got %f", got)
+		}
+	})
+
+	t.Run("aligned points", func(t *testing.T) {
+		if got := Orient2D([2]float64{0, 0}, [2]float64{1, 1}, [2]float64{2, 2}); got != 0 {
+			t.Errorf("Want 0, got %g", got)
+		}
+	})
+
+	t.Run("nearly aligned points have the exact sign", func(t *testing.T) {
+		var (
+			ulp = math.Nextafter(0.5, 1) - 0.5
+			b   = [2]float64{12, 12}
+			c   = [2]float64{24, 24}
+		)
+
+		for i := 0; i < 16; i++ {
+			for j := 0; j < 16; j++ {
+				a := [2]float64{0.5 + float64(i)*ulp, 0.5 + float64(j)*ulp}
+
+				if got, want := sign(Orient2D(a, b, c)), sign(orient2DExact(a, b, c)); got != want {
+					t.Fatalf("Want sign %d for %v, got %d", want, a, got)
+				}
+				if got, want := sign(Orient2D(a, b, c)), sign(float64(j-i)); got != want {
+					t.Fatalf("Want sign %d for %v, got %d", want, a, got)
+				}
+			}
+		}
+	})
+
+	t.Run("tiny values keep their sign", func(t *testing.T) {
+		var (
+			tiny = math.SmallestNonzeroFloat64
+			got  = Orient2D([2]float64{0, 0}, [2]float64{tiny, 0}, [2]float64{0, tiny})
+		)
+
+		if got <= 0 {
+			t.Errorf("Want positive value, got %g", got)
+		}
+	})
+}
+
+func TestOrient3D(t *testing.T) {
+	var (
+		a = [3]float64{0, 0, 0}
+		b = [3]float64{1, 0, 0}
+		c = [3]float64{0, 1, 0}
+	)
+
+	if got := Orient3D(a, b, c, [3]float64{0, 0, -1}); !FloatsEqual(got, 1) {
+		t.Errorf("Want 1 for a point below the plane, got %f", got)
+	}
+	if got := Orient3D(a, b, c, [3]float64{0, 0, 1}); !FloatsEqual(got, -1) {
+		t.Errorf("Want -1 for a point above the plane, got %f", got)
+	}
+	if got := Orient3D(a, b, c, [3]float64{0.1, 0.3, 0}); got != 0 {
+		t.Errorf("Want 0 for a coplanar point, got %g", got)
+	}
+}
+
+func TestInCircle(t *testing.T) {
+	var (
+		a = [2]float64{1, 0}
+		b = [2]float64{0, 1}
+		c = [2]float64{-1, 0}
+	)
+
+	if got := InCircle(a, b, c, [2]float64{0, 0}); got <= 0 {
+		t.Errorf("Want positive value for a point inside, got %f", got)
+	}
+	if got := InCircle(a, b, c, [2]float64{2, 2}); got >= 0 {
+		t.Errorf("Want negative value for a point outside, got %f", got)
+	}
+	if got := InCircle(a, b, c, [2]float64{0, -1}); got != 0 {
+		t.Errorf("Want 0 for a point on the circle, got %g", got)
+	}
+}
+
+func TestInSphere(t *testing.T) {
+	var (
+		a = [3]float64{1, 0, 0}
+		b = [3]float64{0, 1, 0}
+		c = [3]float64{-1, 0, 0}
+		d = [3]float64{0, 0, -1}
+	)
+
+	if Orient3D(a, b, c, d) <= 0 {
+		t.Fatal("Expected the test points to be positively oriented")
+	}
+	if got := InSphere(a, b, c, d, [3]float64{0, 0, 0.5}); got <= 0 {
+		t.Errorf("Want positive value for a point inside, got %f", got)
+	}
+	if got := InSphere(a, b, c, d, [3]float64{0, 2, 0}); got >= 0 {
+		t.Errorf("Want negative value for a point outside, got %f", got)
+	}
+	if got := InSphere(a, b, c, d, [3]float64{0, 0, 1}); got != 0 {
+		t.Errorf("Want 0 for a point on the sphere, got %g", got)
+	}
+}
+
+func TestPredicatesMatchExactEvaluation(t *testing.T) {
+	var (
+		rnd    = rand.New(rand.NewSource(42))
+		point2 = func() [2]float64 { return [2]float64{rnd.Float64(), rnd.Float64()} }
+		point3 = func() [3]float64 { return [3]float64{rnd.Float64(), rnd.Float64(), rnd.Float64()} }
+	)
+
+	for i := 0; i < 200; i++ {
+		a, b, c, d := point2(), point2(), point2(), point2()
+		if sign(Orient2D(a, b, c)) != sign(orient2DExact(a, b, c)) {
+			t.Fatalf("Orient2D sign mismatch for %v, %v, %v", a, b, c)
+		}
+		if sign(InCircle(a, b, c, d)) != sign(inCircleExact(a, b, c, d)) {
+			t.Fatalf("InCircle sign mismatch for %v, %v, %v, %v", a, b, c, d)
+		}
+
+		p, q, r, s, u := point3(), point3(), point3(), point3(), point3()
+		if sign(Orient3D(p, q, r, s)) != sign(orient3DExact(p, q, r, s)) {
+			t.Fatalf("Orient3D sign mismatch for %v, %v, %v, %v", p, q, r, s)
+		}
+		if sign(InSphere(p, q, r, s, u)) != sign(inSphereExact(p, q, r, s, u)) {
+			t.Fatalf("InSphere sign mismatch for %v, %v, %v, %v, %v", p, q, r, s, u)
+		}
+	}
+}
+
+func TestPredicatesWithNonFiniteCoordinates(t *testing.T) {
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		var (
+			p2 = [2]float64{value, 0}
+			p3 = [3]float64{value, 0, 0}
+			o2 = [2]float64{0, 0}
+			o3 = [3]float64{0, 0, 0}
+		)
+
+		if got := Orient2D(o2, [2]float64{1, 0}, p2); !math.IsNaN(got) {
+			t.Errorf("Orient2D: want NaN for %g, got %g", value, got)
+		}
+		if got := Orient3D(o3, [3]float64{1, 0, 0}, [3]float64{0, 1, 0}, p3); !math.IsNaN(got) {
+			t.Errorf("Orient3D: want NaN for %g, got %g", value, got)
+		}
+		if got := InCircle(o2, [2]float64{1, 0}, [2]float64{0, 1}, p2); !math.IsNaN(got) {
+			t.Errorf("InCircle: want NaN for %g, got %g", value, got)
+		}
+		if got := InSphere(o3, [3]float64{1, 0, 0}, [3]float64{0, 1, 0}, [3]float64{0, 0, 1}, p3); !math.IsNaN(got) {
+			t.Errorf("InSphere: want NaN for %g, got %g", value, got)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package nums
+
+import (
+	"errors"
+	"math"
+)
+
+/*
+A GaussLegendreRule is a one-dimensional quadrature rule which integrates a function evaluating
+it at a set of nodes in [-1, 1], and adding the values multiplied by their weights.
+
+A rule of order n has n nodes and integrates exactly polynomials of degree up to 2n - 1.
+*/
+type GaussLegendreRule struct {
+	nodes   []float64
+	weights []float64
+}
+
+/*
+An ElementRule is a quadrature rule which integrates a function over a reference element:
+a triangle, a quadrilateral or a tetrahedron. Each of its nodes has the natural coordinates of a
+point in the reference element.
+*/
+type ElementRule struct {
+	nodes   [][]float64
+	weights []float64
+}
+
+/* <-- Construction --> */
+
+/*
+MakeGaussLegendreRule returns the Gauss–Legendre rule with the given number of nodes.
+
+A non-nil error is returned if the order is smaller than one.
+*/
+func MakeGaussLegendreRule(order int) (*GaussLegendreRule, error) {
+	if order < 1 {
+		return nil, errors.New("the order of a Gauss-Legendre rule must be at least one")
+	}
+
+	var (
+		nodes   = make([]float64, order)
+		weights = make([]float64, order)
+		n       = float64(order)
+	)
+
+	// The roots are symmetric, so only half of them are computed, using Newton's method on the
+	// Legendre polynomial of degree n.
+	for i := 0; i < (order+1)/2; i++ {
+		var (
+			x     = math.Cos(math.Pi * (float64(i) + 0.75) / (n + 0.5))
+			deriv float64
+		)
+
+		for iter := 0; iter < 100; iter++ {
+			var value float64
+			value, deriv = legendreAt(order, x)
+
+			dx := value / deriv
+			x -= dx
+			if math.Abs(dx) < 1e-15 {
+				break
+			}
+		}
+
+		_, deriv = legendreAt(order, x)
+		weight := 2 / ((1 - x*x) * deriv * deriv)
+
+		nodes[i], nodes[order-1-i] = -x, x
+		weights[i], weights[order-1-i] = weight, weight
+	}
+
+	if order%2 == 1 {
+		nodes[order/2] = 0
+	}
+
+	return &GaussLegendreRule{nodes, weights}, nil
+}
+
+/*
+MakeTriangleRule returns a quadrature rule for the reference triangle, with vertices (0, 0),
+(1, 0) and (0, 1), which integrates exactly polynomials of the given degree.
+
+A non-nil error is returned if the degree is smaller than one or greater than five.
+*/
+func MakeTriangleRule(degree int) (*ElementRule, error) {
+	switch {
+	case degree < 1:
+		return nil, errors.New("the degree of a triangle rule must be at least one")
+	case degree == 1:
+		return &ElementRule{[][]float64{{1.0 / 3, 1.0 / 3}}, []float64{0.5}}, nil
+	case degree == 2:
+		return triangleRuleFromOrbits(0, triangleOrbit{1.0 / 6, 1.0 / 6}), nil
+	case degree <= 4:
+		return triangleRuleFromOrbits(
+			0,
+			triangleOrbit{0.445948490915965, 0.223381589678011 / 2},
+			triangleOrbit{0.091576213509771, 0.109951743655322 / 2},
+		), nil
+	case degree == 5:
+		sqrt15 := math.Sqrt(15)
+		return triangleRuleFromOrbits(
+			9.0/80,
+			triangleOrbit{(6 - sqrt15) / 21, (155 - sqrt15) / 2400},
+			triangleOrbit{(6 + sqrt15) / 21, (155 + sqrt15) / 2400},
+		), nil
+	default:
+		return nil, errors.New("triangle rules are available up to degree five")
+	}
+}
+
+/*
+MakeQuadRule returns the quadrature rule for the reference quadrilateral, the square [-1, 1] x
+[-1, 1], which is the product of two Gauss–Legendre rules of the given order.
+
+A non-nil error is returned if the order is smaller than one.
+*/
+func MakeQuadRule(order int) (*ElementRule, error) {
+	rule, err := MakeGaussLegendreRule(order)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nodes   = make([][]float64, 0, order*order)
+		weights = make([]float64, 0, order*order)
+	)
+
+	for i, xi := range rule.nodes {
+		for j, eta := range rule.nodes {
+			nodes = append(nodes, []float64{xi, eta})
+			weights = append(weights, rule.weights[i]*rule.weights[j])
+		}
+	}
+
+	return &ElementRule{nodes, weights}, nil
+}
+
+/*
+MakeTetrahedronRule returns a quadrature rule for the reference tetrahedron, with vertices
+(0, 0, 0), (1, 0, 0), (0, 1, 0) and (0, 0, 1), which integrates exactly polynomials of the given
+degree.
+
+The rule of degree three has a negative weight.
+
+A non-nil error is returned if the degree is smaller than one or greater than three.
+*/
+func MakeTetrahedronRule(degree int) (*ElementRule, error) {
+	switch {
+	case degree < 1:
+		return nil, errors.New("the degree of a tetrahedron rule must be at least one")
+	case degree == 1:
+		return &ElementRule{[][]float64{{0.25, 0.25, 0.25}}, []float64{1.0 / 6}}, nil
+	case degree == 2:
+		var (
+			a = (5 + 3*math.Sqrt(5)) / 20
+			b = (5 - math.Sqrt(5)) / 20
+		)
+
+		return &ElementRule{
+			[][]float64{{a, b, b}, {b, a, b}, {b, b, a}, {b, b, b}},
+			[]float64{1.0 / 24, 1.0 / 24, 1.0 / 24, 1.0 / 24},
+		}, nil
+	case degree == 3:
+		return &ElementRule{
+			[][]float64{
+				{0.25, 0.25, 0.25},
+				{0.5, 1.0 / 6, 1.0 / 6}, {1.0 / 6, 0.5, 1.0 / 6}, {1.0 / 6, 1.0 / 6, 0.5},
+				{1.0 / 6, 1.0 / 6, 1.0 / 6},
+			},
+			[]float64{-2.0 / 15, 3.0 / 40, 3.0 / 40, 3.0 / 40, 3.0 / 40},
+		}, nil
+	default:
+		return nil, errors.New("tetrahedron rules are available up to degree three")
+	}
+}
+
+/* <-- Gauss–Legendre rules --> */
+
+/*
+Order returns the number of nodes of the rule.
+*/
+func (r *GaussLegendreRule) Order() int {
+	return len(r.nodes)
+}
+
+/*
+Nodes returns a copy of the nodes of the rule, in [-1, 1].
+*/
+func (r *GaussLegendreRule) Nodes() []float64 {
+	return append([]float64(nil), r.nodes...)
+}
+
+/*
+Weights returns a copy of the weights of the rule.
+*/
+func (r *GaussLegendreRule) Weights() []float64 {
+	return append([]float64(nil), r.weights...)
+}
+
+/*
+Integrate approximates the integral of the function in the interval [a, b].
+*/
+func (r *GaussLegendreRule) Integrate(f func(x float64) float64, a, b float64) float64 {
+	var (
+		halfLength = 0.5 * (b - a)
+		middle     = 0.5 * (a + b)
+		sum        = 0.0
+	)
+
+	for i, node := range r.nodes {
+		sum += r.weights[i] * f(middle+halfLength*node)
+	}
+
+	return halfLength * sum
+}
+
+/*
+IntegrateTParams approximates the integral of the function in the range of T parameters
+[start, end].
+*/
+func (r *GaussLegendreRule) IntegrateTParams(f func(t TParam) float64, start, end TParam) float64 {
+	return r.Integrate(func(x float64) float64 { return f(MakeTParam(x)) }, start.value, end.value)
+}
+
+/* <-- Element rules --> */
+
+/*
+Len returns the number of nodes of the rule.
+*/
+func (r *ElementRule) Len() int {
+	return len(r.nodes)
+}
+
+/*
+Node returns a copy of the natural coordinates of the node at the given index.
+*/
+func (r *ElementRule) Node(index int) []float64 {
+	return append([]float64(nil), r.nodes[index]...)
+}
+
+/*
+Weight returns the weight of the node at the given index.
+*/
+func (r *ElementRule) Weight(index int) float64 {
+	return r.weights[index]
+}
+
+/*
+Integrate approximates the integral of the function over the reference element. The function
+receives the natural coordinates of each node.
+*/
+func (r *ElementRule) Integrate(f func(coords []float64) float64) float64 {
+	sum := 0.0
+	for i, node := range r.nodes {
+		sum += r.weights[i] * f(node)
+	}
+
+	return sum
+}
+
+// legendreAt evaluates the Legendre polynomial of the given degree, and its derivative, at x.
+func legendreAt(degree int, x float64) (float64, float64) {
+	var (
+		current  = 1.0
+		previous = 0.0
+	)
+
+	for k := 1; k <= degree; k++ {
+		current, previous = ((2*float64(k)-1)*x*current-(float64(k)-1)*previous)/float64(k), current
+	}
+
+	deriv := float64(degree) * (x*current - previous) / (x*x - 1)
+	return current, deriv
+}
+
+// A triangleOrbit is a set of three triangle nodes with the same weight, whose barycentric
+// coordinates are the permutations of (a, a, 1 - 2a).
+type triangleOrbit struct {
+	a, weight float64
+}
+
+func triangleRuleFromOrbits(centroidWeight float64, orbits ...triangleOrbit) *ElementRule {
+	rule := &ElementRule{}
+	if centroidWeight != 0 {
+		rule.nodes = append(rule.nodes, []float64{1.0 / 3, 1.0 / 3})
+		rule.weights = append(rule.weights, centroidWeight)
+	}
+
+	for _, orbit := range orbits {
+		var (
+			a = orbit.a
+			b = 1 - 2*orbit.a
+		)
+
+		rule.nodes = append(rule.nodes, []float64{a, a}, []float64{b, a}, []float64{a, b})
+		rule.weights = append(rule.weights, orbit.weight, orbit.weight, orbit.weight)
+	}
+
+	return rule
+}
@@ -0,0 +1,149 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGaussLegendreRule(t *testing.T) {
+	t.Run("order must be positive", func(t *testing.T) {
+		if _, err := MakeGaussLegendreRule(0); err == nil {
+			t.Error("Expected an error")
+		}
+	})
+
+	t.Run("known nodes and weights of order two", func(t *testing.T) {
+		rule, _ := MakeGaussLegendreRule(2)
+		var (
+			nodes   = rule.Nodes()
+			weights = rule.Weights()
+		)
+
+		if !FloatsEqual(nodes[0], -1/math.Sqrt(3)) || !FloatsEqual(nodes[1], 1/math.Sqrt(3)) {
+			t.Errorf("Unexpected nodes %v", nodes)
+		}
+		if !FloatsEqual(weights[0], 1) || !FloatsEqual(weights[1], 1) {
+			t.Errorf("Unexpected weights %v", weights)
+		}
+	})
+
+	t.Run("weights add up to the interval's length", func(t *testing.T) {
+		for order := 1; order <= 20; order++ {
+			rule, _ := MakeGaussLegendreRule(order)
+			if got := rule.Integrate(func(float64) float64 { return 1 }, -1, 1); !FloatsEqual(got, 2) {
+				t.Errorf("Want 2 for order %d, got %f", order, got)
+			}
+		}
+	})
+
+	t.Run("integrates polynomials of degree 2n - 1 exactly", func(t *testing.T) {
+		for order := 1; order <= 10; order++ {
+			var (
+				rule, _ = MakeGaussLegendreRule(order)
+				degree  = float64(2*order - 1)
+				f       = func(x float64) float64 { return math.Pow(x, degree) + 1 }
+				want    = (math.Pow(3, degree+1)-math.Pow(1, degree+1))/(degree+1) + 2
+			)
+
+			if got := rule.Integrate(f, 1, 3); !FloatsEqualEps(got, want, 1e-10*want) {
+				t.Errorf("Want %f for order %d, got %f", want, order, got)
+			}
+		}
+	})
+
+	t.Run("integrates over a range of T parameters", func(t *testing.T) {
+		var (
+			rule, _ = MakeGaussLegendreRule(3)
+			f       = func(t TParam) float64 { return t.Value() * t.Value() }
+			got     = rule.IntegrateTParams(f, MakeTParam(0.5), MaxT)
+		)
+
+		if want := 7.0 / 24; !FloatsEqual(got, want) {
+			t.Errorf("Want %f, got %f", want, got)
+		}
+	})
+}
+
+func TestTriangleRule(t *testing.T) {
+	// The integral of x^i y^j over the reference triangle is i! j! / (i + j + 2)!.
+	monomialIntegral := func(i, j int) float64 {
+		return factorial(i) * factorial(j) / factorial(i+j+2)
+	}
+
+	for degree := 1; degree <= 5; degree++ {
+		rule, err := MakeTriangleRule(degree)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i <= degree; i++ {
+			for j := 0; i+j <= degree; j++ {
+				var (
+					f    = func(c []float64) float64 { return math.Pow(c[0], float64(i)) * math.Pow(c[1], float64(j)) }
+					got  = rule.Integrate(f)
+					want = monomialIntegral(i, j)
+				)
+
+				if !FloatsEqualEps(got, want, 1e-12) {
+					t.Errorf("Degree %d: want %g for x^%d y^%d, got %g", degree, want, i, j, got)
+				}
+			}
+		}
+	}
+
+	if _, err := MakeTriangleRule(6); err == nil {
+		t.Error("Expected an error for an unsupported degree")
+	}
+}
+
+func TestQuadRule(t *testing.T) {
+	var (
+		rule, _ = MakeQuadRule(2)
+		f       = func(c []float64) float64 { return c[0]*c[0]*c[1]*c[1] + c[0]*c[1]*c[1]*c[1] }
+	)
+
+	if got := rule.Len(); got != 4 {
+		t.Errorf("Want 4 nodes, got %d", got)
+	}
+	if got, want := rule.Integrate(f), 4.0/9; !FloatsEqual(got, want) {
+		t.Errorf("Want %f, got %f", want, got)
+	}
+}
+
+func TestTetrahedronRule(t *testing.T) {
+	// The integral of x^i y^j z^k over the reference tetrahedron is i! j! k! / (i + j + k + 3)!.
+	monomialIntegral := func(i, j, k int) float64 {
+		return factorial(i) * factorial(j) * factorial(k) / factorial(i+j+k+3)
+	}
+
+	for degree := 1; degree <= 3; degree++ {
+		rule, _ := MakeTetrahedronRule(degree)
+
+		for i := 0; i <= degree; i++ {
+			for j := 0; i+j <= degree; j++ {
+				for k := 0; i+j+k <= degree; k++ {
+					var (
+						f = func(c []float64) float64 {
+							return math.Pow(c[0], float64(i)) * math.Pow(c[1], float64(j)) * math.Pow(c[2], float64(k))
+						}
+						got  = rule.Integrate(f)
+						want = monomialIntegral(i, j, k)
+					)
+
+					if !FloatsEqualEps(got, want, 1e-12) {
+						t.Errorf("Degree %d: want %g for x^%d y^%d z^%d, got %g", degree, want, i, j, k, got)
+					}
+				}
+			}
+		}
+	}
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+
+	return result
+}
@@ -0,0 +1,215 @@
+package nums
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNotBracketed is returned when the function doesn't change its sign in the given interval.
+	ErrNotBracketed = errors.New("the function values at the ends of the interval must have opposite signs")
+	// ErrNoConvergence is returned when a root isn't found in the maximum number of iterations.
+	ErrNoConvergence = errors.New("the root wasn't found in the maximum number of iterations")
+	// ErrZeroDerivative is returned when Newton's method reaches a point with a zero derivative.
+	ErrZeroDerivative = errors.New("the derivative of the function is zero")
+)
+
+/*
+BracketRoot expands the interval [a, b] geometrically until the function has opposite signs at
+its ends, and returns the resulting interval.
+
+An ErrNotBracketed error is returned if no sign change is found in the maximum number of
+iterations.
+*/
+func BracketRoot(f func(x float64) float64, a, b float64, maxIterations int) (float64, float64, error) {
+	const growth = 1.6
+
+	if a == b {
+		return a, b, errors.New("the interval must have a non-zero length")
+	}
+
+	fa, fb := f(a), f(b)
+	for i := 0; i < maxIterations; i++ {
+		if fa*fb <= 0 {
+			return a, b, nil
+		}
+
+		// Expand in the direction where the function is closer to zero.
+		if math.Abs(fa) < math.Abs(fb) {
+			a += growth * (a - b)
+			fa = f(a)
+		} else {
+			b += growth * (b - a)
+			fb = f(b)
+		}
+	}
+
+	if fa*fb <= 0 {
+		return a, b, nil
+	}
+
+	return a, b, ErrNotBracketed
+}
+
+/*
+Bisection finds a root of the function in the interval [a, b] by halving it until its length is
+smaller than the tolerance.
+
+An ErrNotBracketed error is returned if the function values at a and b have the same sign, and an
+ErrNoConvergence error if the tolerance isn't reached in the maximum number of iterations.
+*/
+func Bisection(f func(x float64) float64, a, b, tolerance float64, maxIterations int) (float64, error) {
+	fa, fb := f(a), f(b)
+	switch {
+	case fa == 0:
+		return a, nil
+	case fb == 0:
+		return b, nil
+	case fa*fb > 0:
+		return 0, ErrNotBracketed
+	}
+
+	for i := 0; i < maxIterations; i++ {
+		var (
+			middle  = a + 0.5*(b-a)
+			fMiddle = f(middle)
+		)
+
+		if fMiddle == 0 || math.Abs(b-a) < tolerance {
+			return middle, nil
+		}
+
+		if fa*fMiddle < 0 {
+			b = middle
+		} else {
+			a, fa = middle, fMiddle
+		}
+	}
+
+	return a + 0.5*(b-a), ErrNoConvergence
+}
+
+/*
+Brent finds a root of the function in the interval [a, b] using Brent's method, which combines
+the safety of bisection with the fast convergence of the secant method and inverse quadratic
+interpolation.
+
+An ErrNotBracketed error is returned if the function values at a and b have the same sign, and an
+ErrNoConvergence error if the tolerance isn't reached in the maximum number of iterations.
+*/
+func Brent(f func(x float64) float64, a, b, tolerance float64, maxIterations int) (float64, error) {
+	fa, fb := f(a), f(b)
+	switch {
+	case fa == 0:
+		return a, nil
+	case fb == 0:
+		return b, nil
+	case fa*fb > 0:
+		return 0, ErrNotBracketed
+	}
+
+	// b is the best estimate of the root, a the previous one and c the other end of the bracket.
+	var (
+		c, fc = a, fa
+		d     = b - a
+		e     = d
+	)
+
+	for i := 0; i < maxIterations; i++ {
+		if fb*fc > 0 {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		var (
+			tol    = 2*MachineEpsilon*math.Abs(b) + 0.5*tolerance
+			middle = 0.5 * (c - b)
+		)
+
+		if math.Abs(middle) <= tol || fb == 0 {
+			return b, nil
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			var (
+				s    = fb / fa
+				p, q float64
+			)
+
+			if a == c {
+				// Secant method.
+				p = 2 * middle * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				var (
+					qa = fa / fc
+					r  = fb / fc
+				)
+
+				p = s * (2*middle*qa*(qa-r) - (b-a)*(r-1))
+				q = (qa - 1) * (r - 1) * (s - 1)
+			}
+
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+
+			if 2*p < math.Min(3*middle*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = middle, middle
+			}
+		} else {
+			d, e = middle, middle
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, middle)
+		}
+		fb = f(b)
+	}
+
+	return b, ErrNoConvergence
+}
+
+/*
+Newton finds a root of the function using Newton's method, starting at x0 and stopping when the
+step is smaller than the tolerance. The derivative of the function is given by df.
+
+An ErrZeroDerivative error is returned if the derivative is zero at any of the iterations, and
+an ErrNoConvergence error if the tolerance isn't reached in the maximum number of iterations.
+*/
+func Newton(f, df func(x float64) float64, x0, tolerance float64, maxIterations int) (float64, error) {
+	x := x0
+	for i := 0; i < maxIterations; i++ {
+		value := f(x)
+		if value == 0 {
+			return x, nil
+		}
+
+		deriv := df(x)
+		if deriv == 0 {
+			return x, ErrZeroDerivative
+		}
+
+		step := value / deriv
+		x -= step
+
+		if math.Abs(step) < tolerance {
+			return x, nil
+		}
+	}
+
+	return x, ErrNoConvergence
+}
@@ -0,0 +1,123 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBracketRoot(t *testing.T) {
+	t.Run("expands the interval until the sign changes", func(t *testing.T) {
+		f := func(x float64) float64 { return x - 10 }
+
+		a, b, err := BracketRoot(f, 0, 1, 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f(a)*f(b) > 0 {
+			t.Errorf("Want a sign change in [%f, %f]", a, b)
+		}
+	})
+
+	t.Run("fails if there's no sign change", func(t *testing.T) {
+		f := func(x float64) float64 { return x*x + 1 }
+
+		if _, _, err := BracketRoot(f, 0, 1, 20); err != ErrNotBracketed {
+			t.Errorf("Want ErrNotBracketed, got %v", err)
+		}
+	})
+}
+
+func TestBisection(t *testing.T) {
+	f := func(x float64) float64 { return x*x - 2 }
+
+	t.Run("finds the root", func(t *testing.T) {
+		root, err := Bisection(f, 0, 2, 1e-12, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !FloatsEqual(root, math.Sqrt2) {
+			t.Errorf("Want %f, got %f", math.Sqrt2, root)
+		}
+	})
+
+	t.Run("fails if the root isn't bracketed", func(t *testing.T) {
+		if _, err := Bisection(f, 2, 3, 1e-12, 100); err != ErrNotBracketed {
+			t.Errorf("Want ErrNotBracketed, got %v", err)
+		}
+	})
+
+	t.Run("fails if it doesn't converge", func(t *testing.T) {
+		if _, err := Bisection(f, 0, 2, 1e-12, 5); err != ErrNoConvergence {
+			t.Errorf("Want ErrNoConvergence, got %v", err)
+		}
+	})
+}
+
+func TestBrent(t *testing.T) {
+	t.Run("finds the root", func(t *testing.T) {
+		var (
+			f         = func(x float64) float64 { return math.Cos(x) - x }
+			root, err = Brent(f, 0, 1, 1e-14, 100)
+		)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !FloatsEqual(f(root), 0) {
+			t.Errorf("Want a root, got %f with value %g", root, f(root))
+		}
+	})
+
+	t.Run("converges faster than bisection", func(t *testing.T) {
+		var (
+			evaluations = 0
+			f           = func(x float64) float64 { evaluations++; return x*x*x - 2*x - 5 }
+		)
+
+		root, err := Brent(f, 2, 3, 1e-12, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !FloatsEqual(f(root), 0) {
+			t.Errorf("Want a root, got %f", root)
+		}
+		if evaluations > 15 {
+			t.Errorf("Want less than 15 evaluations, got %d", evaluations)
+		}
+	})
+
+	t.Run("fails if the root isn't bracketed", func(t *testing.T) {
+		if _, err := Brent(math.Exp, 0, 1, 1e-12, 100); err != ErrNotBracketed {
+			t.Errorf("Want ErrNotBracketed, got %v", err)
+		}
+	})
+}
+
+func TestNewton(t *testing.T) {
+	var (
+		f  = func(x float64) float64 { return x*x - 2 }
+		df = func(x float64) float64 { return 2 * x }
+	)
+
+	t.Run("finds the root", func(t *testing.T) {
+		root, err := Newton(f, df, 1, 1e-14, 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !FloatsEqual(root, math.Sqrt2) {
+			t.Errorf("Want %f, got %f", math.Sqrt2, root)
+		}
+	})
+
+	t.Run("fails at a zero derivative", func(t *testing.T) {
+		if _, err := Newton(f, df, 0, 1e-14, 50); err != ErrZeroDerivative {
+			t.Errorf("Want ErrZeroDerivative, got %v", err)
+		}
+	})
+
+	t.Run("fails if it doesn't converge", func(t *testing.T) {
+		if _, err := Newton(f, df, 1000, 1e-14, 3); err != ErrNoConvergence {
+			t.Errorf("Want ErrNoConvergence, got %v", err)
+		}
+	})
+}
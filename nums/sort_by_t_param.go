@@ -1,5 +1,7 @@
 package nums
 
+import "sort"
+
 /*
 ByTParamValue implements sort.Interface for []TParam based on the value field.
 */
@@ -16,3 +18,52 @@ func (a ByTParamValue) Swap(i, j int) {
 func (a ByTParamValue) Less(i, j int) bool {
 	return a[i].value < a[j].value
 }
+
+/*
+SortTParams sorts the T parameters in ascending order of their values, in place.
+*/
+func SortTParams(tParams []TParam) {
+	sort.Sort(ByTParamValue(tParams))
+}
+
+/*
+MergeSortedTParams merges two lists of T parameters, sorted in ascending order, into a new
+sorted list. Repeated values are kept.
+*/
+func MergeSortedTParams(a, b []TParam) []TParam {
+	var (
+		merged = make([]TParam, 0, len(a)+len(b))
+		i, j   = 0, 0
+	)
+
+	for i < len(a) && j < len(b) {
+		if b[j].value < a[i].value {
+			merged = append(merged, b[j])
+			j++
+		} else {
+			merged = append(merged, a[i])
+			i++
+		}
+	}
+
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
+
+/*
+UniqueTParams returns a new sorted list of the T parameters, where those equal, within the
+given tolerance, to the previous one in the list are removed.
+*/
+func UniqueTParams(tParams []TParam, tol Tolerance) []TParam {
+	sorted := append([]TParam(nil), tParams...)
+	SortTParams(sorted)
+
+	unique := make([]TParam, 0, len(sorted))
+	for _, t := range sorted {
+		if len(unique) == 0 || !t.EqualsTol(unique[len(unique)-1], tol) {
+			unique = append(unique, t)
+		}
+	}
+
+	return unique
+}
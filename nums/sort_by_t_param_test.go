@@ -0,0 +1,60 @@
+package nums
+
+import "testing"
+
+func makeTParams(values ...float64) []TParam {
+	tParams := make([]TParam, len(values))
+	for i, value := range values {
+		tParams[i] = MakeTParam(value)
+	}
+
+	return tParams
+}
+
+func assertTParamValues(t *testing.T, want []float64, got []TParam) {
+	t.Helper()
+
+	if len(want) != len(got) {
+		t.Fatalf("Want %v, got %v", want, got)
+	}
+	for i := range want {
+		if !FloatsEqual(want[i], got[i].Value()) {
+			t.Fatalf("Want %v, got %v", want, got)
+		}
+	}
+}
+
+func TestSortTParams(t *testing.T) {
+	tParams := makeTParams(0.5, 0.1, 0.9, 0.3)
+	SortTParams(tParams)
+
+	assertTParamValues(t, []float64{0.1, 0.3, 0.5, 0.9}, tParams)
+}
+
+func TestMergeSortedTParams(t *testing.T) {
+	var (
+		a = makeTParams(0, 0.4, 0.8)
+		b = makeTParams(0.2, 0.4, 1)
+	)
+
+	assertTParamValues(t, []float64{0, 0.2, 0.4, 0.4, 0.8, 1}, MergeSortedTParams(a, b))
+	assertTParamValues(t, []float64{0.2, 0.4, 1}, MergeSortedTParams(nil, b))
+}
+
+func TestUniqueTParams(t *testing.T) {
+	tParams := makeTParams(0.5, 0.1, 0.5000001, 0.9, 0.1)
+
+	t.Run("with the default tolerance", func(t *testing.T) {
+		assertTParamValues(t, []float64{0.1, 0.5, 0.5000001, 0.9}, UniqueTParams(tParams, DefaultTolerance))
+	})
+
+	t.Run("with a larger tolerance", func(t *testing.T) {
+		assertTParamValues(t, []float64{0.1, 0.5, 0.9}, UniqueTParams(tParams, MakeAbsoluteTolerance(1e-3)))
+	})
+
+	t.Run("doesn't modify the input", func(t *testing.T) {
+		if tParams[0].Value() != 0.5 {
+			t.Error("Expected the input to be unchanged")
+		}
+	})
+}
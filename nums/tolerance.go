@@ -0,0 +1,126 @@
+package nums
+
+import "math"
+
+/*
+A Tolerance decides whether two floating-point numbers are close enough to be considered equal.
+
+There are three ways of comparing two numbers, which can be combined:
+
+  - Absolute: the numbers are equal if their difference is smaller than a fixed epsilon.
+  - Relative: the numbers are equal if their difference is smaller than a fraction of the
+    largest of their magnitudes.
+  - ULP: the numbers are equal if there are at most a given number of representable float64
+    values between them (units in the last place).
+
+Two numbers are considered equal if any of the configured comparisons says so. Relative and ULP
+comparisons don't work for numbers close to zero, so they are often combined with an absolute
+floor.
+*/
+type Tolerance struct {
+	absolute float64
+	relative float64
+	ulps     uint64
+}
+
+/*
+DefaultTolerance is the absolute tolerance, of 1e-10, used by the comparison functions which
+don't accept a tolerance, like FloatsEqual, IsCloseToZero and IsCloseToOne.
+*/
+var DefaultTolerance = MakeAbsoluteTolerance(defaultEpsilon)
+
+/* <-- Construction --> */
+
+/*
+MakeAbsoluteTolerance returns a tolerance which considers two numbers equal if the absolute value
+of their difference is smaller than epsilon.
+*/
+func MakeAbsoluteTolerance(epsilon float64) Tolerance {
+	return Tolerance{absolute: epsilon}
+}
+
+/*
+MakeRelativeTolerance returns a tolerance which considers two numbers equal if the absolute value
+of their difference is smaller than the given fraction of the largest of their magnitudes.
+*/
+func MakeRelativeTolerance(fraction float64) Tolerance {
+	return Tolerance{relative: fraction}
+}
+
+/*
+MakeULPTolerance returns a tolerance which considers two numbers equal if there are at most ulps
+representable float64 values between them.
+*/
+func MakeULPTolerance(ulps uint64) Tolerance {
+	return Tolerance{ulps: ulps}
+}
+
+/*
+WithAbsoluteFloor returns a copy of the tolerance which also considers two numbers equal if the
+absolute value of their difference is smaller than epsilon.
+*/
+func (tol Tolerance) WithAbsoluteFloor(epsilon float64) Tolerance {
+	tol.absolute = epsilon
+	return tol
+}
+
+/* <-- Comparisons --> */
+
+/*
+Equal returns true if the two numbers are equal within the tolerance.
+NaN values are never equal to any number.
+*/
+func (tol Tolerance) Equal(a, b float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+
+	diff := math.Abs(a - b)
+
+	if diff < tol.absolute {
+		return true
+	}
+	if diff < tol.relative*math.Max(math.Abs(a), math.Abs(b)) {
+		return true
+	}
+
+	return tol.ulps > 0 && ulpsBetween(a, b) <= tol.ulps
+}
+
+/*
+IsZero returns true if the number is equal to zero within the tolerance.
+*/
+func (tol Tolerance) IsZero(a float64) bool {
+	return tol.Equal(a, 0)
+}
+
+/*
+IsOne returns true if the number is equal to one within the tolerance.
+*/
+func (tol Tolerance) IsOne(a float64) bool {
+	return tol.Equal(a, 1)
+}
+
+// ulpsBetween returns the number of representable float64 values between a and b.
+func ulpsBetween(a, b float64) uint64 {
+	ordA, ordB := orderedBits(a), orderedBits(b)
+	if ordA > ordB {
+		return uint64(ordA) - uint64(ordB)
+	}
+
+	return uint64(ordB) - uint64(ordA)
+}
+
+// orderedBits maps the float64 to an integer, so that consecutive floats map to consecutive
+// integers, and both zeros map to zero.
+func orderedBits(a float64) int64 {
+	bits := int64(math.Float64bits(a))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+
+	return bits
+}
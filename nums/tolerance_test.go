@@ -0,0 +1,94 @@
+package nums
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAbsoluteTolerance(t *testing.T) {
+	tol := MakeAbsoluteTolerance(1e-3)
+
+	t.Run("numbers closer than epsilon are equal", func(t *testing.T) {
+		if !tol.Equal(1.0, 1.0005) {
+			t.Error("Numbers expected to be equal")
+		}
+	})
+
+	t.Run("numbers farther than epsilon aren't equal", func(t *testing.T) {
+		if tol.Equal(1e6, 1e6+0.01) {
+			t.Error("Numbers expected to not be equal")
+		}
+	})
+
+	t.Run("zero and one", func(t *testing.T) {
+		if !tol.IsZero(-5e-4) || !tol.IsOne(1.0005) {
+			t.Error("Expected numbers to be zero and one")
+		}
+	})
+}
+
+func TestRelativeTolerance(t *testing.T) {
+	tol := MakeRelativeTolerance(1e-6)
+
+	t.Run("large numbers with a small relative difference are equal", func(t *testing.T) {
+		if !tol.Equal(1e9, 1e9+1) {
+			t.Error("Numbers expected to be equal")
+		}
+	})
+
+	t.Run("small numbers with a large relative difference aren't equal", func(t *testing.T) {
+		if tol.Equal(1e-9, 1.1e-9) {
+			t.Error("Numbers expected to not be equal")
+		}
+	})
+
+	t.Run("only zero is zero without an absolute floor", func(t *testing.T) {
+		if !tol.IsZero(0) || tol.IsZero(1e-300) {
+			t.Error("Expected only zero to be zero")
+		}
+	})
+
+	t.Run("an absolute floor allows comparing to zero", func(t *testing.T) {
+		if !tol.WithAbsoluteFloor(1e-12).IsZero(1e-13) {
+			t.Error("Expected number to be zero")
+		}
+	})
+}
+
+func TestULPTolerance(t *testing.T) {
+	var (
+		tol   = MakeULPTolerance(2)
+		next  = func(x float64) float64 { return math.Nextafter(x, math.Inf(1)) }
+		value = 1e-200
+	)
+
+	t.Run("numbers within the ulps are equal", func(t *testing.T) {
+		if !tol.Equal(value, next(next(value))) {
+			t.Error("Numbers expected to be equal")
+		}
+	})
+
+	t.Run("numbers beyond the ulps aren't equal", func(t *testing.T) {
+		if tol.Equal(value, next(next(next(value)))) {
+			t.Error("Numbers expected to not be equal")
+		}
+	})
+
+	t.Run("numbers on both sides of zero", func(t *testing.T) {
+		smallest := math.SmallestNonzeroFloat64
+		if !tol.Equal(-smallest, smallest) {
+			t.Error("Numbers expected to be equal")
+		}
+		if ulps := ulpsBetween(math.Copysign(0, -1), 0); ulps != 0 {
+			t.Errorf("Want 0 ulps between the zeros, got %d", ulps)
+		}
+	})
+}
+
+func TestToleranceNaN(t *testing.T) {
+	tol := MakeAbsoluteTolerance(math.Inf(1))
+
+	if tol.Equal(math.NaN(), 1) || tol.Equal(math.NaN(), math.NaN()) {
+		t.Error("NaN expected to not equal any number")
+	}
+}
@@ -1,6 +1,7 @@
 package nums
 
 import (
+	"errors"
 	"math"
 )
 
@@ -19,6 +20,28 @@ var (
 	MaxT = TParam{maxTValue}
 )
 
+/*
+ErrTParamOutOfRange is returned when a T parameter is created with a value outside of the [0, 1]
+range, and the value isn't clamped or wrapped.
+*/
+var ErrTParamOutOfRange = errors.New("the value of a T parameter must be in the range [0, 1]")
+
+/*
+A TParamPolicy decides how to handle values outside of the [0, 1] range when creating a T
+parameter.
+*/
+type TParamPolicy int
+
+const (
+	// ClampOutOfRange approximates out of range values to the closest end of the range.
+	ClampOutOfRange TParamPolicy = iota
+	// WrapOutOfRange keeps the fractional part of out of range values, as in closed curves,
+	// where t = 1.25 is the same position as t = 0.25.
+	WrapOutOfRange
+	// RejectOutOfRange returns an error for out of range values.
+	RejectOutOfRange
+)
+
 /*
 A TParam is a parameter which takes values between in the range [0, 1].
 */
@@ -44,6 +67,43 @@ func MakeTParam(value float64) TParam {
 	}
 }
 
+/*
+MakeTParamChecked returns a new T parameter with the given value.
+
+An ErrTParamOutOfRange error is returned if the value is out of the [0, 1] range.
+*/
+func MakeTParamChecked(value float64) (TParam, error) {
+	return MakeTParamWithPolicy(value, RejectOutOfRange)
+}
+
+/*
+MakeTParamWithPolicy returns a new T parameter with the given value, using the policy to handle
+out of range values.
+
+An ErrTParamOutOfRange error is returned if the value is NaN, or if it's out of range and the
+policy is RejectOutOfRange.
+*/
+func MakeTParamWithPolicy(value float64, policy TParamPolicy) (TParam, error) {
+	if math.IsNaN(value) {
+		return MinT, ErrTParamOutOfRange
+	}
+	if value >= minTValue && value <= maxTValue {
+		return TParam{value}, nil
+	}
+
+	switch policy {
+	case ClampOutOfRange:
+		return MakeTParam(value), nil
+	case WrapOutOfRange:
+		if math.IsInf(value, 0) {
+			return MinT, ErrTParamOutOfRange
+		}
+		return TParam{value - math.Floor(value)}, nil
+	default:
+		return MinT, ErrTParamOutOfRange
+	}
+}
+
 /*
 AverageT creates a new T parameter which value is the average of the given two.
 */
@@ -57,7 +117,15 @@ func AverageT(a, b TParam) TParam {
 Equals compares the given t parameters and returns true if their values are equal.
 */
 func (t TParam) Equals(other TParam) bool {
-	return FloatsEqual(t.value, other.value)
+	return t.EqualsTol(other, DefaultTolerance)
+}
+
+/*
+EqualsTol compares the given t parameters and returns true if their values are equal within the
+given tolerance.
+*/
+func (t TParam) EqualsTol(other TParam, tol Tolerance) bool {
+	return tol.Equal(t.value, other.value)
 }
 
 /*
@@ -87,21 +155,45 @@ func (t TParam) IsLessThan(other TParam) bool {
 IsMin returns true if this T parameter's value is the minimum value allowed.
 */
 func (t TParam) IsMin() bool {
-	return FloatsEqual(t.value, minTValue)
+	return t.IsMinTol(DefaultTolerance)
+}
+
+/*
+IsMinTol returns true if this T parameter's value is the minimum value allowed within the given
+tolerance.
+*/
+func (t TParam) IsMinTol(tol Tolerance) bool {
+	return tol.Equal(t.value, minTValue)
 }
 
 /*
 IsMax returns true if this T parameter's value is the maximum value allowed.
 */
 func (t TParam) IsMax() bool {
-	return FloatsEqual(t.value, maxTValue)
+	return t.IsMaxTol(DefaultTolerance)
+}
+
+/*
+IsMaxTol returns true if this T parameter's value is the maximum value allowed within the given
+tolerance.
+*/
+func (t TParam) IsMaxTol(tol Tolerance) bool {
+	return tol.Equal(t.value, maxTValue)
 }
 
 /*
 IsExtreme returns true if this T parameter's value is either minimum or maximum.
 */
 func (t TParam) IsExtreme() bool {
-	return t.IsMax() || t.IsMin()
+	return t.IsExtremeTol(DefaultTolerance)
+}
+
+/*
+IsExtremeTol returns true if this T parameter's value is either minimum or maximum within the
+given tolerance.
+*/
+func (t TParam) IsExtremeTol(tol Tolerance) bool {
+	return t.IsMaxTol(tol) || t.IsMinTol(tol)
 }
 
 /*
@@ -116,8 +208,14 @@ func (t TParam) Value() float64 {
 /*
 SubTParamRangeTimes subdivides a given range of t parameters a given number of times,
 resulting in a times + 1 size slice.
+
+If times is smaller than one, the range isn't subdivided, and only its ends are returned.
 */
 func SubTParamRangeTimes(startT, endT TParam, times int) []TParam {
+	if times < 1 {
+		times = 1
+	}
+
 	tParams := make([]TParam, times+1)
 	step := startT.DistanceTo(endT) / float64(times)
 
@@ -139,6 +237,8 @@ func SubTParamRangeTimes(startT, endT TParam, times int) []TParam {
 /*
 SubTParamCompleteRangeTimes subdivides the entire range of [t_min, t_max] a
 given number of times.
+
+If times is smaller than one, only the ends of the range are returned.
 */
 func SubTParamCompleteRangeTimes(times int) []TParam {
 	return SubTParamRangeTimes(MinT, MaxT, times)
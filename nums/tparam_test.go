@@ -1,6 +1,7 @@
 package nums
 
 import (
+	"math"
 	"sort"
 	"testing"
 )
@@ -35,6 +36,20 @@ func TestTParamIsExtreme(t *testing.T) {
 			t.Errorf("Expected %v to not be extreme", HalfT)
 		}
 	})
+
+	t.Run("is extreme within a tolerance", func(t *testing.T) {
+		var (
+			tParam = MakeTParam(1e-6)
+			tol    = MakeAbsoluteTolerance(1e-5)
+		)
+
+		if tParam.IsExtreme() {
+			t.Errorf("Expected %v to not be extreme", tParam)
+		}
+		if !tParam.IsExtremeTol(tol) || !tParam.IsMinTol(tol) || tParam.IsMaxTol(tol) {
+			t.Errorf("Expected %v to be min within %v", tParam, tol)
+		}
+	})
 }
 
 func TestTParamsEqual(t *testing.T) {
@@ -83,6 +98,24 @@ func TestSubdivideRange(t *testing.T) {
 	}
 }
 
+func TestSubdivideRangeZeroTimes(t *testing.T) {
+	t1, t2 := MakeTParam(0.7), MakeTParam(0.5)
+	vals := SubTParamRangeTimes(t1, t2, 0)
+
+	if len(vals) != 2 || vals[0] != t2 || vals[1] != t1 {
+		t.Errorf("Want the ends of the range, got %v", vals)
+	}
+}
+
+func TestSubdivideRangeOnce(t *testing.T) {
+	t1, t2 := MakeTParam(0.7), MakeTParam(0.5)
+	vals := SubTParamRangeTimes(t1, t2, 1)
+
+	if len(vals) != 2 || vals[0] != t2 || vals[1] != t1 {
+		t.Errorf("Want the ends of the range, got %v", vals)
+	}
+}
+
 func TestGreaterOrLessThan(t *testing.T) {
 	t1, t2 := MakeTParam(0.5), MakeTParam(0.7)
 
@@ -140,3 +173,46 @@ func TestTParamSorting(t *testing.T) {
 		t.Error("Wrong ordering of t params")
 	}
 }
+
+func TestMakeTParamChecked(t *testing.T) {
+	t.Run("value in range", func(t *testing.T) {
+		if tParam, err := MakeTParamChecked(1); err != nil || !tParam.IsMax() {
+			t.Errorf("Want the max T, got %v (%v)", tParam, err)
+		}
+	})
+
+	t.Run("value out of range", func(t *testing.T) {
+		if _, err := MakeTParamChecked(1.2); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+	})
+}
+
+func TestMakeTParamWithPolicy(t *testing.T) {
+	t.Run("clamp", func(t *testing.T) {
+		if tParam, _ := MakeTParamWithPolicy(-0.5, ClampOutOfRange); !tParam.IsMin() {
+			t.Errorf("Want the min T, got %f", tParam.Value())
+		}
+	})
+
+	t.Run("wrap", func(t *testing.T) {
+		if tParam, _ := MakeTParamWithPolicy(1.25, WrapOutOfRange); !FloatsEqual(tParam.Value(), 0.25) {
+			t.Errorf("Want 0.25, got %f", tParam.Value())
+		}
+		if tParam, _ := MakeTParamWithPolicy(-0.25, WrapOutOfRange); !FloatsEqual(tParam.Value(), 0.75) {
+			t.Errorf("Want 0.75, got %f", tParam.Value())
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		if _, err := MakeTParamWithPolicy(-0.5, RejectOutOfRange); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+	})
+
+	t.Run("NaN is always rejected", func(t *testing.T) {
+		if _, err := MakeTParamWithPolicy(math.NaN(), ClampOutOfRange); err != ErrTParamOutOfRange {
+			t.Errorf("Want ErrTParamOutOfRange, got %v", err)
+		}
+	})
+}